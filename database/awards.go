@@ -0,0 +1,812 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const defaultAwardLeaderboardCount = 10
+
+var defaultAwardVotePoints = []int32{3, 2, 1}
+
+/* the values of the awardvoterrole enum */
+var awardVoterRoles = map[string]bool{
+	"coach":   true,
+	"referee": true,
+}
+
+/* Hollinger's game score, a single number for how well a player played */
+const gameScoreExpression = `
+	(COALESCE(PlayerGameStats.ThreePointFGM, 0)*3 + COALESCE(PlayerGameStats.TwoPointFGM, 0)*2 + COALESCE(PlayerGameStats.FreeThrowsMade, 0)) +
+	0.4 * (COALESCE(PlayerGameStats.TwoPointFGM, 0) + COALESCE(PlayerGameStats.ThreePointFGM, 0)) -
+	0.7 * (COALESCE(PlayerGameStats.TwoPointFGA, 0) + COALESCE(PlayerGameStats.ThreePointFGA, 0)) -
+	0.4 * (COALESCE(PlayerGameStats.FreeThrowsAttempted, 0) - COALESCE(PlayerGameStats.FreeThrowsMade, 0)) +
+	0.7 * COALESCE(PlayerGameStats.OffensiveRebounds, 0) +
+	0.3 * COALESCE(PlayerGameStats.DefensiveRebounds, 0) +
+	COALESCE(PlayerGameStats.Steals, 0) +
+	0.7 * COALESCE(PlayerGameStats.Assists, 0) +
+	0.7 * COALESCE(PlayerGameStats.Blocks, 0) -
+	0.4 * COALESCE(PlayerGameStats.RegularFoulsCommitted, 0) -
+	COALESCE(PlayerGameStats.Turnovers, 0)`
+
+/* the points a player got towards an award in one game */
+type awardPoints struct {
+	gameId    int32
+	playerId  int32
+	points    int32
+	gameScore bool
+}
+
+func (database *HeroBallDatabase) CreateAward(ctx context.Context, request *pb.CreateAwardRequest) (*pb.CreateAwardResponse, error) {
+
+	award := request.GetAward()
+
+	violations := fieldViolations{}
+
+	if award == nil {
+		violations.add("Award", "Must supply an award")
+		return nil, violations.err()
+	}
+
+	if award.GetCompetitionId() <= 0 {
+		violations.add("Award.CompetitionId", "Must be greater than zero")
+	}
+
+	name := strings.TrimSpace(award.GetName())
+
+	if name == "" {
+		violations.add("Award.Name", "Must not be empty")
+	}
+
+	votePoints := award.GetVotePoints()
+
+	if len(votePoints) == 0 {
+		votePoints = defaultAwardVotePoints
+	}
+
+	for i, points := range votePoints {
+		if points <= 0 {
+			violations.add(fmt.Sprintf("Award.VotePoints[%v]", i), "Must be greater than zero")
+		} else if i > 0 && points > votePoints[i-1] {
+			violations.add(fmt.Sprintf("Award.VotePoints[%v]", i), "Must not be more than the place above")
+		}
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	var awardId int32
+
+	err := database.db.QueryRowContext(ctx, `
+		INSERT INTO Awards (
+			CompetitionId,
+			Name,
+			VotePoints)
+		VALUES
+			($1, $2, $3)
+		RETURNING
+			AwardId`,
+		award.GetCompetitionId(),
+		name,
+		pq.Array(votePoints)).Scan(&awardId)
+
+	if err != nil {
+		return nil, writeError("Error creating award", err)
+	}
+
+	log.Printf("Created award %v %q in competition %v", awardId, name, award.GetCompetitionId())
+
+	return &pb.CreateAwardResponse{
+		AwardId: awardId,
+	}, nil
+}
+
+func (database *HeroBallDatabase) getAwards(ctx context.Context, condition string, args ...interface{}) ([]*pb.Award, error) {
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			AwardId,
+			CompetitionId,
+			Name,
+			VotePoints
+		FROM
+			Awards
+		WHERE
+			`+condition+`
+		ORDER BY
+			AwardId ASC`,
+		args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting awards: %w", err)
+	}
+
+	defer rows.Close()
+
+	awards := make([]*pb.Award, 0)
+
+	for rows.Next() {
+
+		award := &pb.Award{}
+		var votePoints pq.Int64Array
+
+		err = rows.Scan(&award.AwardId, &award.CompetitionId, &award.Name, &votePoints)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning award: %w", err)
+		}
+
+		for _, points := range votePoints {
+			award.VotePoints = append(award.VotePoints, int32(points))
+		}
+
+		awards = append(awards, award)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return awards, nil
+}
+
+func (database *HeroBallDatabase) getAwardById(ctx context.Context, awardId int32) (*pb.Award, error) {
+
+	awards, err := database.getAwards(ctx, "AwardId = $1", awardId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(awards) == 0 {
+		return nil, notFound("awardId", awardId)
+	}
+
+	return awards[0], nil
+}
+
+func (database *HeroBallDatabase) getAwardsForCompetition(ctx context.Context, competitionId int32) ([]*pb.Award, error) {
+	return database.getAwards(ctx, "CompetitionId = $1", competitionId)
+}
+
+func (database *HeroBallDatabase) CastAwardVotes(ctx context.Context, request *pb.CastAwardVotesRequest) (*pb.CastAwardVotesResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetAwardId() <= 0 {
+		violations.add("AwardId", "Must be greater than zero")
+	}
+
+	if request.GetGameId() <= 0 {
+		violations.add("GameId", "Must be greater than zero")
+	}
+
+	voter := strings.TrimSpace(request.GetVoter())
+
+	if voter == "" {
+		violations.add("Voter", "Must not be empty")
+	}
+
+	if !awardVoterRoles[request.GetVoterRole()] {
+		violations.add("VoterRole", "Must be coach or referee")
+	}
+
+	voted := make(map[int32]bool)
+
+	for i, playerId := range request.GetPlayerIds() {
+
+		if playerId <= 0 {
+			violations.add(fmt.Sprintf("PlayerIds[%v]", i), "Must be greater than zero")
+		} else if voted[playerId] {
+			violations.add(fmt.Sprintf("PlayerIds[%v]", i), "Player %v is already on the ballot", playerId)
+		}
+
+		voted[playerId] = true
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	award, err := database.getAwardById(ctx, request.GetAwardId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(request.GetPlayerIds()) > len(award.GetVotePoints()) {
+		return nil, invalidArgument("PlayerIds", "Award %v takes at most %v players on a ballot", award.GetAwardId(), len(award.GetVotePoints()))
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	game, err := lockGameInTx(ctx, tx, request.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if game.competitionId != award.GetCompetitionId() {
+		return nil, invalidArgument("GameId", "Game %v is not in competition %v", request.GetGameId(), award.GetCompetitionId())
+	}
+
+	if game.status != "final" {
+		violations.add("GameId", "Game %v is %v", request.GetGameId(), game.status)
+		return nil, violations.errWithCode(codes.FailedPrecondition, "Votes are cast once the game is final")
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			PlayerId
+		FROM
+			PlayerGameStats
+		WHERE
+			GameId = $1 AND
+			PlayerId = ANY($2)`,
+		request.GetGameId(),
+		pq.Array(request.GetPlayerIds()))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting players in game: %w", err)
+	}
+
+	played := make(map[int32]bool)
+
+	for rows.Next() {
+
+		var playerId int32
+
+		err = rows.Scan(&playerId)
+
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("Error scanning player in game: %w", err)
+		}
+
+		played[playerId] = true
+	}
+
+	rows.Close()
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	for i, playerId := range request.GetPlayerIds() {
+		if !played[playerId] {
+			violations.add(fmt.Sprintf("PlayerIds[%v]", i), "Player %v did not play in game %v", playerId, request.GetGameId())
+		}
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			AwardVotes
+		WHERE
+			AwardId = $1 AND
+			GameId = $2 AND
+			Voter = $3`,
+		request.GetAwardId(),
+		request.GetGameId(),
+		voter)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error clearing ballot: %w", err)
+	}
+
+	/* the position on the ballot is the place */
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO AwardVotes (
+			AwardId,
+			GameId,
+			Voter,
+			VoterRole,
+			Place,
+			PlayerId)
+		SELECT
+			$1, $2, $3, $4, Ballot.Place, Ballot.PlayerId
+		FROM
+			unnest($5::int[]) WITH ORDINALITY AS Ballot(PlayerId, Place)`,
+		request.GetAwardId(),
+		request.GetGameId(),
+		voter,
+		request.GetVoterRole(),
+		pq.Array(request.GetPlayerIds()))
+
+	if err != nil {
+		return nil, writeError("Error casting votes", err)
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing votes", err)
+	}
+
+	log.Printf("%v %q voted for %v in game %v for award %v", request.GetVoterRole(), voter, request.GetPlayerIds(), request.GetGameId(), request.GetAwardId())
+
+	result, err := database.getAwardGameResult(ctx, award, request.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CastAwardVotesResponse{
+		Result: result,
+	}, nil
+}
+
+/*
+ * the points each player got towards the award in its competition's final
+ * games, or in just gameId when it isn't 0. A game nobody voted in gives the
+ * players with the best game scores the points of a single ballot. The second
+ * return is the number of ballots cast in each game
+ */
+func (database *HeroBallDatabase) getAwardPoints(ctx context.Context, award *pb.Award, gameId int32) ([]awardPoints, map[int32]int32, error) {
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			AwardVotes.GameId,
+			AwardVotes.PlayerId,
+			COALESCE(SUM(Awards.VotePoints[AwardVotes.Place]), 0)
+		FROM
+			AwardVotes
+		JOIN
+			Awards ON AwardVotes.AwardId = Awards.AwardId
+		JOIN
+			Games ON AwardVotes.GameId = Games.GameId
+		WHERE
+			AwardVotes.AwardId = $1 AND
+			($2 = 0 OR AwardVotes.GameId = $2) AND
+			Games.Status = 'final'
+		GROUP BY
+			AwardVotes.GameId,
+			AwardVotes.PlayerId`,
+		award.GetAwardId(),
+		gameId)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error getting award votes: %w", err)
+	}
+
+	defer rows.Close()
+
+	points := make([]awardPoints, 0)
+
+	for rows.Next() {
+
+		var entry awardPoints
+
+		err = rows.Scan(&entry.gameId, &entry.playerId, &entry.points)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error scanning award votes: %w", err)
+		}
+
+		points = append(points, entry)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	rows, err = database.db.QueryContext(ctx, `
+		SELECT
+			GameId,
+			COUNT(DISTINCT Voter)
+		FROM
+			AwardVotes
+		WHERE
+			AwardId = $1 AND
+			($2 = 0 OR GameId = $2)
+		GROUP BY
+			GameId`,
+		award.GetAwardId(),
+		gameId)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error counting ballots: %w", err)
+	}
+
+	defer rows.Close()
+
+	ballots := make(map[int32]int32)
+
+	for rows.Next() {
+
+		var ballotGameId int32
+		var count int32
+
+		err = rows.Scan(&ballotGameId, &count)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error scanning ballot count: %w", err)
+		}
+
+		ballots[ballotGameId] = count
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	/* ties on game score go to the lower PlayerId so the fallback doesn't change between calls */
+	rows, err = database.db.QueryContext(ctx, `
+		SELECT
+			GameId,
+			PlayerId,
+			Place
+		FROM (
+			SELECT
+				PlayerGameStats.GameId,
+				PlayerGameStats.PlayerId,
+				ROW_NUMBER() OVER (PARTITION BY PlayerGameStats.GameId ORDER BY `+gameScoreExpression+` DESC, PlayerGameStats.PlayerId ASC) AS Place
+			FROM
+				PlayerGameStats
+			JOIN
+				Games ON PlayerGameStats.GameId = Games.GameId
+			WHERE
+				Games.CompetitionId = $1 AND
+				($2 = 0 OR Games.GameId = $2) AND
+				Games.Status = 'final' AND
+				NOT EXISTS (SELECT 1 FROM AwardVotes WHERE AwardVotes.AwardId = $3 AND AwardVotes.GameId = Games.GameId)
+		) Ranked
+		WHERE
+			Place <= $4`,
+		award.GetCompetitionId(),
+		gameId,
+		award.GetAwardId(),
+		len(award.GetVotePoints()))
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error getting game scores: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+
+		entry := awardPoints{gameScore: true}
+		var place int32
+
+		err = rows.Scan(&entry.gameId, &entry.playerId, &place)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error scanning game score: %w", err)
+		}
+
+		entry.points = award.GetVotePoints()[place-1]
+
+		points = append(points, entry)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return points, ballots, nil
+}
+
+/* the players with the most points in each game */
+func getAwardGameWinners(points []awardPoints) map[int32][]int32 {
+
+	best := make(map[int32]int32)
+
+	for _, entry := range points {
+		if entry.points > best[entry.gameId] {
+			best[entry.gameId] = entry.points
+		}
+	}
+
+	winners := make(map[int32][]int32)
+
+	for _, entry := range points {
+		if entry.points == best[entry.gameId] {
+			winners[entry.gameId] = append(winners[entry.gameId], entry.playerId)
+		}
+	}
+
+	return winners
+}
+
+/* the award's votes in a single game, with no votes before the game is final */
+func (database *HeroBallDatabase) getAwardGameResult(ctx context.Context, award *pb.Award, gameId int32) (*pb.AwardGameResult, error) {
+
+	points, ballots, err := database.getAwardPoints(ctx, award, gameId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.AwardGameResult{
+		Award:       award,
+		GameId:      gameId,
+		BallotCount: ballots[gameId],
+		Votes:       make([]*pb.AwardVote, 0),
+		Winners:     make([]*pb.Player, 0),
+	}
+
+	if len(points) == 0 {
+		return result, nil
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].points != points[j].points {
+			return points[i].points > points[j].points
+		}
+		return points[i].playerId < points[j].playerId
+	})
+
+	playerIds := make([]int32, 0, len(points))
+
+	for _, entry := range points {
+		playerIds = append(playerIds, entry.playerId)
+	}
+
+	players, err := database.getPlayersById(ctx, playerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.FromGameScore = points[0].gameScore
+
+	for _, entry := range points {
+		result.Votes = append(result.Votes, &pb.AwardVote{
+			Player: findPlayer(players, entry.playerId),
+			Points: entry.points,
+		})
+	}
+
+	for _, playerId := range getAwardGameWinners(points)[gameId] {
+		result.Winners = append(result.Winners, findPlayer(players, playerId))
+	}
+
+	return result, nil
+}
+
+/* every player with points towards the award, ranked, with only their PlayerId filled in */
+func (database *HeroBallDatabase) getAwardStandings(ctx context.Context, award *pb.Award) ([]*pb.AwardLeaderboardEntry, int32, error) {
+
+	points, _, err := database.getAwardPoints(ctx, award, 0)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries := make(map[int32]*pb.AwardLeaderboardEntry)
+	games := make(map[int32]bool)
+
+	for _, entry := range points {
+
+		standing, found := entries[entry.playerId]
+
+		if !found {
+			standing = &pb.AwardLeaderboardEntry{Player: &pb.Player{PlayerId: entry.playerId}}
+			entries[entry.playerId] = standing
+		}
+
+		standing.Points += entry.points
+
+		if entry.gameScore {
+			standing.GameScorePoints += entry.points
+		} else {
+			standing.VotedPoints += entry.points
+		}
+
+		games[entry.gameId] = true
+	}
+
+	for _, winners := range getAwardGameWinners(points) {
+		for _, playerId := range winners {
+			entries[playerId].GamesWon++
+		}
+	}
+
+	standings := make([]*pb.AwardLeaderboardEntry, 0, len(entries))
+
+	for _, standing := range entries {
+		standings = append(standings, standing)
+	}
+
+	/* level on points share a rank, listed by votes then games won */
+	sort.Slice(standings, func(i, j int) bool {
+		first, second := standings[i], standings[j]
+		switch {
+		case first.Points != second.Points:
+			return first.Points > second.Points
+		case first.VotedPoints != second.VotedPoints:
+			return first.VotedPoints > second.VotedPoints
+		case first.GamesWon != second.GamesWon:
+			return first.GamesWon > second.GamesWon
+		}
+		return first.Player.PlayerId < second.Player.PlayerId
+	})
+
+	for i, standing := range standings {
+		if i > 0 && standing.Points == standings[i-1].Points {
+			standing.Rank = standings[i-1].Rank
+		} else {
+			standing.Rank = int32(i + 1)
+		}
+	}
+
+	return standings, int32(len(games)), nil
+}
+
+func (database *HeroBallDatabase) GetAwardLeaderboard(ctx context.Context, request *pb.GetAwardLeaderboardRequest) (*pb.AwardLeaderboard, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetAwardId() <= 0 {
+		violations.add("AwardId", "Must be greater than zero")
+	}
+
+	if request.GetCount() < 0 {
+		violations.add("Count", "Must not be negative")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	award, err := database.getAwardById(ctx, request.GetAwardId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	count := request.GetCount()
+
+	if count == 0 {
+		count = defaultAwardLeaderboardCount
+	}
+
+	standings, gameCount, err := database.getAwardStandings(ctx, award)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(standings) > int(count) {
+		standings = standings[:count]
+	}
+
+	leaderboard := &pb.AwardLeaderboard{
+		Award:     award,
+		GameCount: gameCount,
+		Entries:   standings,
+	}
+
+	if len(standings) == 0 {
+		return leaderboard, nil
+	}
+
+	playerIds := make([]int32, 0, len(standings))
+
+	for _, standing := range standings {
+		playerIds = append(playerIds, standing.Player.PlayerId)
+	}
+
+	players, err := database.getPlayersById(ctx, playerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, standing := range standings {
+		standing.Player = findPlayer(players, standing.Player.PlayerId)
+	}
+
+	return leaderboard, nil
+}
+
+/* where the player stands in each award of the competitions they've played in */
+func (database *HeroBallDatabase) getPlayerAwards(ctx context.Context, playerId int32) ([]*pb.PlayerAward, error) {
+
+	awards, err := database.getAwards(ctx, `
+			CompetitionId IN (
+				SELECT
+					Games.CompetitionId
+				FROM
+					PlayerGameStats
+				JOIN
+					Games ON PlayerGameStats.GameId = Games.GameId
+				WHERE
+					PlayerGameStats.PlayerId = $1)`,
+		playerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	playerAwards := make([]*pb.PlayerAward, 0)
+
+	if len(awards) == 0 {
+		return playerAwards, nil
+	}
+
+	player, err := database.getPlayerById(ctx, playerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, award := range awards {
+
+		standings, _, err := database.getAwardStandings(ctx, award)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, standing := range standings {
+			if standing.Player.PlayerId == playerId {
+				standing.Player = player
+				playerAwards = append(playerAwards, &pb.PlayerAward{
+					Award:    award,
+					Standing: standing,
+				})
+			}
+		}
+	}
+
+	return playerAwards, nil
+}
+
+/* the game's result in each of its competition's awards */
+func (database *HeroBallDatabase) getGameAwards(ctx context.Context, game *pb.Game) ([]*pb.AwardGameResult, error) {
+
+	results := make([]*pb.AwardGameResult, 0)
+
+	if game.GetStatus() != "final" {
+		return results, nil
+	}
+
+	awards, err := database.getAwardsForCompetition(ctx, game.GetCompetition().GetCompetitionId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, award := range awards {
+
+		result, err := database.getAwardGameResult(ctx, award, game.GetGameId())
+
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,588 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+/* a BracketSeries row, later rounds have no teams until they're worked out */
+type seriesRow struct {
+	seriesId         int32
+	round            int32
+	position         int32
+	bestOf           int32
+	higherSeed       int32
+	higherSeedTeamId int32
+	lowerSeed        int32
+	lowerSeedTeamId  int32
+}
+
+/* a playoff game and its score, forfeits scored as they are everywhere else */
+type seriesGame struct {
+	seriesId   int32
+	gameId     int32
+	homeTeamId int32
+	awayTeamId int32
+	status     string
+	homePoints int32
+	awayPoints int32
+}
+
+/*
+ * the seeds in bracket order for a field of count, pairs of neighbours meet in
+ * the first round. Each doubling of the field puts the new seed that adds up
+ * to count+1 alongside its opponent, so 8 teams run 1,8,4,5,2,7,3,6 and the top
+ * two seeds can only meet in the final
+ */
+func bracketSeedOrder(count int) []int32 {
+
+	seeds := []int32{1, 2}
+
+	for len(seeds) < count {
+
+		expanded := make([]int32, 0, len(seeds)*2)
+
+		for _, seed := range seeds {
+			expanded = append(expanded, seed, int32(len(seeds)*2+1)-seed)
+		}
+
+		seeds = expanded
+	}
+
+	return seeds
+}
+
+/*
+ * fills in the bracket from its series and their games. A series is won by the
+ * first team to a majority of BestOf, and its winner goes through to meet the
+ * winner of its neighbouring series in the next round, where the better
+ * remaining seed is the higher seed
+ */
+func resolveBracket(competitionId int32, series []seriesRow, games []seriesGame, teamNames map[int32]string) *pb.Bracket {
+
+	sort.Slice(series, func(i, j int) bool {
+		if series[i].round != series[j].round {
+			return series[i].round < series[j].round
+		}
+		return series[i].position < series[j].position
+	})
+
+	gamesBySeries := make(map[int32][]seriesGame)
+
+	for _, game := range games {
+		gamesBySeries[game.seriesId] = append(gamesBySeries[game.seriesId], game)
+	}
+
+	bracket := &pb.Bracket{
+		CompetitionId: competitionId,
+		Rounds:        make([]*pb.BracketRound, 0),
+	}
+
+	/* winning team and its seed of each series by round and position */
+	type advancing struct {
+		teamId int32
+		seed   int32
+	}
+
+	winners := make(map[int32]map[int32]advancing)
+
+	for _, row := range series {
+
+		if winners[row.round] == nil {
+			winners[row.round] = make(map[int32]advancing)
+		}
+
+		if row.round > 1 {
+
+			first, firstDecided := winners[row.round-1][row.position*2]
+			second, secondDecided := winners[row.round-1][row.position*2+1]
+
+			if firstDecided && secondDecided {
+
+				if second.seed < first.seed {
+					first, second = second, first
+				}
+
+				row.higherSeed, row.higherSeedTeamId = first.seed, first.teamId
+				row.lowerSeed, row.lowerSeedTeamId = second.seed, second.teamId
+			}
+		}
+
+		matchup := &pb.BracketSeries{
+			SeriesId: row.seriesId,
+			Round:    row.round,
+			Position: row.position,
+			BestOf:   row.bestOf,
+			GameIds:  make([]int32, 0),
+		}
+
+		if row.higherSeedTeamId != 0 && row.lowerSeedTeamId != 0 {
+			matchup.HigherSeed = &pb.Team{TeamId: row.higherSeedTeamId, Name: teamNames[row.higherSeedTeamId]}
+			matchup.HigherSeedNumber = row.higherSeed
+			matchup.LowerSeed = &pb.Team{TeamId: row.lowerSeedTeamId, Name: teamNames[row.lowerSeedTeamId]}
+			matchup.LowerSeedNumber = row.lowerSeed
+		}
+
+		for _, game := range gamesBySeries[row.seriesId] {
+
+			matchup.GameIds = append(matchup.GameIds, game.gameId)
+
+			if game.status != "final" && game.status != "forfeit" {
+				continue
+			}
+
+			var winnerTeamId int32
+
+			switch {
+			case game.homePoints > game.awayPoints:
+				winnerTeamId = game.homeTeamId
+			case game.awayPoints > game.homePoints:
+				winnerTeamId = game.awayTeamId
+			}
+
+			switch {
+			case winnerTeamId == 0:
+			case winnerTeamId == row.higherSeedTeamId:
+				matchup.HigherSeedWins++
+			case winnerTeamId == row.lowerSeedTeamId:
+				matchup.LowerSeedWins++
+			}
+		}
+
+		needed := row.bestOf/2 + 1
+
+		switch {
+		case matchup.HigherSeed == nil:
+		case matchup.HigherSeedWins >= needed:
+			matchup.WinnerTeamId = row.higherSeedTeamId
+			winners[row.round][row.position] = advancing{teamId: row.higherSeedTeamId, seed: row.higherSeed}
+		case matchup.LowerSeedWins >= needed:
+			matchup.WinnerTeamId = row.lowerSeedTeamId
+			winners[row.round][row.position] = advancing{teamId: row.lowerSeedTeamId, seed: row.lowerSeed}
+		}
+
+		if len(bracket.Rounds) < int(row.round) {
+			bracket.Rounds = append(bracket.Rounds, &pb.BracketRound{
+				Round:  row.round,
+				Series: make([]*pb.BracketSeries, 0),
+			})
+		}
+
+		bracketRound := bracket.Rounds[row.round-1]
+		bracketRound.Series = append(bracketRound.Series, matchup)
+	}
+
+	/* the last round is the final */
+	if len(bracket.Rounds) > 0 {
+
+		final := bracket.Rounds[len(bracket.Rounds)-1]
+
+		if len(final.Series) == 1 {
+			bracket.ChampionTeamId = final.Series[0].WinnerTeamId
+		}
+	}
+
+	return bracket
+}
+
+/* seeds the competition's finals from the top of its standings, the first round is set and the rest follow from results */
+func (database *HeroBallDatabase) CreateBracket(ctx context.Context, request *pb.CreateBracketRequest) (*pb.Bracket, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetCompetitionId() <= 0 {
+		violations.add("CompetitionId", "Must be greater than zero")
+	}
+
+	var rounds int32
+
+	switch request.GetTeamCount() {
+	case 2:
+		rounds = 1
+	case 4:
+		rounds = 2
+	case 8:
+		rounds = 3
+	case 16:
+		rounds = 4
+	default:
+		violations.add("TeamCount", "Must be 2, 4, 8 or 16")
+	}
+
+	if rounds > 0 && int32(len(request.GetBestOf())) > rounds {
+		violations.add("BestOf", "Has %v entries but a field of %v only has %v rounds", len(request.GetBestOf()), request.GetTeamCount(), rounds)
+	}
+
+	for i, bestOf := range request.GetBestOf() {
+		if bestOf < 1 || bestOf%2 == 0 {
+			violations.add(fmt.Sprintf("BestOf[%v]", i), "Must be an odd number of games")
+		}
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	_, err := database.getCompetitionById(ctx, request.GetCompetitionId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := database.getStandingsForCompetition(ctx, request.GetCompetitionId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if int32(len(standings)) < request.GetTeamCount() {
+		return nil, invalidArgument("TeamCount", "Competition %v only has %v teams", request.GetCompetitionId(), len(standings))
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var exists bool
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			EXISTS (SELECT 1 FROM BracketSeries WHERE CompetitionId = $1)`,
+		request.GetCompetitionId()).Scan(&exists)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for a bracket: %w", err)
+	}
+
+	if exists {
+		violations.add("CompetitionId", "Competition %v already has a bracket", request.GetCompetitionId())
+		return nil, violations.errWithCode(codes.AlreadyExists, "Bracket already exists")
+	}
+
+	seeds := bracketSeedOrder(int(request.GetTeamCount()))
+
+	for round := int32(1); round <= rounds; round++ {
+
+		bestOf := int32(1)
+
+		if int(round) <= len(request.GetBestOf()) {
+			bestOf = request.GetBestOf()[round-1]
+		}
+
+		seriesCount := request.GetTeamCount() >> round
+
+		for position := int32(0); position < seriesCount; position++ {
+
+			row := seriesRow{
+				round:    round,
+				position: position,
+				bestOf:   bestOf,
+			}
+
+			if round == 1 {
+
+				higherSeed, lowerSeed := seeds[position*2], seeds[position*2+1]
+
+				if lowerSeed < higherSeed {
+					higherSeed, lowerSeed = lowerSeed, higherSeed
+				}
+
+				row.higherSeed, row.higherSeedTeamId = higherSeed, standings[higherSeed-1].GetTeam().GetTeamId()
+				row.lowerSeed, row.lowerSeedTeamId = lowerSeed, standings[lowerSeed-1].GetTeam().GetTeamId()
+			}
+
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO BracketSeries (
+					CompetitionId,
+					Round,
+					Position,
+					BestOf,
+					HigherSeed,
+					HigherSeedTeamId,
+					LowerSeed,
+					LowerSeedTeamId)
+				VALUES
+					($1, $2, $3, $4, NULLIF($5, 0), NULLIF($6, 0), NULLIF($7, 0), NULLIF($8, 0))`,
+				request.GetCompetitionId(),
+				row.round,
+				row.position,
+				row.bestOf,
+				row.higherSeed,
+				row.higherSeedTeamId,
+				row.lowerSeed,
+				row.lowerSeedTeamId)
+
+			if err != nil {
+				return nil, writeError(fmt.Sprintf("Error inserting round %v series %v", round, position), err)
+			}
+		}
+	}
+
+	bracket, err := getBracketInTx(ctx, tx, request.GetCompetitionId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing bracket", err)
+	}
+
+	log.Printf("Created a %v team bracket for competition %v", request.GetTeamCount(), request.GetCompetitionId())
+
+	return bracket, nil
+}
+
+func (database *HeroBallDatabase) GetBracket(ctx context.Context, request *pb.GetBracketRequest) (*pb.Bracket, error) {
+
+	if request.GetCompetitionId() <= 0 {
+		return nil, invalidArgument("CompetitionId", "Must be greater than zero")
+	}
+
+	/* one snapshot so the series and their games agree */
+	tx, err := database.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	return getBracketInTx(ctx, tx, request.GetCompetitionId())
+}
+
+func getBracketInTx(ctx context.Context, tx *sql.Tx, competitionId int32) (*pb.Bracket, error) {
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			BracketSeries.SeriesId,
+			BracketSeries.Round,
+			BracketSeries.Position,
+			BracketSeries.BestOf,
+			COALESCE(BracketSeries.HigherSeed, 0),
+			COALESCE(BracketSeries.HigherSeedTeamId, 0),
+			COALESCE(HigherSeedTeams.Name, ''),
+			COALESCE(BracketSeries.LowerSeed, 0),
+			COALESCE(BracketSeries.LowerSeedTeamId, 0),
+			COALESCE(LowerSeedTeams.Name, '')
+		FROM
+			BracketSeries
+		LEFT JOIN
+			Teams HigherSeedTeams ON BracketSeries.HigherSeedTeamId = HigherSeedTeams.TeamId
+		LEFT JOIN
+			Teams LowerSeedTeams ON BracketSeries.LowerSeedTeamId = LowerSeedTeams.TeamId
+		WHERE
+			BracketSeries.CompetitionId = $1`,
+		competitionId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting bracket series: %w", err)
+	}
+
+	defer rows.Close()
+
+	series := make([]seriesRow, 0)
+
+	/* every team in the bracket starts in the first round */
+	teamNames := make(map[int32]string)
+
+	for rows.Next() {
+
+		var row seriesRow
+		var higherSeedName string
+		var lowerSeedName string
+
+		err = rows.Scan(
+			&row.seriesId,
+			&row.round,
+			&row.position,
+			&row.bestOf,
+			&row.higherSeed,
+			&row.higherSeedTeamId,
+			&higherSeedName,
+			&row.lowerSeed,
+			&row.lowerSeedTeamId,
+			&lowerSeedName)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning bracket series: %w", err)
+		}
+
+		teamNames[row.higherSeedTeamId] = higherSeedName
+		teamNames[row.lowerSeedTeamId] = lowerSeedName
+
+		series = append(series, row)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	if len(series) == 0 {
+		return nil, notFound("bracket", competitionId)
+	}
+
+	games, err := getSeriesGamesInTx(ctx, tx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveBracket(competitionId, series, games, teamNames), nil
+}
+
+/* the playoff games of the competition in the order they were played */
+func getSeriesGamesInTx(ctx context.Context, tx *sql.Tx, competitionId int32) ([]seriesGame, error) {
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			Games.SeriesId,
+			Games.GameId,
+			Games.HomeTeamId,
+			Games.AwayTeamId,
+			Games.Status,
+			`+teamPoints("Games.HomeTeamId")+`,
+			`+teamPoints("Games.AwayTeamId")+`
+		FROM
+			Games
+		JOIN
+			BracketSeries ON Games.SeriesId = BracketSeries.SeriesId
+		WHERE
+			BracketSeries.CompetitionId = $1
+		ORDER BY
+			Games.GameTime ASC,
+			Games.GameId ASC`,
+		competitionId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting series games: %w", err)
+	}
+
+	defer rows.Close()
+
+	games := make([]seriesGame, 0)
+
+	for rows.Next() {
+
+		var game seriesGame
+
+		err = rows.Scan(
+			&game.seriesId,
+			&game.gameId,
+			&game.homeTeamId,
+			&game.awayTeamId,
+			&game.status,
+			&game.homePoints,
+			&game.awayPoints)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning series game: %w", err)
+		}
+
+		games = append(games, game)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return games, nil
+}
+
+/*
+ * a playoff game has to be in its series' competition and between its two
+ * teams, which for later rounds means the series before it are decided. Once
+ * a series is won it takes no more games, gameId is 0 for a new game
+ */
+func validateSeriesGameInTx(ctx context.Context, tx *sql.Tx, game gameRow, gameId int32) error {
+
+	violations := fieldViolations{}
+
+	var competitionId int32
+
+	/* one game added to a series at a time */
+	err := tx.QueryRowContext(ctx, `
+		SELECT
+			CompetitionId
+		FROM
+			BracketSeries
+		WHERE
+			SeriesId = $1
+		FOR UPDATE`,
+		game.seriesId).Scan(&competitionId)
+
+	if err == sql.ErrNoRows {
+		return notFound("seriesId", game.seriesId)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error getting series: %w", err)
+	}
+
+	if competitionId != game.competitionId {
+		return invalidArgument("SeriesId", "Series %v is in competition %v", game.seriesId, competitionId)
+	}
+
+	bracket, err := getBracketInTx(ctx, tx, competitionId)
+
+	if err != nil {
+		return err
+	}
+
+	var series *pb.BracketSeries
+
+	for _, bracketRound := range bracket.GetRounds() {
+		for _, matchup := range bracketRound.GetSeries() {
+			if matchup.GetSeriesId() == game.seriesId {
+				series = matchup
+			}
+		}
+	}
+
+	if series == nil {
+		return fmt.Errorf("Series %v missing from its bracket", game.seriesId)
+	}
+
+	if series.GetHigherSeed() == nil {
+		violations.add("SeriesId", "The teams in series %v are not decided yet", game.seriesId)
+		return violations.errWithCode(codes.FailedPrecondition, "Series teams are not decided")
+	}
+
+	higherSeedTeamId := series.GetHigherSeed().GetTeamId()
+	lowerSeedTeamId := series.GetLowerSeed().GetTeamId()
+
+	if !(game.homeTeamId == higherSeedTeamId && game.awayTeamId == lowerSeedTeamId) &&
+		!(game.homeTeamId == lowerSeedTeamId && game.awayTeamId == higherSeedTeamId) {
+		return invalidArgument("HomeTeamId", "Series %v is between teams %v and %v", game.seriesId, higherSeedTeamId, lowerSeedTeamId)
+	}
+
+	if series.GetWinnerTeamId() == 0 {
+		return nil
+	}
+
+	for _, seriesGameId := range series.GetGameIds() {
+		if gameId != 0 && seriesGameId == gameId {
+			return nil
+		}
+	}
+
+	violations.add("SeriesId", "Series %v has already been won by team %v", game.seriesId, series.GetWinnerTeamId())
+	return violations.errWithCode(codes.FailedPrecondition, "Series is decided")
+}
@@ -0,0 +1,220 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBracketSeedOrder(t *testing.T) {
+
+	tests := []struct {
+		count int
+		seeds []int32
+	}{
+		{2, []int32{1, 2}},
+		{4, []int32{1, 4, 2, 3}},
+		{8, []int32{1, 8, 4, 5, 2, 7, 3, 6}},
+		{16, []int32{1, 16, 8, 9, 4, 13, 5, 12, 2, 15, 7, 10, 3, 14, 6, 11}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v teams", test.count), func(t *testing.T) {
+
+			seeds := bracketSeedOrder(test.count)
+
+			if !reflect.DeepEqual(seeds, test.seeds) {
+				t.Errorf("Got %v, want %v", seeds, test.seeds)
+			}
+		})
+	}
+}
+
+/* four teams, seeds 1 to 4 are teams 10 to 40, best of 3 throughout */
+func testBracketSeries() []seriesRow {
+	return []seriesRow{
+		{seriesId: 3, round: 2, position: 0, bestOf: 3},
+		{seriesId: 1, round: 1, position: 0, bestOf: 3, higherSeed: 1, higherSeedTeamId: 10, lowerSeed: 4, lowerSeedTeamId: 40},
+		{seriesId: 2, round: 1, position: 1, bestOf: 3, higherSeed: 2, higherSeedTeamId: 20, lowerSeed: 3, lowerSeedTeamId: 30},
+	}
+}
+
+/* a final game in the series won by winner over loser, home first */
+func testSeriesGame(seriesId int32, gameId int32, winner int32, loser int32) seriesGame {
+	return seriesGame{
+		seriesId:   seriesId,
+		gameId:     gameId,
+		homeTeamId: winner,
+		awayTeamId: loser,
+		status:     "final",
+		homePoints: 80,
+		awayPoints: 70,
+	}
+}
+
+func TestResolveBracket(t *testing.T) {
+
+	games := []seriesGame{
+		testSeriesGame(1, 1, 10, 40),
+		testSeriesGame(1, 2, 40, 10),
+		testSeriesGame(2, 3, 30, 20),
+		/* unplayed and drawn games don't count towards a series */
+		{seriesId: 1, gameId: 4, homeTeamId: 10, awayTeamId: 40, status: "scheduled"},
+		{seriesId: 1, gameId: 5, homeTeamId: 10, awayTeamId: 40, status: "final", homePoints: 70, awayPoints: 70},
+	}
+
+	bracket := resolveBracket(1, testBracketSeries(), games, map[int32]string{10: "Ten"})
+
+	if len(bracket.GetRounds()) != 2 || len(bracket.GetRounds()[0].GetSeries()) != 2 {
+		t.Fatalf("Got bracket %v", bracket)
+	}
+
+	first := bracket.GetRounds()[0].GetSeries()[0]
+
+	if first.GetHigherSeedWins() != 1 || first.GetLowerSeedWins() != 1 || first.GetWinnerTeamId() != 0 || len(first.GetGameIds()) != 4 {
+		t.Errorf("Series 1 is %v", first)
+	}
+
+	if first.GetHigherSeed().GetName() != "Ten" {
+		t.Errorf("Series 1 higher seed is %v", first.GetHigherSeed())
+	}
+
+	if bracket.GetRounds()[1].GetSeries()[0].GetHigherSeed() != nil {
+		t.Errorf("Final has teams before the semi finals are decided")
+	}
+
+	/* the lower seed takes the second semi, then the top seed wins its decider */
+	games = append(games, testSeriesGame(2, 6, 30, 20), testSeriesGame(1, 7, 10, 40))
+
+	bracket = resolveBracket(1, testBracketSeries(), games, nil)
+
+	semis := bracket.GetRounds()[0].GetSeries()
+
+	if semis[0].GetWinnerTeamId() != 10 || semis[1].GetWinnerTeamId() != 30 {
+		t.Fatalf("Semi finals were won by %v and %v, want 10 and 30", semis[0].GetWinnerTeamId(), semis[1].GetWinnerTeamId())
+	}
+
+	final := bracket.GetRounds()[1].GetSeries()[0]
+
+	if final.GetHigherSeed().GetTeamId() != 10 || final.GetHigherSeedNumber() != 1 || final.GetLowerSeed().GetTeamId() != 30 || final.GetLowerSeedNumber() != 3 {
+		t.Fatalf("Final is %v", final)
+	}
+
+	if bracket.GetChampionTeamId() != 0 {
+		t.Errorf("Champion %v before the final is played", bracket.GetChampionTeamId())
+	}
+
+	/* the lower seed winning away still counts as theirs */
+	games = append(games, testSeriesGame(3, 8, 30, 10), seriesGame{seriesId: 3, gameId: 9, homeTeamId: 10, awayTeamId: 30, status: "forfeit", homePoints: 0, awayPoints: 20})
+
+	bracket = resolveBracket(1, testBracketSeries(), games, nil)
+
+	if bracket.GetChampionTeamId() != 30 {
+		t.Errorf("Champion is %v, want 30", bracket.GetChampionTeamId())
+	}
+}
+
+/* serves the bracket to validateSeriesGameInTx */
+func fakeBracketHandler(games []seriesGame) fakeQueryHandler {
+	return func(query string, args []driver.NamedValue) ([][]driver.Value, error) {
+
+		switch {
+		case strings.HasPrefix(query, "SELECT CompetitionId FROM BracketSeries WHERE SeriesId = $1 FOR UPDATE"):
+			return [][]driver.Value{{int64(1)}}, nil
+
+		case strings.HasPrefix(query, "SELECT BracketSeries.SeriesId"):
+
+			rows := make([][]driver.Value, 0)
+
+			for _, row := range testBracketSeries() {
+				rows = append(rows, []driver.Value{
+					int64(row.seriesId),
+					int64(row.round),
+					int64(row.position),
+					int64(row.bestOf),
+					int64(row.higherSeed),
+					int64(row.higherSeedTeamId),
+					"",
+					int64(row.lowerSeed),
+					int64(row.lowerSeedTeamId),
+					"",
+				})
+			}
+
+			return rows, nil
+
+		case strings.HasPrefix(query, "SELECT Games.SeriesId"):
+
+			rows := make([][]driver.Value, 0)
+
+			for _, game := range games {
+				rows = append(rows, []driver.Value{
+					int64(game.seriesId),
+					int64(game.gameId),
+					int64(game.homeTeamId),
+					int64(game.awayTeamId),
+					game.status,
+					int64(game.homePoints),
+					int64(game.awayPoints),
+				})
+			}
+
+			return rows, nil
+		}
+
+		return nil, fmt.Errorf("Unexpected query %q", query)
+	}
+}
+
+func TestValidateSeriesGame(t *testing.T) {
+
+	/* series 1 is won 2-0 by team 10, series 2 is level */
+	games := []seriesGame{
+		testSeriesGame(1, 1, 10, 40),
+		testSeriesGame(1, 2, 10, 40),
+		testSeriesGame(2, 3, 20, 30),
+		testSeriesGame(2, 4, 30, 20),
+	}
+
+	tests := []struct {
+		name   string
+		game   gameRow
+		gameId int32
+		code   codes.Code
+	}{
+		{"series still open", gameRow{competitionId: 1, seriesId: 2, homeTeamId: 30, awayTeamId: 20}, 0, codes.OK},
+		{"series decided", gameRow{competitionId: 1, seriesId: 1, homeTeamId: 40, awayTeamId: 10}, 0, codes.FailedPrecondition},
+		{"editing a game of a decided series", gameRow{competitionId: 1, seriesId: 1, homeTeamId: 10, awayTeamId: 40}, 2, codes.OK},
+		{"moving another game into a decided series", gameRow{competitionId: 1, seriesId: 1, homeTeamId: 10, awayTeamId: 40}, 3, codes.FailedPrecondition},
+		{"teams not in the series", gameRow{competitionId: 1, seriesId: 2, homeTeamId: 10, awayTeamId: 20}, 0, codes.InvalidArgument},
+		{"final teams not decided", gameRow{competitionId: 1, seriesId: 3, homeTeamId: 10, awayTeamId: 20}, 0, codes.FailedPrecondition},
+		{"another competition", gameRow{competitionId: 2, seriesId: 2, homeTeamId: 30, awayTeamId: 20}, 0, codes.InvalidArgument},
+	}
+
+	database, _ := newFakeDatabase(t, fakeBracketHandler(games))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			tx, err := database.db.BeginTx(context.Background(), nil)
+
+			if err != nil {
+				t.Fatalf("Error starting transaction: %v", err)
+			}
+
+			defer tx.Rollback()
+
+			err = validateSeriesGameInTx(context.Background(), tx, test.game, test.gameId)
+
+			if status.Code(err) != test.code {
+				t.Errorf("Got %v, want %v", err, test.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,1040 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/lib/pq"
+	_ "github.com/lib/pq"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+type HeroBallDatabase struct {
+	connectionString string
+	db               *sql.DB
+	views            *viewRefresher
+	games            *gameFeed
+}
+
+const (
+	recentGameCount = 3
+)
+
+/* builds the postgres connection string from the POSTGRES_* environment */
+func ConnectionStringFromEnv() string {
+	return fmt.Sprintf("user=%v password=%v host=%v dbname=%v sslmode=disable", os.Getenv("POSTGRES_USER"), os.Getenv("POSTGRES_PASSWORD"), os.Getenv("POSTGRES_HOST"), os.Getenv("POSTGRES_DBNAME"))
+}
+
+func NewHeroBallDatabase(connStr string) (*HeroBallDatabase, error) {
+
+	db := &HeroBallDatabase{
+		connectionString: connStr,
+	}
+
+	err := db.connect()
+
+	if err != nil {
+		return nil, err
+	}
+
+	db.views = newViewRefresher(db.db)
+	db.games = newGameFeed()
+
+	return db, nil
+}
+
+/* keep the materialized views up to date with writes from any client */
+func (database *HeroBallDatabase) StartViewRefresher(refreshDebounce time.Duration, refreshMaxDelay time.Duration) error {
+	return database.views.start(database.connectionString, refreshDebounce, refreshMaxDelay)
+}
+
+/* lets StreamGame follow changes made by any client */
+func (database *HeroBallDatabase) StartGameFeed() error {
+	return database.games.start(database.connectionString)
+}
+
+/* checks postgres is reachable */
+func (database *HeroBallDatabase) Ping(ctx context.Context) error {
+	return database.db.PingContext(ctx)
+}
+
+/* stops the view refresher and closes the connection pool */
+func (database *HeroBallDatabase) Close() error {
+
+	err := database.views.stop()
+
+	if err != nil {
+		log.Printf("Error stopping view refresher: %v", err)
+	}
+
+	err = database.games.stop()
+
+	if err != nil {
+		log.Printf("Error stopping game feed: %v", err)
+	}
+
+	return database.db.Close()
+}
+
+func (database *HeroBallDatabase) connect() error {
+
+	db, err := sql.Open("postgres", database.connectionString)
+	if err != nil {
+		return err
+	}
+	database.db = db
+	return nil
+}
+
+/* competitionId is optional, without it the team's latest competition is used */
+func (database *HeroBallDatabase) GetTeamInfo(ctx context.Context, teamId int32, competitionId int32) (*pb.TeamInfo, error) {
+
+	if teamId <= 0 {
+		return nil, invalidArgument("TeamId", "Must be greater than zero")
+	}
+
+	if competitionId < 0 {
+		return nil, invalidArgument("CompetitionId", "Must be zero or greater")
+	}
+
+	teamInfo := &pb.TeamInfo{}
+
+	team, err := database.getTeamById(ctx, teamId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	teamInfo.Team = team
+
+	gamesFilter := &pb.GamesFilter{
+		TeamIds: []int32{teamId},
+	}
+
+	if competitionId > 0 {
+		gamesFilter.CompetitionIds = []int32{competitionId}
+	}
+
+	gameCursor, err := database.GetGamesCursor(ctx, 0, recentGameCount, gamesFilter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	teamInfo.RecentGames = gameCursor
+
+	var maxTeamSize int32 = 30
+
+	playersCursor, err := database.GetPlayersCursor(ctx, 0, maxTeamSize, &pb.PlayersFilter{
+		TeamIds: []int32{teamId},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	teamInfo.Players = playersCursor
+
+	/* get the comp */
+	if competitionId > 0 {
+
+		entered, err := database.isTeamInCompetition(ctx, teamId, competitionId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !entered {
+			return nil, invalidArgument("CompetitionId", "Team %v has not entered competition %v", teamId, competitionId)
+		}
+
+	} else {
+
+		competitionId, err = database.getCompetitionForTeam(ctx, teamId)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if competitionId == 0 {
+			return teamInfo, nil
+		}
+	}
+
+	competition, err := database.getCompetitionById(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	teamInfo.Competition = competition
+
+	roster, err := database.getRosterForTeamInCompetition(ctx, teamId, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	teamInfo.Roster = roster
+
+	return teamInfo, nil
+}
+
+func (database *HeroBallDatabase) GetCompetitionInfo(ctx context.Context, competitionId int32) (*pb.CompetitionInfo, error) {
+
+	if competitionId <= 0 {
+		return nil, invalidArgument("CompetitionId", "Must be greater than zero")
+	}
+
+	compInfo := &pb.CompetitionInfo{}
+
+	/* now fill it out */
+	comp, err := database.getCompetitionById(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compInfo.Competition = comp
+
+	/* now get locations */
+	locationIds, err := database.getCompetitionLocationIds(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := database.getLocations(ctx, locationIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compInfo.Locations = locations
+
+	getOrderedteams, err := database.getStandingsForCompetition(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compInfo.Teams = getOrderedteams
+
+	rules, err := database.getStandingsRules(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compInfo.StandingsRules = rules
+
+	awards, err := database.getAwardsForCompetition(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compInfo.Awards = awards
+
+	gameCursor, err := database.GetGamesCursor(ctx, 0, recentGameCount, &pb.GamesFilter{
+		CompetitionIds: []int32{competitionId},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	compInfo.RecentGames = gameCursor
+
+	firstGame, lastGame, err := database.getFirstAndLastGameForCompetitionId(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	compInfo.FirstGameTime = firstGame
+	compInfo.LastGameTime = lastGame
+
+	return compInfo, nil
+}
+
+func (database *HeroBallDatabase) GetPlayerAverageStats(ctx context.Context, request *pb.GetPlayerAverageStatsRequest) (*pb.GetPlayerAverageStatsResponse, error) {
+
+	forRequest := &pb.ForStatsRequest{
+		CompetitionIds: make([]int32, 0),
+		TeamIds:        make([]int32, 0),
+		PlayerIds:      make([]int32, 0),
+	}
+
+	againstRequest := &pb.AgainstStatsRequest{
+		CompetitionIds: make([]int32, 0),
+		TeamIds:        make([]int32, 0),
+	}
+
+	if request.GetFor() != nil {
+		forRequest = request.GetFor()
+	}
+
+	if request.GetAgainst() != nil {
+		againstRequest = request.GetAgainst()
+	}
+
+	ordering, err := getStatsOrdering(request.GetOrdering())
+
+	if err != nil {
+		return nil, err
+	}
+
+	phaseCondition, err := getPhaseCondition(request.GetPhase())
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Got a stats request: for %+v, against: %+v, ordering %v", forRequest, againstRequest, request.GetOrdering())
+
+	combinedCompIds := append(forRequest.CompetitionIds, againstRequest.CompetitionIds...)
+	combinedTeamIds := append(forRequest.TeamIds, againstRequest.TeamIds...)
+
+	/* we need to get stats leaders */
+	leaders, playerIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
+		`(cardinality($1::int[]) IS NULL OR Games.CompetitionId = ANY($1)) AND
+		(cardinality($2::int[]) IS NULL OR NOT (PlayerGameStats.TeamId = ANY($2))) AND
+		(cardinality($3::int[]) IS NULL OR Games.HomeTeamId = ANY($3) OR Games.AwayTeamId = ANY($3)) AND
+		(cardinality($4::int[]) IS NULL OR PlayerGameStats.TeamId = ANY($4)) AND
+		(cardinality($5::int[]) IS NULL OR PlayerGameStats.PlayerId = ANY($5))`+phaseCondition,
+		[]interface{}{
+			pq.Array(combinedCompIds),
+			pq.Array(againstRequest.TeamIds),
+			pq.Array(combinedTeamIds),
+			pq.Array(forRequest.TeamIds),
+			pq.Array(forRequest.PlayerIds)},
+		"GROUP BY PlayerGameStats.PlayerId",
+		"PlayerGameStats.PlayerId",
+		"HAVING COUNT(DISTINCT PlayerGameStats.GameId) >= $6",
+		[]interface{}{request.MinimumGames},
+		ordering,
+		request.GetCount(),
+		request.GetOffset())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(playerIds) < 1 {
+		return &pb.GetPlayerAverageStatsResponse{}, nil
+	}
+
+	players, err := database.getPlayersById(ctx, playerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	playerMap := make(map[int32]*pb.Player)
+
+	/* into map */
+	for _, p := range players {
+		playerMap[p.PlayerId] = p
+	}
+
+	playerStats := make([]*pb.PlayerAggregateStats, 0)
+
+	for i, playerStatLine := range leaders {
+		playerStats = append(playerStats, &pb.PlayerAggregateStats{
+			Player: playerMap[playerIds[i]],
+			Stats:  playerStatLine,
+		})
+	}
+
+	return &pb.GetPlayerAverageStatsResponse{
+		AggregateStats: playerStats,
+	}, nil
+}
+
+func (database *HeroBallDatabase) GetTeamAverageStats(ctx context.Context, request *pb.GetTeamAverageStatsRequest) (*pb.GetTeamAverageStatsResponse, error) {
+
+	/* PAPG and OPPFG rank the opponent aggregate, we then fill in the team's own */
+	ordering, byOpponent := getTeamOpponentOrdering(request.GetOrdering())
+
+	if !byOpponent {
+
+		teamOrdering, err := getStatsOrdering(request.GetOrdering())
+
+		if err != nil {
+			return nil, err
+		}
+
+		ordering = teamOrdering
+	}
+
+	phaseCondition, err := getPhaseCondition(request.GetPhase())
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Got a team stats request: for %+v, against: %+v, ordering %v", request.GetFor(), request.GetAgainst(), request.GetOrdering())
+
+	args := []interface{}{
+		pq.Array(append(request.GetFor().GetCompetitionIds(), request.GetAgainst().GetCompetitionIds()...)),
+		pq.Array(request.GetAgainst().GetTeamIds()),
+		pq.Array(request.GetFor().GetTeamIds()),
+		pq.Array(request.GetFor().GetPlayerIds())}
+
+	rankedKey, otherKey := "PlayerGameStats.TeamId", opponentTeamKey
+
+	if byOpponent {
+		rankedKey, otherKey = opponentTeamKey, "PlayerGameStats.TeamId"
+	}
+
+	ranked, teamIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
+		getTeamStatsCondition(rankedKey, otherKey)+phaseCondition,
+		args,
+		fmt.Sprintf("GROUP BY %v", rankedKey),
+		rankedKey,
+		"HAVING COUNT(DISTINCT PlayerGameStats.GameId) >= $5",
+		[]interface{}{request.MinimumGames},
+		ordering,
+		request.GetCount(),
+		request.GetOffset())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(teamIds) < 1 {
+		return &pb.GetTeamAverageStatsResponse{}, nil
+	}
+
+	/* and the other side of the same games, for just this page of teams */
+	other, otherTeamIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
+		getTeamStatsCondition(otherKey, rankedKey)+phaseCondition+fmt.Sprintf(" AND %v = ANY($5)", otherKey),
+		append(args, pq.Array(teamIds)),
+		fmt.Sprintf("GROUP BY %v", otherKey),
+		otherKey,
+		"",
+		nil,
+		"",
+		int32(len(teamIds)),
+		0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	otherMap := make(map[int32]*pb.Stats)
+
+	for i, stats := range other {
+		otherMap[otherTeamIds[i]] = stats
+	}
+
+	teams, err := database.getTeamsById(ctx, teamIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	teamMap := make(map[int32]*pb.Team)
+
+	for _, t := range teams {
+		teamMap[t.TeamId] = t
+	}
+
+	teamStats := make([]*pb.TeamAggregateStats, 0)
+
+	for i, teamId := range teamIds {
+
+		aggregate := &pb.TeamAggregateStats{
+			Team:          teamMap[teamId],
+			Stats:         ranked[i],
+			OpponentStats: otherMap[teamId],
+		}
+
+		if byOpponent {
+			aggregate.Stats, aggregate.OpponentStats = otherMap[teamId], ranked[i]
+		}
+
+		teamStats = append(teamStats, aggregate)
+	}
+
+	return &pb.GetTeamAverageStatsResponse{
+		AggregateStats: teamStats,
+	}, nil
+}
+
+func (database *HeroBallDatabase) GetHeroBallMetadata(ctx context.Context, request *pb.GetHeroBallMetadataRequest) (*pb.HeroBallMetadata, error) {
+
+	md := &pb.HeroBallMetadata{}
+
+	if request.Competitions {
+		/* we need competitions, teams, players */
+		competitions, err := database.getAllCompetitions(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error getting competitions: %w", err)
+		}
+
+		md.Competitions = competitions
+	}
+
+	if request.Teams {
+		teams, err := database.getAllTeams(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error getting teams: %w", err)
+		}
+		md.Teams = teams
+	}
+
+	if request.Players {
+		players, err := database.getAllPlayers(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error getting players: %w", err)
+		}
+
+		md.Players = players
+	}
+
+	return md, nil
+}
+
+func (database *HeroBallDatabase) GetGameInfo(ctx context.Context, gameId int32) (*pb.GameInfo, error) {
+
+	if gameId <= 0 {
+		return nil, invalidArgument("GameId", "Must be greater than zero")
+	}
+
+	gameInfo := &pb.GameInfo{}
+
+	game, err := database.getGameById(ctx, gameId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting game: %w", err)
+	}
+
+	/* get players in the game */
+	playerIds, err := database.getPlayersInGame(ctx, gameId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting players in game: %w", err)
+	}
+
+	players := make([]*pb.PlayerGameStats, 0)
+
+	for _, playerId := range playerIds {
+
+		playerStat, err := database.getPlayerStatsForGame(ctx, playerId, gameId)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error getting player stats: %w", err)
+		}
+
+		players = append(players, playerStat)
+	}
+
+	periods, flow, err := database.getGamePeriods(ctx, game)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting game periods: %w", err)
+	}
+
+	awards, err := database.getGameAwards(ctx, game)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting game awards: %w", err)
+	}
+
+	gameInfo.Game = game
+	gameInfo.PlayerStats = players
+	gameInfo.PeriodScores = periods
+	gameInfo.Flow = flow
+	gameInfo.Awards = awards
+
+	return gameInfo, nil
+}
+
+func (database *HeroBallDatabase) GetPlayerInfo(ctx context.Context, playerId int32) (*pb.PlayerInfo, error) {
+
+	if playerId <= 0 {
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
+	}
+
+	info := &pb.PlayerInfo{
+		PlayerId: playerId,
+		Teams:    make([]*pb.PlayerTeam, 0),
+	}
+
+	profile, err := database.getPlayerProfile(ctx, playerId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting player profile: %w", err)
+	}
+
+	info.Profile = profile
+
+	teams, err := database.getAllTeamsForPlayer(ctx, playerId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting teams for player: %w", err)
+	}
+
+	info.Teams = teams
+
+	/* TODO - we could do this client side with team stats */
+	totalStats, err := database.getPlayerTotalStatsForAllTime(ctx, playerId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting all stats for player: %w", err)
+	}
+
+	info.AggregateStats = totalStats
+
+	gameCursor, err := database.GetGamesCursor(ctx, 0, recentGameCount, &pb.GamesFilter{
+		PlayerIds: []int32{playerId},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	info.RecentGames = gameCursor
+
+	recentStats := make([]*pb.PlayerGameStats, 0)
+
+	/* if there are recent games, get player stats too */
+	if len(info.RecentGames.Games) != 0 {
+		for _, game := range info.RecentGames.Games {
+
+			playerStats, err := database.getPlayerStatsForGame(ctx, playerId, game.GameId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			recentStats = append(recentStats, playerStats)
+		}
+
+		info.RecentStats = recentStats
+	}
+
+	info.CareerHighs, err = database.getCareerHighs(ctx, playerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info.DoubleDoubles, info.TripleDoubles, err = database.getDoubleDoubles(ctx, playerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info.Awards, err = database.getPlayerAwards(ctx, playerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (database *HeroBallDatabase) GetPlayersCursor(ctx context.Context, offset int32, count int32, filter *pb.PlayersFilter) (*pb.PlayersCursor, error) {
+
+	if offset < 0 {
+		return nil, invalidArgument("Offset", "Must be zero or greater")
+	}
+
+	if count <= 0 {
+		return nil, invalidArgument("Count", "Must be greater than zero")
+	}
+
+	var totalPlayers int32
+
+	err := database.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(DISTINCT Players.PlayerId)
+		FROM
+			Players
+		LEFT JOIN
+			PlayerGameStats ON Players.PlayerId = PlayerGameStats.PlayerId
+		LEFT JOIN
+			Games ON PlayerGameStats.GameId = Games.GameId
+		WHERE
+			(cardinality($1::int[]) IS NULL OR Games.CompetitionId = ANY($1)) AND
+			(cardinality($2::int[]) IS NULL OR PlayerGameStats.TeamId = ANY($2))`,
+		pq.Array(filter.GetCompetitionIds()),
+		pq.Array(filter.GetTeamIds())).Scan(&totalPlayers)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting player count for cursor: %w", err)
+	}
+
+	/* if the count is less than offset, return */
+	if offset > totalPlayers {
+		return nil, outOfRange("Offset", "Offset %v is past the end of the %v results", offset, totalPlayers)
+	}
+
+	/* if no matches, return */
+	if totalPlayers == 0 {
+		log.Printf("Returning 0 players for filter %v", filter)
+		return &pb.PlayersCursor{
+			Filter: filter,
+			Total:  0,
+		}, nil
+	}
+
+	/* get the playerIds */
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			DISTINCT
+			Players.PlayerId,
+			Players.Name
+		FROM
+			Players
+		LEFT JOIN
+			PlayerGameStats ON Players.PlayerId = PlayerGameStats.PlayerId
+		LEFT JOIN
+			Games ON PlayerGameStats.GameId = Games.GameId
+		WHERE
+			(cardinality($1::int[]) IS NULL OR Games.CompetitionId = ANY($1)) AND
+			(cardinality($2::int[]) IS NULL OR PlayerGameStats.TeamId = ANY($2))
+		ORDER BY
+			Players.Name DESC
+		LIMIT $3
+		OFFSET $4
+		`,
+		pq.Array(filter.GetCompetitionIds()),
+		pq.Array(filter.GetTeamIds()),
+		count,
+		offset)
+
+	/* this shouldn't hit as we previously did a count */
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("Count mismatch error 63")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting players: %w", err)
+	}
+
+	/* otherwise scan the players required */
+	playerIds := make([]int32, 0)
+
+	for rows.Next() {
+
+		var playerId int32
+		var name string
+
+		err = rows.Scan(&playerId, &name)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning players: %w", err)
+		}
+
+		playerIds = append(playerIds, playerId)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	/* now lets get the players */
+	/* TODO work out what this call should be... */
+	players, err := database.getPlayersById(ctx, playerIds)
+
+	/* return offset and gameIds len */
+	if err != nil {
+		return nil, err
+	}
+
+	/* calculate next offset */
+	nextOffset := offset + int32(len(players))
+
+	if nextOffset > totalPlayers {
+		nextOffset = totalPlayers
+	}
+
+	log.Printf("Returning %v players for request from filter %+v for count %v from offset %v", len(players), filter, count, offset)
+
+	return &pb.PlayersCursor{
+		Total:      totalPlayers,
+		NextOffset: nextOffset,
+		Players:    players,
+		Filter:     filter,
+	}, nil
+}
+
+/* TODO seperate query if null filter, will be much cheaper */
+func (database *HeroBallDatabase) GetGamesCursor(ctx context.Context, offset int32, count int32, filter *pb.GamesFilter) (*pb.GamesCursor, error) {
+
+	/* get the count across the filter */
+	if offset < 0 {
+		return nil, invalidArgument("Offset", "Must be zero or greater")
+	}
+
+	if count <= 0 {
+		return nil, invalidArgument("Count", "Must be greater than zero")
+	}
+
+	for i, gameStatus := range filter.GetStatuses() {
+		if !gameStatuses[gameStatus] {
+			return nil, invalidArgument(fmt.Sprintf("Filter.Statuses[%v]", i), "Unrecognised status: %q", gameStatus)
+		}
+	}
+
+	phaseCondition, err := getPhaseCondition(filter.GetPhase())
+
+	if err != nil {
+		return nil, invalidArgument("Filter.Phase", "Unrecognised phase: %v", filter.GetPhase())
+	}
+
+	if len(filter.GetOpponentTeamIds()) > 0 && len(filter.GetTeamIds()) == 0 {
+		return nil, invalidArgument("Filter.OpponentTeamIds", "Must be given with TeamIds")
+	}
+
+	if len(filter.GetOpponentPlayerIds()) > 0 && len(filter.GetPlayerIds()) == 0 {
+		return nil, invalidArgument("Filter.OpponentPlayerIds", "Must be given with PlayerIds")
+	}
+
+	/* lets validate any dates */
+	date := filter.GetDate()
+	dateParsed := pq.NullTime{}
+
+	if date != nil {
+		pDate, err := time.Parse("2006-01-02", fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day))
+
+		if err != nil {
+			return nil, invalidArgument("Filter.Date", "Not a valid date: %v", err)
+		}
+
+		dateParsed.Time = pDate
+		dateParsed.Valid = true
+	}
+
+	var totalGames int32
+
+	/* get the count - potentially expensive for each cursor page... */
+	err = database.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(DISTINCT Games.GameId)
+		FROM
+			Games
+		LEFT JOIN
+			PlayerGameStats ON Games.GameId = PlayerGameStats.GameId
+		WHERE
+			(cardinality($1::int[]) IS NULL OR Games.CompetitionId = ANY($1)) AND
+			(cardinality($2::int[]) IS NULL OR PlayerGameStats.PlayerId = ANY($2)) AND
+			(cardinality($3::int[]) IS NULL OR (Games.HomeTeamId = ANY($3) OR Games.AwayTeamId = ANY($3))) AND
+			(COALESCE($4 > current_timestamp) IS NULL OR (Games.GameTime >= $4 AND Games.GameTime < $4 + interval '1 day')) AND
+			(cardinality($5::text[]) IS NULL OR Games.Status::text = ANY($5)) AND
+			(cardinality($6::int[]) IS NULL OR (Games.HomeTeamId = ANY($3) AND Games.AwayTeamId = ANY($6)) OR (Games.AwayTeamId = ANY($3) AND Games.HomeTeamId = ANY($6))) AND
+			(cardinality($7::int[]) IS NULL OR EXISTS (
+				SELECT 1 FROM PlayerGameStats Opponents
+				WHERE Opponents.GameId = Games.GameId AND Opponents.PlayerId = ANY($7) AND Opponents.TeamId <> PlayerGameStats.TeamId))`+phaseCondition,
+		pq.Array(filter.GetCompetitionIds()),
+		pq.Array(filter.GetPlayerIds()),
+		pq.Array(filter.GetTeamIds()),
+		dateParsed,
+		pq.Array(filter.GetStatuses()),
+		pq.Array(filter.GetOpponentTeamIds()),
+		pq.Array(filter.GetOpponentPlayerIds())).Scan(&totalGames)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting game count for cursor: %w", err)
+	}
+
+	/* if the count is less than offset, return */
+	if offset > totalGames {
+		return nil, outOfRange("Offset", "Offset %v is past the end of the %v results", offset, totalGames)
+	}
+
+	/* if no matches, return */
+	if totalGames == 0 {
+		log.Printf("Returning 0 games for filter %v", filter)
+		return &pb.GamesCursor{
+			Filter: filter,
+			Total:  0,
+		}, nil
+	}
+
+	/* get the gameIds */
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			DISTINCT
+			Games.GameId,
+			Games.GameTime
+		FROM
+			Games
+		LEFT JOIN
+			PlayerGameStats ON Games.GameId = PlayerGameStats.GameId
+		WHERE
+			(cardinality($1::int[]) IS NULL OR Games.CompetitionId = ANY($1)) AND
+			(cardinality($2::int[]) IS NULL OR PlayerGameStats.PlayerId = ANY($2)) AND
+			(cardinality($3::int[]) IS NULL OR (Games.HomeTeamId = ANY($3) OR Games.AwayTeamId = ANY($3))) AND
+			(COALESCE($4 > current_timestamp) IS NULL OR (Games.GameTime >= $4 AND Games.GameTime < $4 + interval '1 day')) AND
+			(cardinality($5::text[]) IS NULL OR Games.Status::text = ANY($5)) AND
+			(cardinality($6::int[]) IS NULL OR (Games.HomeTeamId = ANY($3) AND Games.AwayTeamId = ANY($6)) OR (Games.AwayTeamId = ANY($3) AND Games.HomeTeamId = ANY($6))) AND
+			(cardinality($7::int[]) IS NULL OR EXISTS (
+				SELECT 1 FROM PlayerGameStats Opponents
+				WHERE Opponents.GameId = Games.GameId AND Opponents.PlayerId = ANY($7) AND Opponents.TeamId <> PlayerGameStats.TeamId))`+phaseCondition+`
+		ORDER BY
+			Games.GameTime DESC
+		LIMIT $8
+		OFFSET $9
+	`,
+		pq.Array(filter.GetCompetitionIds()),
+		pq.Array(filter.GetPlayerIds()),
+		pq.Array(filter.GetTeamIds()),
+		dateParsed,
+		pq.Array(filter.GetStatuses()),
+		pq.Array(filter.GetOpponentTeamIds()),
+		pq.Array(filter.GetOpponentPlayerIds()),
+		count,
+		offset)
+
+	/* this shouldn't hit */
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("Count mismatch error 62")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	/* otherwise scan the gameIds required */
+	gameIds := make([]int32, 0)
+
+	for rows.Next() {
+
+		var gameId int32
+		var gameTime string
+
+		err = rows.Scan(&gameId, &gameTime)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning games: %w", err)
+		}
+
+		gameIds = append(gameIds, gameId)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	/* get the games */
+	games, err := database.getGamesById(ctx, gameIds)
+
+	/* return offset and gameIds len */
+	if err != nil {
+		return nil, err
+	}
+
+	/* calculate next offset */
+	nextOffset := offset + int32(len(games))
+
+	if nextOffset > totalGames {
+		nextOffset = totalGames
+	}
+
+	log.Printf("Returning %v games for request from filter %+v for count %v from offset %v", len(games), filter, count, offset)
+
+	return &pb.GamesCursor{
+		Total:      totalGames,
+		NextOffset: nextOffset,
+		Games:      games,
+		Filter:     filter,
+	}, nil
+}
+
+func (database *HeroBallDatabase) GetPlayerGamesStats(ctx context.Context, request *pb.GetPlayerGamesStatsRequest) (*pb.GetPlayerGamesStatsResponse, error) {
+
+	againstRequest := &pb.AgainstStatsRequest{
+		CompetitionIds: make([]int32, 0),
+		TeamIds:        make([]int32, 0),
+	}
+
+	if request.GetPlayerId() <= 0 {
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
+	}
+
+	if request.GetAgainst() != nil {
+		againstRequest = request.GetAgainst()
+	}
+
+	phaseCondition, err := getPhaseCondition(request.GetPhase())
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Got a stats request for games by player %v: against: %+v", request.GetPlayerId(), againstRequest)
+
+	stats, err := database.getPlayerGameStatsByConditionAndOffsetAndCount(ctx, `
+		PlayerGameStats.PlayerId = $1 AND 
+		(cardinality($2::int[]) IS NULL OR Games.CompetitionId = ANY($2)) AND
+		(cardinality($3::int[]) IS NULL OR Games.HomeTeamId = ANY($3) OR Games.AwayTeamId = ANY($3)) AND
+		`+countedGamesCondition+phaseCondition,
+		[]interface{}{
+			request.GetPlayerId(),
+			pq.Array(againstRequest.CompetitionIds),
+			pq.Array(againstRequest.TeamIds)},
+		request.GetOffset(), request.GetCount())
+
+	if err != nil {
+		return nil, err
+	}
+
+	/* now we need the matching games */
+	gameIds := make([]int32, 0)
+
+	for _, game := range stats {
+		gameIds = append(gameIds, game.GameId)
+	}
+
+	games, err := database.getGamesById(ctx, gameIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPlayerGamesStatsResponse{
+		Games: games,
+		Stats: stats,
+	}, nil
+}
+
+func (database *HeroBallDatabase) RefreshViews(ctx context.Context, request *pb.RefreshViewsRequest) (*pb.RefreshViewsResponse, error) {
+
+	previousRefresh, _ := database.views.lastRefresh()
+
+	err := database.views.refresh(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lastRefresh, duration := database.views.lastRefresh()
+
+	response := &pb.RefreshViewsResponse{
+		LastRefreshTime: lastRefresh.Format(time.RFC3339),
+		DurationMs:      int32(duration / time.Millisecond),
+	}
+
+	if !previousRefresh.IsZero() {
+		response.PreviousRefreshTime = previousRefresh.Format(time.RFC3339)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,827 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	DefaultImportBatchSize = 50
+)
+
+/* values accepted by the playerposition enum */
+var playerPositions = map[string]bool{
+	"guard":          true,
+	"point-guard":    true,
+	"shooting-guard": true,
+	"small-forward":  true,
+	"forward":        true,
+	"power-forward":  true,
+	"center":         true,
+}
+
+/* a single box score row from an import source, teams and players are referenced by name */
+type ImportStatLine struct {
+	Row          int /* position in the source, for reporting */
+	GameTime     time.Time
+	HomeTeam     string
+	AwayTeam     string
+	Team         string
+	Player       string
+	Position     string
+	JerseyNumber int32 /* negative when the source does not record it */
+	Stats        *pb.Stats
+}
+
+type ImportOptions struct {
+	CompetitionId int32
+	LocationId    int32
+	BatchSize     int /* games per transaction */
+	DryRun        bool
+}
+
+type ImportStatChange struct {
+	Game    string
+	Player  string
+	Changes []string
+}
+
+/* what an import did, or would do when a dry run */
+type ImportReport struct {
+	DryRun         bool
+	NewTeams       []string
+	NewPlayers     []string
+	NewGames       []string
+	ExistingGames  int
+	NewStats       int
+	ChangedStats   []*ImportStatChange
+	UnchangedStats int
+	MissingJerseys int
+}
+
+type importGame struct {
+	key      string
+	homeTeam string
+	awayTeam string
+	gameTime time.Time
+	gameId   int32
+	lines    []*ImportStatLine
+}
+
+type existingStatLine struct {
+	teamId       int32
+	jerseyNumber int32
+	stats        *pb.Stats
+}
+
+/* writes box score lines, creating any missing teams, players and games. Safe to re-run */
+func (database *HeroBallDatabase) ImportStatLines(ctx context.Context, lines []*ImportStatLine, options ImportOptions) (*ImportReport, error) {
+
+	if options.CompetitionId <= 0 {
+		return nil, invalidArgument("CompetitionId", "Must be greater than zero")
+	}
+
+	if options.LocationId <= 0 {
+		return nil, invalidArgument("LocationId", "Must be greater than zero")
+	}
+
+	if options.BatchSize <= 0 {
+		options.BatchSize = DefaultImportBatchSize
+	}
+
+	report := &ImportReport{
+		DryRun:       options.DryRun,
+		NewTeams:     make([]string, 0),
+		NewPlayers:   make([]string, 0),
+		NewGames:     make([]string, 0),
+		ChangedStats: make([]*ImportStatChange, 0),
+	}
+
+	games, err := groupImportLines(lines)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(games) == 0 {
+		return report, nil
+	}
+
+	/* work out which teams and players are new */
+	teamIds, err := database.getTeamIdsByName(ctx, importTeamNames(games))
+
+	if err != nil {
+		return nil, err
+	}
+
+	playerIds, err := database.getPlayerIdsByName(ctx, importPlayerNames(games))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range importTeamNames(games) {
+		if _, found := teamIds[name]; !found {
+			report.NewTeams = append(report.NewTeams, name)
+		}
+	}
+
+	for _, name := range importPlayerNames(games) {
+		if _, found := playerIds[name]; !found {
+			report.NewPlayers = append(report.NewPlayers, name)
+		}
+	}
+
+	/* match games we already have */
+	err = database.matchExistingGames(ctx, options.CompetitionId, games, teamIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	existingGameIds := make([]int32, 0)
+
+	for _, game := range games {
+		if game.gameId > 0 {
+			existingGameIds = append(existingGameIds, game.gameId)
+		} else {
+			report.NewGames = append(report.NewGames, game.key)
+		}
+	}
+
+	report.ExistingGames = len(existingGameIds)
+
+	existingStats, err := database.getExistingStatLines(ctx, existingGameIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	knownJerseys, err := database.getKnownJerseyNumbers(ctx, playerIdList(playerIds))
+
+	if err != nil {
+		return nil, err
+	}
+
+	/* diff each line against what is stored, resolving jerseys as we go */
+	pendingLines := make(map[string][]*ImportStatLine)
+
+	for _, game := range games {
+		for _, line := range game.lines {
+
+			playerId, playerKnown := playerIds[line.Player]
+			teamId, teamKnown := teamIds[line.Team]
+
+			var existing *existingStatLine
+
+			if game.gameId > 0 && playerKnown {
+				existing = existingStats[statLineKey(game.gameId, playerId)]
+			}
+
+			if line.JerseyNumber < 0 {
+				if existing != nil {
+					line.JerseyNumber = existing.jerseyNumber
+				} else if jersey, found := knownJerseys[jerseyKey(playerId, teamId)]; playerKnown && teamKnown && found {
+					line.JerseyNumber = jersey
+				} else {
+					line.JerseyNumber = 0
+					report.MissingJerseys++
+				}
+			}
+
+			if existing == nil {
+				report.NewStats++
+				pendingLines[game.key] = append(pendingLines[game.key], line)
+				continue
+			}
+
+			changes := diffStatLine(existing, teamId, line)
+
+			if len(changes) == 0 {
+				report.UnchangedStats++
+				continue
+			}
+
+			report.ChangedStats = append(report.ChangedStats, &ImportStatChange{
+				Game:    game.key,
+				Player:  line.Player,
+				Changes: changes,
+			})
+
+			pendingLines[game.key] = append(pendingLines[game.key], line)
+		}
+	}
+
+	if options.DryRun {
+		return report, nil
+	}
+
+	err = database.insertImportTeamsAndPlayers(ctx, report, games, teamIds, playerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	/* now the games and their stats, a batch of games per transaction */
+	for start := 0; start < len(games); start += options.BatchSize {
+
+		end := start + options.BatchSize
+
+		if end > len(games) {
+			end = len(games)
+		}
+
+		err = database.writeImportBatch(ctx, options, games[start:end], pendingLines, teamIds, playerIds)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error importing games %v to %v: %w", start+1, end, err)
+		}
+
+		log.Printf("Imported %v of %v games", end, len(games))
+	}
+
+	return report, nil
+}
+
+func (database *HeroBallDatabase) writeImportBatch(ctx context.Context, options ImportOptions, games []*importGame, pendingLines map[string][]*ImportStatLine, teamIds map[string]int32, playerIds map[string]int32) error {
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	for _, game := range games {
+
+		if game.gameId <= 0 {
+
+			err = tx.QueryRowContext(ctx, `
+				INSERT INTO Games (
+					CompetitionId,
+					LocationId,
+					HomeTeamId,
+					AwayTeamId,
+					GameTime,
+					Status)
+				VALUES
+					($1, $2, $3, $4, $5, 'final')
+				RETURNING GameId`,
+				options.CompetitionId,
+				options.LocationId,
+				teamIds[game.homeTeam],
+				teamIds[game.awayTeam],
+				game.gameTime).Scan(&game.gameId)
+
+			if err != nil {
+				return writeError(fmt.Sprintf("Error inserting game %v", game.key), err)
+			}
+		}
+
+		entries := make([]*pb.PlayerGameStatsEntry, 0)
+
+		for _, line := range pendingLines[game.key] {
+			entries = append(entries, &pb.PlayerGameStatsEntry{
+				PlayerId:     playerIds[line.Player],
+				TeamId:       teamIds[line.Team],
+				JerseyNumber: line.JerseyNumber,
+				Stats:        line.Stats,
+			})
+		}
+
+		_, err = upsertPlayerGameStatsInTx(ctx, tx, game.gameId, entries)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return writeError("Error committing batch", err)
+	}
+
+	return nil
+}
+
+func (database *HeroBallDatabase) insertImportTeamsAndPlayers(ctx context.Context, report *ImportReport, games []*importGame, teamIds map[string]int32, playerIds map[string]int32) error {
+
+	if len(report.NewTeams) == 0 && len(report.NewPlayers) == 0 {
+		return nil
+	}
+
+	/* first appearance of each new player gives us their position and starting year */
+	firstLines := make(map[string]*ImportStatLine)
+
+	for _, game := range games {
+		for _, line := range game.lines {
+			if _, found := firstLines[line.Player]; !found {
+				firstLines[line.Player] = line
+			}
+		}
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	for _, name := range report.NewTeams {
+
+		var teamId int32
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO Teams (Name) VALUES ($1) RETURNING TeamId`,
+			name).Scan(&teamId)
+
+		if err != nil {
+			return fmt.Errorf("Error inserting team %v: %w", name, err)
+		}
+
+		teamIds[name] = teamId
+	}
+
+	for _, name := range report.NewPlayers {
+
+		var playerId int32
+
+		line := firstLines[name]
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO Players (
+				Name,
+				Position,
+				Email,
+				YearStarted,
+				Description)
+			VALUES
+				($1, $2, '', $3, '')
+			RETURNING PlayerId`,
+			name,
+			line.Position,
+			line.GameTime.Year()).Scan(&playerId)
+
+		if err != nil {
+			return fmt.Errorf("Error inserting player %v: %w", name, err)
+		}
+
+		playerIds[name] = playerId
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return fmt.Errorf("Error committing teams and players: %w", err)
+	}
+
+	return nil
+}
+
+/* finds games in the competition matching on teams and tip off time */
+func (database *HeroBallDatabase) matchExistingGames(ctx context.Context, competitionId int32, games []*importGame, teamIds map[string]int32) error {
+
+	first := games[0].gameTime
+	last := games[0].gameTime
+
+	for _, game := range games {
+		if game.gameTime.Before(first) {
+			first = game.gameTime
+		}
+		if game.gameTime.After(last) {
+			last = game.gameTime
+		}
+	}
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			GameId,
+			HomeTeamId,
+			AwayTeamId,
+			GameTime
+		FROM
+			Games
+		WHERE
+			CompetitionId = $1 AND
+			GameTime >= $2 AND
+			GameTime <= $3`,
+		competitionId,
+		first,
+		last)
+
+	if err != nil {
+		return fmt.Errorf("Error getting existing games: %w", err)
+	}
+
+	defer rows.Close()
+
+	existing := make(map[string]int32)
+
+	for rows.Next() {
+
+		var gameId int32
+		var homeTeamId int32
+		var awayTeamId int32
+		var gameTime time.Time
+
+		err = rows.Scan(&gameId, &homeTeamId, &awayTeamId, &gameTime)
+
+		if err != nil {
+			return fmt.Errorf("Error scanning game: %w", err)
+		}
+
+		existing[fmt.Sprintf("%v/%v/%v", homeTeamId, awayTeamId, gameTime.UTC().Format(time.RFC3339))] = gameId
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return fmt.Errorf("Error following scan: %w", err)
+	}
+
+	for _, game := range games {
+
+		homeTeamId, homeFound := teamIds[game.homeTeam]
+		awayTeamId, awayFound := teamIds[game.awayTeam]
+
+		if !homeFound || !awayFound {
+			continue
+		}
+
+		game.gameId = existing[fmt.Sprintf("%v/%v/%v", homeTeamId, awayTeamId, game.gameTime.UTC().Format(time.RFC3339))]
+	}
+
+	return nil
+}
+
+func (database *HeroBallDatabase) getExistingStatLines(ctx context.Context, gameIds []int32) (map[string]*existingStatLine, error) {
+
+	lines := make(map[string]*existingStatLine)
+
+	if len(gameIds) == 0 {
+		return lines, nil
+	}
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			GameId,
+			PlayerId,
+			TeamId,
+			JerseyNumber,
+			TwoPointFGA,
+			TwoPointFGM,
+			ThreePointFGA,
+			ThreePointFGM,
+			FreeThrowsAttempted,
+			FreeThrowsMade,
+			OffensiveRebounds,
+			DefensiveRebounds,
+			Assists,
+			Blocks,
+			Steals,
+			Turnovers,
+			RegularFoulsForced,
+			RegularFoulsCommitted,
+			TechnicalFoulsCommitted,
+			MinutesPlayed
+		FROM
+			PlayerGameStats
+		WHERE
+			GameId = ANY($1)`,
+		pq.Array(gameIds))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting existing stats: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+
+		var gameId int32
+		var playerId int32
+
+		line := &existingStatLine{
+			stats: &pb.Stats{},
+		}
+
+		err = rows.Scan(
+			&gameId,
+			&playerId,
+			&line.teamId,
+			&line.jerseyNumber,
+			&line.stats.TwoPointFGA,
+			&line.stats.TwoPointFGM,
+			&line.stats.ThreePointFGA,
+			&line.stats.ThreePointFGM,
+			&line.stats.FreeThrowsAttempted,
+			&line.stats.FreeThrowsMade,
+			&line.stats.OffensiveRebounds,
+			&line.stats.DefensiveRebounds,
+			&line.stats.Assists,
+			&line.stats.Blocks,
+			&line.stats.Steals,
+			&line.stats.Turnovers,
+			&line.stats.RegularFoulsForced,
+			&line.stats.RegularFoulsCommitted,
+			&line.stats.TechnicalFoulsCommitted,
+			&line.stats.MinutesPlayed)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning existing stats: %w", err)
+		}
+
+		lines[statLineKey(gameId, playerId)] = line
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return lines, nil
+}
+
+/* the most recently recorded jersey for each player and team */
+func (database *HeroBallDatabase) getKnownJerseyNumbers(ctx context.Context, playerIds []int32) (map[string]int32, error) {
+
+	jerseys := make(map[string]int32)
+
+	if len(playerIds) == 0 {
+		return jerseys, nil
+	}
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			DISTINCT ON (PlayerId, TeamId)
+			PlayerId,
+			TeamId,
+			JerseyNumber
+		FROM
+			PlayerGameStats
+		WHERE
+			PlayerId = ANY($1)
+		ORDER BY
+			PlayerId, TeamId, StatsId DESC`,
+		pq.Array(playerIds))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting jersey numbers: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+
+		var playerId int32
+		var teamId int32
+		var jersey int32
+
+		err = rows.Scan(&playerId, &teamId, &jersey)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning jersey number: %w", err)
+		}
+
+		jerseys[jerseyKey(playerId, teamId)] = jersey
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return jerseys, nil
+}
+
+func (database *HeroBallDatabase) getTeamIdsByName(ctx context.Context, names []string) (map[string]int32, error) {
+	return database.getIdsByName(ctx, `SELECT TeamId, Name FROM Teams WHERE Name = ANY($1) ORDER BY TeamId`, names)
+}
+
+func (database *HeroBallDatabase) getPlayerIdsByName(ctx context.Context, names []string) (map[string]int32, error) {
+	return database.getIdsByName(ctx, `SELECT PlayerId, Name FROM Players WHERE Name = ANY($1) ORDER BY PlayerId`, names)
+}
+
+/* where a name is duplicated the oldest row wins */
+func (database *HeroBallDatabase) getIdsByName(ctx context.Context, query string, names []string) (map[string]int32, error) {
+
+	ids := make(map[string]int32)
+
+	rows, err := database.db.QueryContext(ctx, query, pq.Array(names))
+
+	if err == sql.ErrNoRows {
+		return ids, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting ids by name: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+
+		var id int32
+		var name string
+
+		err = rows.Scan(&id, &name)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning id: %w", err)
+		}
+
+		if _, found := ids[name]; !found {
+			ids[name] = id
+		}
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return ids, nil
+}
+
+/* validates lines and groups them into games, in chronological order */
+func groupImportLines(lines []*ImportStatLine) ([]*importGame, error) {
+
+	violations := fieldViolations{}
+	gamesByKey := make(map[string]*importGame)
+	games := make([]*importGame, 0)
+	seenPlayers := make(map[string]bool)
+
+	for _, line := range lines {
+
+		field := fmt.Sprintf("Row %v", line.Row)
+
+		if line.HomeTeam == "" || line.AwayTeam == "" {
+			violations.add(field, "Must have a home and away team")
+		} else if line.HomeTeam == line.AwayTeam {
+			violations.add(field, "Home and away team must differ")
+		}
+
+		if line.Team != line.HomeTeam && line.Team != line.AwayTeam {
+			violations.add(field, "Team %q is not playing in this game", line.Team)
+		}
+
+		if line.Player == "" {
+			violations.add(field, "Must have a player name")
+		}
+
+		if !playerPositions[line.Position] {
+			violations.add(field, "Unknown position %q", line.Position)
+		}
+
+		if line.GameTime.IsZero() {
+			violations.add(field, "Must have a game time")
+		}
+
+		validateStats(&violations, field, line.Stats)
+
+		if line.Stats == nil {
+			line.Stats = &pb.Stats{}
+		}
+
+		key := fmt.Sprintf("%v v %v at %v", line.HomeTeam, line.AwayTeam, line.GameTime.UTC().Format("2006-01-02 15:04"))
+
+		if seenPlayers[key+"/"+line.Player] {
+			violations.add(field, "Player %q has more than one line in %v", line.Player, key)
+		}
+
+		seenPlayers[key+"/"+line.Player] = true
+
+		game, found := gamesByKey[key]
+
+		if !found {
+			game = &importGame{
+				key:      key,
+				homeTeam: line.HomeTeam,
+				awayTeam: line.AwayTeam,
+				gameTime: line.GameTime,
+			}
+			gamesByKey[key] = game
+			games = append(games, game)
+		}
+
+		game.lines = append(game.lines, line)
+	}
+
+	if len(violations) > 0 {
+
+		problems := make([]string, 0)
+
+		for _, violation := range violations {
+			problems = append(problems, fmt.Sprintf("%v: %v", violation.Field, violation.Description))
+		}
+
+		return nil, fmt.Errorf("%v problems found:\n%v", len(problems), strings.Join(problems, "\n"))
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].gameTime.Before(games[j].gameTime)
+	})
+
+	return games, nil
+}
+
+func diffStatLine(existing *existingStatLine, teamId int32, line *ImportStatLine) []string {
+
+	changes := make([]string, 0)
+
+	compare := func(name string, before int32, after int32) {
+		if before != after {
+			changes = append(changes, fmt.Sprintf("%v %v -> %v", name, before, after))
+		}
+	}
+
+	compare("TeamId", existing.teamId, teamId)
+	compare("JerseyNumber", existing.jerseyNumber, line.JerseyNumber)
+	compare("TwoPointFGA", existing.stats.TwoPointFGA, line.Stats.TwoPointFGA)
+	compare("TwoPointFGM", existing.stats.TwoPointFGM, line.Stats.TwoPointFGM)
+	compare("ThreePointFGA", existing.stats.ThreePointFGA, line.Stats.ThreePointFGA)
+	compare("ThreePointFGM", existing.stats.ThreePointFGM, line.Stats.ThreePointFGM)
+	compare("FreeThrowsAttempted", existing.stats.FreeThrowsAttempted, line.Stats.FreeThrowsAttempted)
+	compare("FreeThrowsMade", existing.stats.FreeThrowsMade, line.Stats.FreeThrowsMade)
+	compare("OffensiveRebounds", existing.stats.OffensiveRebounds, line.Stats.OffensiveRebounds)
+	compare("DefensiveRebounds", existing.stats.DefensiveRebounds, line.Stats.DefensiveRebounds)
+	compare("Assists", existing.stats.Assists, line.Stats.Assists)
+	compare("Blocks", existing.stats.Blocks, line.Stats.Blocks)
+	compare("Steals", existing.stats.Steals, line.Stats.Steals)
+	compare("Turnovers", existing.stats.Turnovers, line.Stats.Turnovers)
+	compare("RegularFoulsForced", existing.stats.RegularFoulsForced, line.Stats.RegularFoulsForced)
+	compare("RegularFoulsCommitted", existing.stats.RegularFoulsCommitted, line.Stats.RegularFoulsCommitted)
+	compare("TechnicalFoulsCommitted", existing.stats.TechnicalFoulsCommitted, line.Stats.TechnicalFoulsCommitted)
+	compare("MinutesPlayed", existing.stats.MinutesPlayed, line.Stats.MinutesPlayed)
+
+	return changes
+}
+
+func importTeamNames(games []*importGame) []string {
+
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+
+	for _, game := range games {
+		for _, name := range []string{game.homeTeam, game.awayTeam} {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
+func importPlayerNames(games []*importGame) []string {
+
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+
+	for _, game := range games {
+		for _, line := range game.lines {
+			if !seen[line.Player] {
+				seen[line.Player] = true
+				names = append(names, line.Player)
+			}
+		}
+	}
+
+	return names
+}
+
+func playerIdList(playerIds map[string]int32) []int32 {
+
+	ids := make([]int32, 0)
+
+	for _, id := range playerIds {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func statLineKey(gameId int32, playerId int32) string {
+	return fmt.Sprintf("%v/%v", gameId, playerId)
+}
+
+func jerseyKey(playerId int32, teamId int32) string {
+	return fmt.Sprintf("%v/%v", playerId, teamId)
+}
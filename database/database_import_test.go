@@ -0,0 +1,288 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+/* just enough of the schema for ImportStatLines to write to and read back */
+type fakeImportStore struct {
+	t       *testing.T
+	nextId  int64
+	teams   map[string]int64
+	players map[string]int64
+	games   [][]driver.Value
+	/* by GameId and PlayerId, GameId, PlayerId, TeamId, JerseyNumber then the stats */
+	lines map[string][]driver.Value
+	order []string
+}
+
+func newFakeImportStore(t *testing.T) *fakeImportStore {
+	return &fakeImportStore{
+		t:       t,
+		teams:   make(map[string]int64),
+		players: make(map[string]int64),
+		lines:   make(map[string][]driver.Value),
+	}
+}
+
+func (store *fakeImportStore) handle(query string, args []driver.NamedValue) ([][]driver.Value, error) {
+
+	switch {
+	case strings.HasPrefix(query, "SELECT TeamId, Name FROM Teams"):
+		return store.byName(store.teams, args[0]), nil
+
+	case strings.HasPrefix(query, "SELECT PlayerId, Name FROM Players"):
+		return store.byName(store.players, args[0]), nil
+
+	case strings.HasPrefix(query, "INSERT INTO Teams"):
+		return store.insertNamed(store.teams, args[0].Value.(string)), nil
+
+	case strings.HasPrefix(query, "INSERT INTO Players"):
+		return store.insertNamed(store.players, args[0].Value.(string)), nil
+
+	case strings.HasPrefix(query, "SELECT GameId, HomeTeamId, AwayTeamId, GameTime FROM Games"):
+		return store.games, nil
+
+	case strings.HasPrefix(query, "INSERT INTO Games"):
+		store.nextId++
+		store.games = append(store.games, []driver.Value{store.nextId, args[2].Value, args[3].Value, args[4].Value})
+		return [][]driver.Value{{store.nextId}}, nil
+
+	case strings.HasPrefix(query, "INSERT INTO CompetitionTeams"), strings.HasPrefix(query, "INSERT INTO CompetitionRosters"):
+		return nil, nil
+
+	case strings.Contains(query, "FROM GameEvents"):
+		return nil, nil
+
+	case strings.HasPrefix(query, "INSERT INTO PlayerGameStats"):
+
+		key := fmt.Sprintf("%v/%v", args[0].Value, args[1].Value)
+
+		if _, exists := store.lines[key]; !exists {
+			store.order = append(store.order, key)
+		}
+
+		line := make([]driver.Value, len(args))
+
+		for i, arg := range args {
+			line[i] = arg.Value
+		}
+
+		store.lines[key] = line
+		store.nextId++
+
+		return [][]driver.Value{{store.nextId}}, nil
+
+	case strings.Contains(query, "FROM PlayerGameStats WHERE GameId = ANY($1)"):
+
+		rows := make([][]driver.Value, 0)
+
+		for _, gameId := range fakeIntArray(store.t, args[0]) {
+			for _, key := range store.order {
+				if store.lines[key][0] == gameId {
+					rows = append(rows, store.lines[key])
+				}
+			}
+		}
+
+		return rows, nil
+
+	case strings.Contains(query, "DISTINCT ON (PlayerId, TeamId)"):
+
+		rows := make([][]driver.Value, 0)
+
+		for _, playerId := range fakeIntArray(store.t, args[0]) {
+			for _, key := range store.order {
+				if line := store.lines[key]; line[1] == playerId {
+					rows = append(rows, []driver.Value{line[1], line[2], line[3]})
+				}
+			}
+		}
+
+		return rows, nil
+	}
+
+	return nil, fmt.Errorf("Unexpected query %q", query)
+}
+
+func (store *fakeImportStore) byName(ids map[string]int64, arg driver.NamedValue) [][]driver.Value {
+
+	rows := make([][]driver.Value, 0)
+
+	for _, name := range fakeStringArray(store.t, arg) {
+		if id, found := ids[name]; found {
+			rows = append(rows, []driver.Value{id, name})
+		}
+	}
+
+	return rows
+}
+
+func (store *fakeImportStore) insertNamed(ids map[string]int64, name string) [][]driver.Value {
+	store.nextId++
+	ids[name] = store.nextId
+	return [][]driver.Value{{store.nextId}}
+}
+
+/* two games between three teams, as a fresh read of the same file each time */
+func importTestLines() []*ImportStatLine {
+
+	tipOff := time.Date(2021, 4, 10, 19, 30, 0, 0, time.UTC)
+
+	line := func(row int, gameTime time.Time, home string, away string, team string, player string, jersey int32, points int32, assists int32) *ImportStatLine {
+		return &ImportStatLine{
+			Row:          row,
+			GameTime:     gameTime,
+			HomeTeam:     home,
+			AwayTeam:     away,
+			Team:         team,
+			Player:       player,
+			Position:     "guard",
+			JerseyNumber: jersey,
+			Stats: &pb.Stats{
+				TwoPointFGA: points,
+				TwoPointFGM: points / 2,
+				Assists:     assists,
+			},
+		}
+	}
+
+	return []*ImportStatLine{
+		line(2, tipOff, "Hawks", "Owls", "Hawks", "Ann", 4, 10, 3),
+		line(3, tipOff, "Hawks", "Owls", "Owls", "Bea", -1, 8, 1),
+		line(4, tipOff.Add(24*time.Hour), "Owls", "Kites", "Owls", "Bea", -1, 6, 2),
+		line(5, tipOff.Add(24*time.Hour), "Owls", "Kites", "Kites", "Cat", 12, 4, 0),
+	}
+}
+
+func TestImportStatLinesRerun(t *testing.T) {
+
+	store := newFakeImportStore(t)
+	database, _ := newFakeDatabase(t, store.handle)
+	ctx := context.Background()
+
+	options := ImportOptions{
+		CompetitionId: 1,
+		LocationId:    1,
+		DryRun:        true,
+	}
+
+	dryRun, err := database.ImportStatLines(ctx, importTestLines(), options)
+
+	if err != nil {
+		t.Fatalf("Dry run failed: %v", err)
+	}
+
+	if len(dryRun.NewTeams) != 3 || len(dryRun.NewPlayers) != 3 || len(dryRun.NewGames) != 2 || dryRun.NewStats != 4 {
+		t.Errorf("Dry run reported %+v, want 3 teams, 3 players, 2 games and 4 lines", dryRun)
+	}
+
+	if len(store.teams) != 0 || len(store.games) != 0 || len(store.lines) != 0 {
+		t.Fatalf("Dry run wrote to the database")
+	}
+
+	options.DryRun = false
+
+	first, err := database.ImportStatLines(ctx, importTestLines(), options)
+
+	if err != nil {
+		t.Fatalf("First import failed: %v", err)
+	}
+
+	if len(first.NewGames) != 2 || first.NewStats != 4 || first.MissingJerseys != 2 {
+		t.Errorf("First import reported %+v, want 2 games, 4 lines and 2 missing jerseys", first)
+	}
+
+	second, err := database.ImportStatLines(ctx, importTestLines(), options)
+
+	if err != nil {
+		t.Fatalf("Second import failed: %v", err)
+	}
+
+	if len(second.NewTeams) != 0 || len(second.NewPlayers) != 0 || len(second.NewGames) != 0 || second.NewStats != 0 {
+		t.Errorf("Second import created %+v", second)
+	}
+
+	if second.ExistingGames != 2 || second.UnchangedStats != 4 || len(second.ChangedStats) != 0 {
+		t.Errorf("Second import reported %v existing games, %v unchanged and %v changed lines, want 2, 4 and 0", second.ExistingGames, second.UnchangedStats, len(second.ChangedStats))
+	}
+
+	/* a corrected line is the only change reported */
+	corrected := importTestLines()
+	corrected[0].Stats.Assists = 4
+
+	third, err := database.ImportStatLines(ctx, corrected, options)
+
+	if err != nil {
+		t.Fatalf("Third import failed: %v", err)
+	}
+
+	if third.UnchangedStats != 3 || len(third.ChangedStats) != 1 {
+		t.Fatalf("Third import reported %v unchanged and %v changed lines, want 3 and 1", third.UnchangedStats, len(third.ChangedStats))
+	}
+
+	if change := third.ChangedStats[0]; change.Player != "Ann" || strings.Join(change.Changes, ", ") != "Assists 3 -> 4" {
+		t.Errorf("Third import reported %v changed %v", change.Player, change.Changes)
+	}
+}
+
+func TestGroupImportLinesRejectsFouls(t *testing.T) {
+
+	lines := importTestLines()
+	lines[1].Stats.RegularFoulsCommitted = MaxRegularFouls + 1
+	lines[2].Stats.TechnicalFoulsCommitted = MaxTechnicalFouls + 1
+
+	_, err := groupImportLines(lines)
+
+	if err == nil {
+		t.Fatalf("Lines over the foul limits were accepted")
+	}
+
+	for _, row := range []string{"Row 3", "Row 4"} {
+		if !strings.Contains(err.Error(), row) {
+			t.Errorf("Error %q does not mention %v", err, row)
+		}
+	}
+}
+
+func TestDiffStatLine(t *testing.T) {
+
+	existing := &existingStatLine{
+		teamId:       2,
+		jerseyNumber: 7,
+		stats:        &pb.Stats{TwoPointFGA: 5, MinutesPlayed: 30},
+	}
+
+	tests := []struct {
+		name    string
+		teamId  int32
+		jersey  int32
+		stats   *pb.Stats
+		changes string
+	}{
+		{"unchanged", 2, 7, &pb.Stats{TwoPointFGA: 5, MinutesPlayed: 30}, ""},
+		{"stats", 2, 7, &pb.Stats{TwoPointFGA: 6, MinutesPlayed: 28}, "TwoPointFGA 5 -> 6, MinutesPlayed 30 -> 28"},
+		{"team and jersey", 3, 9, &pb.Stats{TwoPointFGA: 5, MinutesPlayed: 30}, "TeamId 2 -> 3, JerseyNumber 7 -> 9"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			changes := diffStatLine(existing, test.teamId, &ImportStatLine{
+				JerseyNumber: test.jersey,
+				Stats:        test.stats,
+			})
+
+			if got := strings.Join(changes, ", "); got != test.changes {
+				t.Errorf("Got changes %q, want %q", got, test.changes)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
-package main
+package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -9,15 +10,15 @@ import (
 	pb "github.com/mlv9/protobuf"
 )
 
-func (database *HeroBallDatabase) getPlayerProfile(playerId int32) (*pb.PlayerProfile, error) {
+func (database *HeroBallDatabase) getPlayerProfile(ctx context.Context, playerId int32) (*pb.PlayerProfile, error) {
 
 	if playerId <= 0 {
-		return nil, fmt.Errorf("Invalid playerId")
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
 	}
 
 	profile := &pb.PlayerProfile{}
 
-	err := database.db.QueryRow(`
+	err := database.db.QueryRowContext(ctx, `
 		SELECT
 			Name,
 			YearStarted,
@@ -34,7 +35,7 @@ func (database *HeroBallDatabase) getPlayerProfile(playerId int32) (*pb.PlayerPr
 		&profile.Description)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("That playerId does not exist")
+		return nil, notFound("playerId", playerId)
 	}
 
 	if err != nil {
@@ -44,16 +45,16 @@ func (database *HeroBallDatabase) getPlayerProfile(playerId int32) (*pb.PlayerPr
 	return profile, nil
 }
 
-func (database *HeroBallDatabase) getPlayerById(playerId int32) (*pb.Player, error) {
+func (database *HeroBallDatabase) getPlayerById(ctx context.Context, playerId int32) (*pb.Player, error) {
 
-	players, err := database.getPlayersById([]int32{playerId})
+	players, err := database.getPlayersById(ctx, []int32{playerId})
 
 	if err != nil {
 		return nil, err
 	}
 
 	if players == nil || len(players) == 0 {
-		return nil, fmt.Errorf("Could not find player")
+		return nil, notFound("playerId", playerId)
 	}
 
 	if len(players) != 1 {
@@ -63,13 +64,13 @@ func (database *HeroBallDatabase) getPlayerById(playerId int32) (*pb.Player, err
 	return players[0], nil
 }
 
-func (database *HeroBallDatabase) getPlayersById(playerIds []int32) ([]*pb.Player, error) {
+func (database *HeroBallDatabase) getPlayersById(ctx context.Context, playerIds []int32) ([]*pb.Player, error) {
 
 	if len(playerIds) < 1 {
 		return nil, fmt.Errorf("Must supply a playerId")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			PlayerId,
 			Name,
@@ -85,7 +86,7 @@ func (database *HeroBallDatabase) getPlayersById(playerIds []int32) ([]*pb.Playe
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error in db: %v", err)
+		return nil, fmt.Errorf("Error in db: %w", err)
 	}
 
 	players := make([]*pb.Player, 0)
@@ -101,7 +102,7 @@ func (database *HeroBallDatabase) getPlayersById(playerIds []int32) ([]*pb.Playe
 			&player.Position)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error scanning player: %v", err)
+			return nil, fmt.Errorf("Error scanning player: %w", err)
 		}
 
 		players = append(players, player)
@@ -111,23 +112,23 @@ func (database *HeroBallDatabase) getPlayersById(playerIds []int32) ([]*pb.Playe
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("error on db scan: %v", err)
+		return nil, fmt.Errorf("error on db scan: %w", err)
 	}
 
 	return players, nil
 }
 
-func (database *HeroBallDatabase) getPlayerStatsForGame(playerId int32, gameId int32) (*pb.PlayerGameStats, error) {
+func (database *HeroBallDatabase) getPlayerStatsForGame(ctx context.Context, playerId int32, gameId int32) (*pb.PlayerGameStats, error) {
 
 	if playerId <= 0 {
-		return nil, fmt.Errorf("Invalid playerId")
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
 	}
 
 	if playerId <= 0 {
-		return nil, fmt.Errorf("Invalid gameId")
+		return nil, invalidArgument("GameId", "Must be greater than zero")
 	}
 
-	stats, err := database.getPlayerGameStatsByConditionAndOffsetAndCount("PlayerGameStats.PlayerId = $1 AND PlayerGameStats.GameId = $2", []interface{}{playerId, gameId}, 0, 0)
+	stats, err := database.getPlayerGameStatsByConditionAndOffsetAndCount(ctx, "PlayerGameStats.PlayerId = $1 AND PlayerGameStats.GameId = $2", []interface{}{playerId, gameId}, 0, 0)
 
 	if err != nil {
 		return nil, err
@@ -140,13 +141,13 @@ func (database *HeroBallDatabase) getPlayerStatsForGame(playerId int32, gameId i
 	return stats[0], nil
 }
 
-func (database *HeroBallDatabase) getPlayerTotalStatsForTeam(playerId int32, teamId int32) (*pb.PlayerAggregateStats, error) {
+func (database *HeroBallDatabase) getPlayerTotalStatsForTeam(ctx context.Context, playerId int32, teamId int32) (*pb.PlayerAggregateStats, error) {
 
 	if playerId <= 0 {
-		return nil, fmt.Errorf("Invalid playerId")
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
 	}
 
-	playerStats, playerIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(
+	playerStats, playerIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
 		"PlayerGameStats.PlayerId = $1 AND PlayerGameStats.TeamId = $2",
 		[]interface{}{playerId, teamId},
 		"GROUP BY PlayerGameStats.PlayerId", "PlayerGameStats.PlayerId", "", nil, "", 1, 0)
@@ -159,7 +160,7 @@ func (database *HeroBallDatabase) getPlayerTotalStatsForTeam(playerId int32, tea
 		return nil, nil
 	}
 
-	player, err := database.getPlayerById(playerIds[0])
+	player, err := database.getPlayerById(ctx, playerIds[0])
 
 	return &pb.PlayerAggregateStats{
 		Stats:  playerStats[0],
@@ -167,13 +168,13 @@ func (database *HeroBallDatabase) getPlayerTotalStatsForTeam(playerId int32, tea
 	}, nil
 }
 
-func (database *HeroBallDatabase) getPlayerTotalStatsForAllTime(playerId int32) (*pb.PlayerAggregateStats, error) {
+func (database *HeroBallDatabase) getPlayerTotalStatsForAllTime(ctx context.Context, playerId int32) (*pb.PlayerAggregateStats, error) {
 
 	if playerId <= 0 {
-		return nil, fmt.Errorf("Invalid playerId")
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
 	}
 
-	playerStats, playerIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(
+	playerStats, playerIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
 		"PlayerGameStats.PlayerId = $1",
 		[]interface{}{playerId},
 		"GROUP BY PlayerGameStats.PlayerId", "PlayerGameStats.PlayerId", "", nil, "", 1, 0)
@@ -186,7 +187,7 @@ func (database *HeroBallDatabase) getPlayerTotalStatsForAllTime(playerId int32)
 		return nil, nil
 	}
 
-	player, err := database.getPlayerById(playerIds[0])
+	player, err := database.getPlayerById(ctx, playerIds[0])
 
 	return &pb.PlayerAggregateStats{
 		Stats:  playerStats[0],
@@ -194,7 +195,7 @@ func (database *HeroBallDatabase) getPlayerTotalStatsForAllTime(playerId int32)
 	}, nil
 }
 
-func (database *HeroBallDatabase) getPlayerGameStatsByConditionAndOffsetAndCount(conditions string, args []interface{}, offset int32, count int32) ([]*pb.PlayerGameStats, error) {
+func (database *HeroBallDatabase) getPlayerGameStatsByConditionAndOffsetAndCount(ctx context.Context, conditions string, args []interface{}, offset int32, count int32) ([]*pb.PlayerGameStats, error) {
 
 	joinedStats := make([]*pb.PlayerGameStats, 0)
 
@@ -208,7 +209,7 @@ func (database *HeroBallDatabase) getPlayerGameStatsByConditionAndOffsetAndCount
 		conditions = fmt.Sprintf("%v OFFSET %v", conditions, offset)
 	}
 
-	rows, err := database.db.Query(fmt.Sprintf(`
+	rows, err := database.db.QueryContext(ctx, fmt.Sprintf(`
 		SELECT
 			PlayerGameStats.StatsId,
 			PlayerGameStats.GameId,
@@ -293,22 +294,24 @@ func (database *HeroBallDatabase) getPlayerGameStatsByConditionAndOffsetAndCount
 			&stats.Stats.MinutesPlayed)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error scanning stats: %v", err)
+			return nil, fmt.Errorf("Error scanning stats: %w", err)
 		}
 
+		setAdvancedStats(stats.Stats)
+
 		joinedStats = append(joinedStats, stats)
 	}
 
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting total stats: %v", err)
+		return nil, fmt.Errorf("Error getting total stats: %w", err)
 	}
 
 	return joinedStats, nil
 }
 
-func (database *HeroBallDatabase) getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(whereClause string, whereArgs []interface{}, grouping string, groupReturnedKey string, having string, havingArgs []interface{}, ordering string, limit int32, offset int32) ([]*pb.Stats, []int32, error) {
+func (database *HeroBallDatabase) getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx context.Context, whereClause string, whereArgs []interface{}, grouping string, groupReturnedKey string, having string, havingArgs []interface{}, ordering string, limit int32, offset int32) ([]*pb.Stats, []int32, error) {
 
 	/* if missing, lets fake it */
 	if groupReturnedKey == "" {
@@ -320,10 +323,10 @@ func (database *HeroBallDatabase) getAggregateStatsByConditionAndGroupingAndOrde
 	/* append to totals */
 	allStats := make([]*pb.Stats, 0)
 
-	rows, err := database.db.Query(fmt.Sprintf(`
+	rows, err := database.db.QueryContext(ctx, fmt.Sprintf(`
 		SELECT
 			%v,
-			COUNT(PlayerGameStats.StatsId),
+			COUNT(DISTINCT PlayerGameStats.GameId),
 			SUM(PlayerGameStats.TwoPointFGA),
 			SUM(PlayerGameStats.TwoPointFGM),
 			SUM(PlayerGameStats.ThreePointFGA), 
@@ -345,13 +348,13 @@ func (database *HeroBallDatabase) getAggregateStatsByConditionAndGroupingAndOrde
 		LEFT JOIN
 			Games ON PlayerGameStats.GameId = Games.GameId	
 		WHERE
-			%v
+			(%v) AND %v
 			%v
 			%v
 			%v
 			LIMIT %v
 			OFFSET %v`,
-		groupReturnedKey, whereClause, grouping, having, ordering, limit, offset), append(whereArgs, havingArgs...)...)
+		groupReturnedKey, whereClause, countedGamesCondition, grouping, having, ordering, limit, offset), append(whereArgs, havingArgs...)...)
 
 	if err == sql.ErrNoRows {
 		return nil, nil, nil
@@ -390,6 +393,8 @@ func (database *HeroBallDatabase) getAggregateStatsByConditionAndGroupingAndOrde
 			return nil, nil, err
 		}
 
+		setAdvancedStats(stats)
+
 		allStats = append(allStats, stats)
 		groupedKeys = append(groupedKeys, groupedKey)
 	}
@@ -403,116 +408,16 @@ func (database *HeroBallDatabase) getAggregateStatsByConditionAndGroupingAndOrde
 	return allStats, groupedKeys, nil
 }
 
-func (database *HeroBallDatabase) getResultForGame(gameId int32) (*pb.GameResult, error) {
+func (database *HeroBallDatabase) getCompetitionById(ctx context.Context, competitionId int32) (*pb.Competition, error) {
 
-	results, err := database.getResultsForGames([]int32{gameId})
-
-	if err != nil {
-		return nil, err
-	}
-
-	if results == nil || len(results) == 0 {
-		return nil, fmt.Errorf("Could not find result")
-	}
-
-	if len(results) != 1 {
-		return nil, fmt.Errorf("Expecting 1 result, got %v", len(results))
-	}
-
-	return results[0], nil
-}
-
-/* optimise with Postgres MATERIAL VIEW */
-func (database *HeroBallDatabase) getResultsForGames(gameIds []int32) ([]*pb.GameResult, error) {
-
-	if gameIds == nil {
-		return nil, fmt.Errorf("Invalid gameIds")
-	}
-
-	results := make([]*pb.GameResult, 0)
-
-	for _, gameId := range gameIds {
-
-		var homeTeamId int32
-		var awayTeamId int32
-
-		err := database.db.QueryRow(`
-		SELECT
-			HomeTeamId,
-			AwayTeamId
-		FROM
-			Games
-		WHERE GameId = $1
-		`, gameId).Scan(&homeTeamId, &awayTeamId)
-
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("This game has no result")
-		}
-
-		if err != nil {
-			return nil, fmt.Errorf("Error getting teams in game: %v", err)
-		}
-
-		/* now get the points for each */
-		homeTeamStats, err := database.getStatsForTeamInGame(homeTeamId, gameId)
-
-		if err != nil {
-			return nil, err
-		}
-
-		if homeTeamStats == nil {
-			return nil, fmt.Errorf("Was not able to find home teamId %v stats for gameId %v", homeTeamId, gameId)
-		}
-
-		awayTeamStats, err := database.getStatsForTeamInGame(awayTeamId, gameId)
-
-		if err != nil {
-			return nil, err
-		}
-
-		if awayTeamStats == nil {
-			return nil, fmt.Errorf("Was not able to find away teamId %v stats for gameId %v", awayTeamId, gameId)
-		}
-
-		results = append(results, &pb.GameResult{
-			HomeTeamId:     homeTeamId,
-			AwayTeamId:     awayTeamId,
-			HomeTeamPoints: (homeTeamStats.ThreePointFGM * 3) + (homeTeamStats.TwoPointFGM * 2) + (homeTeamStats.FreeThrowsMade),
-			AwayTeamPoints: (awayTeamStats.ThreePointFGM * 3) + (awayTeamStats.TwoPointFGM * 2) + (awayTeamStats.FreeThrowsMade),
-		})
-	}
-
-	return results, nil
-}
-
-/* possible optimisation using MATERIAL VIEW */
-func (database *HeroBallDatabase) getStatsForTeamInGame(teamId int32, gameId int32) (*pb.Stats, error) {
-	stats, _, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(
-		"PlayerGameStats.TeamId = $1 AND PlayerGameStats.GameId = $2",
-		[]interface{}{teamId, gameId},
-		"GROUP BY PlayerGameStats.TeamId", "PlayerGameStats.TeamId", "", nil, "", 1, 0)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if stats == nil || len(stats) < 1 {
-		return nil, nil
-	}
-
-	return stats[0], nil
-}
-
-func (database *HeroBallDatabase) getCompetitionById(competitionId int32) (*pb.Competition, error) {
-
-	comps, err := database.getCompetitionsById([]int32{competitionId})
+	comps, err := database.getCompetitionsById(ctx, []int32{competitionId})
 
 	if err != nil {
 		return nil, err
 	}
 
 	if comps == nil || len(comps) == 0 {
-		return nil, fmt.Errorf("Could not find game")
+		return nil, notFound("competitionId", competitionId)
 	}
 
 	if len(comps) != 1 {
@@ -522,19 +427,20 @@ func (database *HeroBallDatabase) getCompetitionById(competitionId int32) (*pb.C
 	return comps[0], nil
 }
 
-func (database *HeroBallDatabase) getCompetitionsById(competitionIds []int32) ([]*pb.Competition, error) {
+func (database *HeroBallDatabase) getCompetitionsById(ctx context.Context, competitionIds []int32) ([]*pb.Competition, error) {
 
 	if competitionIds == nil {
 		return nil, fmt.Errorf("Invalid competitionIds - must supply at least one")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 	SELECT
 		Competitions.CompetitionId,
 		Leagues.LeagueId,
 		Leagues.Name,
 		Leagues.Division,
-		Competitions.Name
+		Competitions.Name,
+		Competitions.PeriodCount
 	FROM
 		Competitions
 	LEFT JOIN
@@ -548,7 +454,7 @@ func (database *HeroBallDatabase) getCompetitionsById(competitionIds []int32) ([
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error in db: %v", err)
+		return nil, fmt.Errorf("Error in db: %w", err)
 	}
 
 	comps := make([]*pb.Competition, 0)
@@ -564,10 +470,11 @@ func (database *HeroBallDatabase) getCompetitionsById(competitionIds []int32) ([
 			&comp.League.LeagueId,
 			&comp.League.Name,
 			&comp.League.Division,
-			&comp.Name)
+			&comp.Name,
+			&comp.PeriodCount)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error scanning comp: %v", err)
+			return nil, fmt.Errorf("Error scanning comp: %w", err)
 		}
 
 		comps = append(comps, comp)
@@ -576,22 +483,22 @@ func (database *HeroBallDatabase) getCompetitionsById(competitionIds []int32) ([
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error on final scan: %v", err)
+		return nil, fmt.Errorf("Error on final scan: %w", err)
 	}
 
 	return comps, nil
 }
 
-func (database *HeroBallDatabase) getGameById(gameId int32) (*pb.Game, error) {
+func (database *HeroBallDatabase) getGameById(ctx context.Context, gameId int32) (*pb.Game, error) {
 
-	games, err := database.getGamesById([]int32{gameId})
+	games, err := database.getGamesById(ctx, []int32{gameId})
 
 	if err != nil {
 		return nil, err
 	}
 
 	if games == nil || len(games) == 0 {
-		return nil, fmt.Errorf("Could not find game")
+		return nil, notFound("gameId", gameId)
 	}
 
 	if len(games) != 1 {
@@ -601,16 +508,16 @@ func (database *HeroBallDatabase) getGameById(gameId int32) (*pb.Game, error) {
 	return games[0], nil
 }
 
-func (database *HeroBallDatabase) getLocation(locationId int32) (*pb.Location, error) {
+func (database *HeroBallDatabase) getLocation(ctx context.Context, locationId int32) (*pb.Location, error) {
 
-	locations, err := database.getLocations([]int32{locationId})
+	locations, err := database.getLocations(ctx, []int32{locationId})
 
 	if err != nil {
 		return nil, err
 	}
 
 	if locations == nil || len(locations) == 0 {
-		return nil, fmt.Errorf("Could not find location")
+		return nil, notFound("locationId", locationId)
 	}
 
 	if len(locations) != 1 {
@@ -620,16 +527,16 @@ func (database *HeroBallDatabase) getLocation(locationId int32) (*pb.Location, e
 	return locations[0], nil
 }
 
-func (database *HeroBallDatabase) getTeamById(teamId int32) (*pb.Team, error) {
+func (database *HeroBallDatabase) getTeamById(ctx context.Context, teamId int32) (*pb.Team, error) {
 
-	teams, err := database.getTeamsById([]int32{teamId})
+	teams, err := database.getTeamsById(ctx, []int32{teamId})
 
 	if err != nil {
 		return nil, err
 	}
 
 	if teams == nil || len(teams) == 0 {
-		return nil, fmt.Errorf("Could not find team")
+		return nil, notFound("teamId", teamId)
 	}
 
 	if len(teams) != 1 {
@@ -639,13 +546,17 @@ func (database *HeroBallDatabase) getTeamById(teamId int32) (*pb.Team, error) {
 	return teams[0], nil
 }
 
-func (database *HeroBallDatabase) getGamesById(gameIds []int32) ([]*pb.Game, error) {
+/*
+ * loads the games with their results and competitions in a single query, however
+ * many are asked for. Games that haven't been played have no stats and score 0
+ */
+func (database *HeroBallDatabase) getGamesById(ctx context.Context, gameIds []int32) ([]*pb.Game, error) {
 
 	if gameIds == nil {
 		return nil, fmt.Errorf("Invalid gameIds")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			Games.GameId,
 			HomeTeams.TeamId,
@@ -654,18 +565,32 @@ func (database *HeroBallDatabase) getGamesById(gameIds []int32) ([]*pb.Game, err
 			AwayTeams.Name,
 			Locations.LocationId,
 			Locations.Name,
-			Games.CompetitionId,
-			Games.GameTime	
+			Competitions.CompetitionId,
+			Competitions.Name,
+			Competitions.PeriodCount,
+			Leagues.LeagueId,
+			Leagues.Name,
+			Leagues.Division,
+			Games.GameTime,
+			Games.Status,
+			COALESCE(Games.ForfeitTeamId, 0),
+			COALESCE(Games.SeriesId, 0),
+			`+teamPoints("Games.HomeTeamId")+`,
+			`+teamPoints("Games.AwayTeamId")+`
 		FROM
 			Games
 		LEFT JOIN
 			Teams HomeTeams ON Games.HomeTeamId = HomeTeams.TeamId
 		LEFT JOIN
-			Teams AwayTeams ON Games.AwayTeamId = AwayTeams.TeamId			
+			Teams AwayTeams ON Games.AwayTeamId = AwayTeams.TeamId
 		LEFT JOIN
 			Locations ON Games.LocationId = Locations.LocationId
+		LEFT JOIN
+			Competitions ON Games.CompetitionId = Competitions.CompetitionId
+		LEFT JOIN
+			Leagues ON Competitions.LeagueId = Leagues.LeagueId
 		WHERE
-			GameId = ANY($1)
+			Games.GameId = ANY($1)
 		ORDER BY Games.GameTime DESC`,
 		pq.Array(gameIds))
 
@@ -674,72 +599,74 @@ func (database *HeroBallDatabase) getGamesById(gameIds []int32) ([]*pb.Game, err
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error in db: %v", err)
+		return nil, fmt.Errorf("Error in db: %w", err)
 	}
 
+	defer rows.Close()
+
 	games := make([]*pb.Game, 0)
 
 	for rows.Next() {
 
-		var competitionId int32
-		var gameId int32
-
 		game := &pb.Game{
 			HomeTeam: &pb.Team{},
 			AwayTeam: &pb.Team{},
 			Location: &pb.Location{},
+			Competition: &pb.Competition{
+				League: &pb.League{},
+			},
+			Result: &pb.GameResult{},
 		}
 
 		err = rows.Scan(
-			&gameId,
+			&game.GameId,
 			&game.HomeTeam.TeamId,
 			&game.HomeTeam.Name,
 			&game.AwayTeam.TeamId,
 			&game.AwayTeam.Name,
 			&game.Location.LocationId,
 			&game.Location.Name,
-			&competitionId,
-			&game.GameTime)
-
-		if err != nil {
-			return nil, fmt.Errorf("Error getting games: %v", err)
-		}
-
-		game.GameId = gameId
-
-		/* get the game result */
-		result, err := database.getResultForGame(gameId)
+			&game.Competition.CompetitionId,
+			&game.Competition.Name,
+			&game.Competition.PeriodCount,
+			&game.Competition.League.LeagueId,
+			&game.Competition.League.Name,
+			&game.Competition.League.Division,
+			&game.GameTime,
+			&game.Status,
+			&game.ForfeitTeamId,
+			&game.SeriesId,
+			&game.Result.HomeTeamPoints,
+			&game.Result.AwayTeamPoints)
 
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("Error getting games: %w", err)
 		}
 
-		game.Result = result
-
-		/* get the competition */
-		comp, err := database.getCompetitionById(competitionId)
+		game.Result.HomeTeamId = game.HomeTeam.TeamId
+		game.Result.AwayTeamId = game.AwayTeam.TeamId
 
-		if err != nil {
-			return nil, err
-		}
+		games = append(games, game)
+	}
 
-		game.Competition = comp
+	err = rows.Err()
 
-		games = append(games, game)
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
 	}
 
 	return games, nil
 }
 
-func (database *HeroBallDatabase) getPlayersForTeam(teamId int32) ([]int32, error) {
+func (database *HeroBallDatabase) getPlayersForTeam(ctx context.Context, teamId int32) ([]int32, error) {
 
 	if teamId <= 0 {
-		return nil, fmt.Errorf("Invalid teamId")
+		return nil, invalidArgument("TeamId", "Must be greater than zero")
 	}
 
 	playerIds := make([]int32, 0)
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			DISTINCT PlayerId
 		FROM
@@ -750,7 +677,7 @@ func (database *HeroBallDatabase) getPlayersForTeam(teamId int32) ([]int32, erro
 		teamId)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("That teamId does not exist")
+		return nil, notFound("teamId", teamId)
 	}
 
 	if err != nil {
@@ -781,14 +708,14 @@ func (database *HeroBallDatabase) getPlayersForTeam(teamId int32) ([]int32, erro
 }
 
 /* returns a list of gameIds, from most recent to least recent */
-func (database *HeroBallDatabase) getGameIdsForCompetitionId(competitionId int32) ([]int32, error) {
+func (database *HeroBallDatabase) getGameIdsForCompetitionId(ctx context.Context, competitionId int32) ([]int32, error) {
 
 	if competitionId <= 0 {
-		return nil, fmt.Errorf("Invalid competitionId")
+		return nil, invalidArgument("CompetitionId", "Must be greater than zero")
 	}
 
 	/* get all the games in a competition */
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			GameId,
 			GameTime
@@ -805,7 +732,7 @@ func (database *HeroBallDatabase) getGameIdsForCompetitionId(competitionId int32
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting results for comp: %v", err)
+		return nil, fmt.Errorf("Error getting results for comp: %w", err)
 	}
 
 	gameIds := make([]int32, 0)
@@ -818,7 +745,7 @@ func (database *HeroBallDatabase) getGameIdsForCompetitionId(competitionId int32
 		err = rows.Scan(&gameId, &gameTime)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error scanning gameId: %v", err)
+			return nil, fmt.Errorf("Error scanning gameId: %w", err)
 		}
 
 		gameIds = append(gameIds, gameId)
@@ -827,18 +754,18 @@ func (database *HeroBallDatabase) getGameIdsForCompetitionId(competitionId int32
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error following scan: %v", err)
+		return nil, fmt.Errorf("Error following scan: %w", err)
 	}
 
 	return gameIds, nil
 }
 
-func (database *HeroBallDatabase) getGameIdsForPlayer(playerId int32) ([]int32, error) {
+func (database *HeroBallDatabase) getGameIdsForPlayer(ctx context.Context, playerId int32) ([]int32, error) {
 	if playerId <= 0 {
-		return nil, fmt.Errorf("Invalid playerId")
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 	SELECT
 		Games.GameId,
 		Games.GameTime
@@ -868,7 +795,7 @@ func (database *HeroBallDatabase) getGameIdsForPlayer(playerId int32) ([]int32,
 		err = rows.Scan(&gameId, &gameTime)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error scanning games: %v", err)
+			return nil, fmt.Errorf("Error scanning games: %w", err)
 		}
 
 		games = append(games, gameId)
@@ -877,7 +804,7 @@ func (database *HeroBallDatabase) getGameIdsForPlayer(playerId int32) ([]int32,
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error following scan: %v", err)
+		return nil, fmt.Errorf("Error following scan: %w", err)
 	}
 
 	return games, nil
@@ -885,13 +812,13 @@ func (database *HeroBallDatabase) getGameIdsForPlayer(playerId int32) ([]int32,
 }
 
 /* returns a list of gameIds, from most recent to least recent */
-func (database *HeroBallDatabase) getGameIdsForTeam(teamId int32) ([]int32, error) {
+func (database *HeroBallDatabase) getGameIdsForTeam(ctx context.Context, teamId int32) ([]int32, error) {
 
 	if teamId <= 0 {
-		return nil, fmt.Errorf("Invalid teamId")
+		return nil, invalidArgument("TeamId", "Must be greater than zero")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			GameId,
 			GameTime
@@ -929,7 +856,7 @@ func (database *HeroBallDatabase) getGameIdsForTeam(teamId int32) ([]int32, erro
 		err = rows.Scan(&gameId, &gameTime)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error scanning games: %v", err)
+			return nil, fmt.Errorf("Error scanning games: %w", err)
 		}
 
 		games = append(games, gameId)
@@ -938,21 +865,21 @@ func (database *HeroBallDatabase) getGameIdsForTeam(teamId int32) ([]int32, erro
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error following scan: %v", err)
+		return nil, fmt.Errorf("Error following scan: %w", err)
 	}
 
 	return games, nil
 }
 
-func (database *HeroBallDatabase) getPlayersInGame(gameId int32) ([]int32, error) {
+func (database *HeroBallDatabase) getPlayersInGame(ctx context.Context, gameId int32) ([]int32, error) {
 
 	if gameId <= 0 {
-		return nil, fmt.Errorf("Invalid gameId")
+		return nil, invalidArgument("GameId", "Must be greater than zero")
 	}
 
 	playerIds := make([]int32, 0)
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			DISTINCT PlayerId
 		FROM
@@ -963,7 +890,7 @@ func (database *HeroBallDatabase) getPlayersInGame(gameId int32) ([]int32, error
 		gameId)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("That gameId does not exist")
+		return nil, notFound("gameId", gameId)
 	}
 
 	if err != nil {
@@ -994,17 +921,17 @@ func (database *HeroBallDatabase) getPlayersInGame(gameId int32) ([]int32, error
 }
 
 /* returns the team that has been played with the most */
-func (database *HeroBallDatabase) getPlayersTeamInCompetition(playerId int32, competitionId int32) (int32, error) {
+func (database *HeroBallDatabase) getPlayersTeamInCompetition(ctx context.Context, playerId int32, competitionId int32) (int32, error) {
 
 	if playerId <= 0 {
-		return 0, fmt.Errorf("Invalid playerId")
+		return 0, invalidArgument("PlayerId", "Must be greater than zero")
 	}
 
 	if competitionId <= 0 {
-		return 0, fmt.Errorf("Invalid competitionId")
+		return 0, invalidArgument("CompetitionId", "Must be greater than zero")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			DISTINCT PlayerGameStats.TeamId,
 			COUNT(PlayerGameStats.TeamId)
@@ -1023,7 +950,7 @@ func (database *HeroBallDatabase) getPlayersTeamInCompetition(playerId int32, co
 	}
 
 	if err != nil {
-		return 0, fmt.Errorf("Error getting player teams in comp: %v", err)
+		return 0, fmt.Errorf("Error getting player teams in comp: %w", err)
 	}
 
 	var team struct {
@@ -1041,7 +968,7 @@ func (database *HeroBallDatabase) getPlayersTeamInCompetition(playerId int32, co
 			&count)
 
 		if err != nil {
-			return 0, fmt.Errorf("Error scanning team: %v", err)
+			return 0, fmt.Errorf("Error scanning team: %w", err)
 		}
 
 		if count > team.Count {
@@ -1053,22 +980,22 @@ func (database *HeroBallDatabase) getPlayersTeamInCompetition(playerId int32, co
 	err = rows.Err()
 
 	if err != nil {
-		return 0, fmt.Errorf("Error on scan: %v", err)
+		return 0, fmt.Errorf("Error on scan: %w", err)
 	}
 
 	return team.TeamId, nil
 }
 
-func (database *HeroBallDatabase) getFirstAndLastGameForCompetitionId(competitionId int32) (string, string, error) {
+func (database *HeroBallDatabase) getFirstAndLastGameForCompetitionId(ctx context.Context, competitionId int32) (string, string, error) {
 
 	if competitionId <= 0 {
-		return "", "", fmt.Errorf("Invalid competitionId")
+		return "", "", invalidArgument("CompetitionId", "Must be greater than zero")
 	}
 
 	var firstGameTime string
 	var lastGameTime string
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		(SELECT
 			GameTime
 		FROM 
@@ -1133,13 +1060,13 @@ func (database *HeroBallDatabase) getFirstAndLastGameForCompetitionId(competitio
 	return firstGameTime, lastGameTime, nil
 }
 
-func (database *HeroBallDatabase) getCompetitionLocationIds(competitionId int32) ([]int32, error) {
+func (database *HeroBallDatabase) getCompetitionLocationIds(ctx context.Context, competitionId int32) ([]int32, error) {
 
 	if competitionId <= 0 {
-		return nil, fmt.Errorf("Invalid competitionId")
+		return nil, invalidArgument("CompetitionId", "Must be greater than zero")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			DISTINCT LocationId
 		FROM 
@@ -1153,7 +1080,7 @@ func (database *HeroBallDatabase) getCompetitionLocationIds(competitionId int32)
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting competition locations: %v", err)
+		return nil, fmt.Errorf("Error getting competition locations: %w", err)
 	}
 
 	locationIds := make([]int32, 0)
@@ -1164,7 +1091,7 @@ func (database *HeroBallDatabase) getCompetitionLocationIds(competitionId int32)
 		err = rows.Scan(&locationId)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error getting location: %v", err)
+			return nil, fmt.Errorf("Error getting location: %w", err)
 		}
 
 		locationIds = append(locationIds, locationId)
@@ -1173,30 +1100,24 @@ func (database *HeroBallDatabase) getCompetitionLocationIds(competitionId int32)
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error from rows: %v", err)
+		return nil, fmt.Errorf("Error from rows: %w", err)
 	}
 
 	return locationIds, nil
 }
 
-func (database *HeroBallDatabase) getCompetitionTeams(competitionId int32) ([]int32, error) {
+func (database *HeroBallDatabase) getCompetitionTeams(ctx context.Context, competitionId int32) ([]int32, error) {
 
 	if competitionId <= 0 {
-		return nil, fmt.Errorf("Invalid competitionId")
+		return nil, invalidArgument("CompetitionId", "Must be greater than zero")
 	}
 
-	rows, err := database.db.Query(`
-		SELECT 
-			DISTINCT HomeTeamId AS TeamId
-		FROM 
-			Games
-		WHERE 
-			CompetitionId = $1
-		UNION SELECT 
-			DISTINCT AwayTeamId
-		FROM 
-			Games
-		WHERE 
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			TeamId
+		FROM
+			CompetitionTeams
+		WHERE
 			CompetitionId = $1
 	`, competitionId)
 
@@ -1205,7 +1126,7 @@ func (database *HeroBallDatabase) getCompetitionTeams(competitionId int32) ([]in
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting competition teams: %v", err)
+		return nil, fmt.Errorf("Error getting competition teams: %w", err)
 	}
 
 	teamIds := make([]int32, 0)
@@ -1216,7 +1137,7 @@ func (database *HeroBallDatabase) getCompetitionTeams(competitionId int32) ([]in
 		err = rows.Scan(&teamId)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error getting location: %v", err)
+			return nil, fmt.Errorf("Error getting location: %w", err)
 		}
 
 		teamIds = append(teamIds, teamId)
@@ -1225,18 +1146,18 @@ func (database *HeroBallDatabase) getCompetitionTeams(competitionId int32) ([]in
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error from rows: %v", err)
+		return nil, fmt.Errorf("Error from rows: %w", err)
 	}
 
 	return teamIds, nil
 }
 
-func (database *HeroBallDatabase) getAllCompetitions() ([]*pb.Competition, error) {
+func (database *HeroBallDatabase) getAllCompetitions(ctx context.Context) ([]*pb.Competition, error) {
 
 	/* get all the competitionIds */
 	compIds := make([]int32, 0)
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			CompetitionId
 		FROM
@@ -1270,14 +1191,14 @@ func (database *HeroBallDatabase) getAllCompetitions() ([]*pb.Competition, error
 		return nil, err
 	}
 
-	return database.getCompetitionsById(compIds)
+	return database.getCompetitionsById(ctx, compIds)
 }
 
-func (database *HeroBallDatabase) getAllPlayers() ([]*pb.Player, error) {
+func (database *HeroBallDatabase) getAllPlayers(ctx context.Context) ([]*pb.Player, error) {
 
 	playerIds := make([]int32, 0)
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			PlayerId
 		FROM
@@ -1311,13 +1232,13 @@ func (database *HeroBallDatabase) getAllPlayers() ([]*pb.Player, error) {
 		return nil, err
 	}
 
-	return database.getPlayersById(playerIds)
+	return database.getPlayersById(ctx, playerIds)
 }
 
-func (database *HeroBallDatabase) getAllTeams() ([]*pb.Team, error) {
+func (database *HeroBallDatabase) getAllTeams(ctx context.Context) ([]*pb.Team, error) {
 	teamIds := make([]int32, 0)
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			TeamId
 		FROM
@@ -1351,16 +1272,16 @@ func (database *HeroBallDatabase) getAllTeams() ([]*pb.Team, error) {
 		return nil, err
 	}
 
-	return database.getTeamsById(teamIds)
+	return database.getTeamsById(ctx, teamIds)
 }
 
-func (database *HeroBallDatabase) getAllTeamsForPlayer(playerId int32) ([]*pb.PlayerTeam, error) {
+func (database *HeroBallDatabase) getAllTeamsForPlayer(ctx context.Context, playerId int32) ([]*pb.PlayerTeam, error) {
 
 	if playerId <= 0 {
-		return nil, fmt.Errorf("Invalid playerId")
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			DISTINCT PlayerGameStats.TeamId,
 			Games.CompetitionId,
@@ -1380,7 +1301,7 @@ func (database *HeroBallDatabase) getAllTeamsForPlayer(playerId int32) ([]*pb.Pl
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting teams for player: %v", err)
+		return nil, fmt.Errorf("Error getting teams for player: %w", err)
 	}
 
 	teams := make([]*pb.PlayerTeam, 0)
@@ -1399,10 +1320,10 @@ func (database *HeroBallDatabase) getAllTeamsForPlayer(playerId int32) ([]*pb.Pl
 			&playerTeam.Team.Name)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error scanning team: %v", err)
+			return nil, fmt.Errorf("Error scanning team: %w", err)
 		}
 
-		comp, err := database.getCompetitionById(compId)
+		comp, err := database.getCompetitionById(ctx, compId)
 
 		if err != nil {
 			return nil, err
@@ -1410,7 +1331,7 @@ func (database *HeroBallDatabase) getAllTeamsForPlayer(playerId int32) ([]*pb.Pl
 
 		playerTeam.Competition = comp
 
-		playerStats, err := database.getPlayerTotalStatsForTeam(playerId, playerTeam.Team.TeamId)
+		playerStats, err := database.getPlayerTotalStatsForTeam(ctx, playerId, playerTeam.Team.TeamId)
 
 		if err != nil {
 			return nil, err
@@ -1424,13 +1345,13 @@ func (database *HeroBallDatabase) getAllTeamsForPlayer(playerId int32) ([]*pb.Pl
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error from scan: %v", err)
+		return nil, fmt.Errorf("Error from scan: %w", err)
 	}
 
 	/* now get all jersey numbers for that player in the team */
 	for _, team := range teams {
 
-		rows, err = database.db.Query(`
+		rows, err = database.db.QueryContext(ctx, `
 			SELECT
 				DISTINCT JerseyNumber
 			FROM
@@ -1454,7 +1375,7 @@ func (database *HeroBallDatabase) getAllTeamsForPlayer(playerId int32) ([]*pb.Pl
 			err = rows.Scan(&jNum)
 
 			if err != nil {
-				return nil, fmt.Errorf("Error scanning jersey number: %v", err)
+				return nil, fmt.Errorf("Error scanning jersey number: %w", err)
 			}
 
 			team.JerseyNumbers = append(team.JerseyNumbers, jNum)
@@ -1463,20 +1384,20 @@ func (database *HeroBallDatabase) getAllTeamsForPlayer(playerId int32) ([]*pb.Pl
 		err = rows.Err()
 
 		if err != nil {
-			return nil, fmt.Errorf("Error scanning jersey: %v", err)
+			return nil, fmt.Errorf("Error scanning jersey: %w", err)
 		}
 	}
 
 	return teams, nil
 }
 
-func (database *HeroBallDatabase) getTeamsById(teamIds []int32) ([]*pb.Team, error) {
+func (database *HeroBallDatabase) getTeamsById(ctx context.Context, teamIds []int32) ([]*pb.Team, error) {
 
 	if teamIds == nil {
 		return nil, fmt.Errorf("Invalid teamIds")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			TeamId,
 			Name
@@ -1491,7 +1412,7 @@ func (database *HeroBallDatabase) getTeamsById(teamIds []int32) ([]*pb.Team, err
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting teams: %v", err)
+		return nil, fmt.Errorf("Error getting teams: %w", err)
 	}
 
 	teams := make([]*pb.Team, 0)
@@ -1503,7 +1424,7 @@ func (database *HeroBallDatabase) getTeamsById(teamIds []int32) ([]*pb.Team, err
 		err = rows.Scan(&team.TeamId, &team.Name)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error getting team info: %v", err)
+			return nil, fmt.Errorf("Error getting team info: %w", err)
 		}
 
 		teams = append(teams, &team)
@@ -1512,19 +1433,19 @@ func (database *HeroBallDatabase) getTeamsById(teamIds []int32) ([]*pb.Team, err
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting teams: %v", err)
+		return nil, fmt.Errorf("Error getting teams: %w", err)
 	}
 
 	return teams, nil
 }
 
-func (database *HeroBallDatabase) getLocations(locationIds []int32) ([]*pb.Location, error) {
+func (database *HeroBallDatabase) getLocations(ctx context.Context, locationIds []int32) ([]*pb.Location, error) {
 
 	if locationIds == nil {
 		return nil, fmt.Errorf("Invalid locationIds")
 	}
 
-	rows, err := database.db.Query(`
+	rows, err := database.db.QueryContext(ctx, `
 		SELECT
 			LocationId,
 			Name
@@ -1539,7 +1460,7 @@ func (database *HeroBallDatabase) getLocations(locationIds []int32) ([]*pb.Locat
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting Locations: %v", err)
+		return nil, fmt.Errorf("Error getting Locations: %w", err)
 	}
 
 	locations := make([]*pb.Location, 0)
@@ -1551,7 +1472,7 @@ func (database *HeroBallDatabase) getLocations(locationIds []int32) ([]*pb.Locat
 		err = rows.Scan(&location.LocationId, &location.Name)
 
 		if err != nil {
-			return nil, fmt.Errorf("Error getting location info: %v", err)
+			return nil, fmt.Errorf("Error getting location info: %w", err)
 		}
 
 		locations = append(locations, &location)
@@ -1560,83 +1481,21 @@ func (database *HeroBallDatabase) getLocations(locationIds []int32) ([]*pb.Locat
 	err = rows.Err()
 
 	if err != nil {
-		return nil, fmt.Errorf("Error getting locations: %v", err)
+		return nil, fmt.Errorf("Error getting locations: %w", err)
 	}
 
 	return locations, nil
 }
 
-/* OPTIMISE with MATERIAL VIEW */
-func (database *HeroBallDatabase) getStandingsForCompetition(competitionId int32) ([]*pb.CompetitionTeam, error) {
-
-	/* get games in compeittion */
-
-	if competitionId <= 0 {
-		return nil, fmt.Errorf("Invalid competitionId")
-	}
-
-	/* now turn the teams map into an ordered list */
-	teams := make([]*pb.CompetitionTeam, 0)
-
-	rows, err := database.db.Query(`
-		SELECT 
-			TeamId, 
-			TeamName, 
-			GamesWon, 
-			GamesLost,
-			GamesDrawn
-		FROM 
-			CompetitionStandingsView 
-		WHERE 
-			CompetitionId = $1
-		ORDER BY
-			GamesWon
-		DESC
-		`,
-		competitionId)
-
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("That competitionId does not exist")
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	for rows.Next() {
-
-		team := pb.CompetitionTeam{
-			Team: &pb.Team{},
-		}
-
-		/* now to scan them all */
-		err = rows.Scan(&team.Team.TeamId, &team.Team.Name, &team.Won, &team.Lost, &team.Drawn)
-
-		if err != nil {
-			return nil, err
-		}
-
-		teams = append(teams, &team)
-	}
-
-	err = rows.Err()
-
-	if err != nil {
-		return nil, err
-	}
-
-	return teams, nil
-}
-
-func (database *HeroBallDatabase) getTeamGameCount(teamId int32) (int32, error) {
+func (database *HeroBallDatabase) getTeamGameCount(ctx context.Context, teamId int32) (int32, error) {
 
 	var teamGameCount int32
 
 	if teamId <= 0 {
-		return 0, fmt.Errorf("Invalid teamId")
+		return 0, invalidArgument("TeamId", "Must be greater than zero")
 	}
 
-	err := database.db.QueryRow(`
+	err := database.db.QueryRowContext(ctx, `
 		SELECT
 			COUNT(DISTINCT PlayerGameStats.GameId)
 		FROM
@@ -1657,33 +1516,110 @@ func (database *HeroBallDatabase) getTeamGameCount(teamId int32) (int32, error)
 
 }
 
-func (database *HeroBallDatabase) getCompetitionForTeam(teamId int32) (int32, error) {
+func (database *HeroBallDatabase) getCompetitionForTeam(ctx context.Context, teamId int32) (int32, error) {
 
 	if teamId <= 0 {
-		return 0, fmt.Errorf("Invalid teamId")
+		return 0, invalidArgument("TeamId", "Must be greater than zero")
 	}
 
 	var competitionId int32
 
-	err := database.db.QueryRow(`
+	/* the competition the team last played in, or most recently entered */
+	err := database.db.QueryRowContext(ctx, `
 		SELECT
-			CompetitionId
+			CompetitionTeams.CompetitionId
 		FROM
-			PlayerGameStats
+			CompetitionTeams
 		LEFT JOIN
-			Games ON Games.GameId = PlayerGameStats.GameId
+			Games ON Games.CompetitionId = CompetitionTeams.CompetitionId AND
+				(Games.HomeTeamId = CompetitionTeams.TeamId OR Games.AwayTeamId = CompetitionTeams.TeamId)
+		WHERE
+			CompetitionTeams.TeamId = $1
+		GROUP BY
+			CompetitionTeams.CompetitionId
 		ORDER BY
-			Games.GameTime ASC
+			MAX(Games.GameTime) DESC NULLS LAST,
+			CompetitionTeams.CompetitionId DESC
 		LIMIT 1
-		`).Scan(&competitionId)
+		`, teamId).Scan(&competitionId)
 
+	/* not entered in anything yet */
 	if err == sql.ErrNoRows {
-		return 0, fmt.Errorf("Team does not exist")
+		return 0, nil
 	}
 
 	if err != nil {
-		return 0, fmt.Errorf("Error getting comp for team: %v", err)
+		return 0, fmt.Errorf("Error getting comp for team: %w", err)
 	}
 
 	return competitionId, nil
 }
+
+func (database *HeroBallDatabase) isTeamInCompetition(ctx context.Context, teamId int32, competitionId int32) (bool, error) {
+
+	var entered bool
+
+	err := database.db.QueryRowContext(ctx, `
+		SELECT
+			EXISTS (SELECT 1 FROM CompetitionTeams WHERE TeamId = $1 AND CompetitionId = $2)
+		`, teamId, competitionId).Scan(&entered)
+
+	if err != nil {
+		return false, fmt.Errorf("Error checking team competition: %w", err)
+	}
+
+	return entered, nil
+}
+
+func (database *HeroBallDatabase) getRosterForTeamInCompetition(ctx context.Context, teamId int32, competitionId int32) ([]*pb.RosterPlayer, error) {
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			Players.PlayerId,
+			Players.Name,
+			Players.Position,
+			CompetitionRosters.JerseyNumber
+		FROM
+			CompetitionRosters
+		LEFT JOIN
+			Players ON Players.PlayerId = CompetitionRosters.PlayerId
+		WHERE
+			CompetitionRosters.TeamId = $1 AND CompetitionRosters.CompetitionId = $2
+		ORDER BY
+			CompetitionRosters.JerseyNumber ASC,
+			Players.Name ASC
+		`, teamId, competitionId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting roster: %w", err)
+	}
+
+	roster := make([]*pb.RosterPlayer, 0)
+
+	for rows.Next() {
+
+		rosterPlayer := &pb.RosterPlayer{
+			Player: &pb.Player{},
+		}
+
+		err = rows.Scan(
+			&rosterPlayer.Player.PlayerId,
+			&rosterPlayer.Player.Name,
+			&rosterPlayer.Player.Position,
+			&rosterPlayer.JerseyNumber)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning roster: %w", err)
+		}
+
+		roster = append(roster, rosterPlayer)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting roster: %w", err)
+	}
+
+	return roster, nil
+}
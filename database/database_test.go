@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+const fakeGameCount = 60
+
+/* serves fakeGameCount games, newest first, to GetGamesCursor */
+func fakeGamesHandler(t testing.TB) fakeQueryHandler {
+
+	tipOff := time.Date(2021, 4, 10, 19, 30, 0, 0, time.UTC)
+
+	return func(query string, args []driver.NamedValue) ([][]driver.Value, error) {
+
+		switch {
+		case strings.HasPrefix(query, "SELECT COUNT(DISTINCT Games.GameId)"):
+			return [][]driver.Value{{int64(fakeGameCount)}}, nil
+
+		case strings.HasPrefix(query, "SELECT DISTINCT Games.GameId, Games.GameTime"):
+
+			limit, offset := args[7].Value.(int64), args[8].Value.(int64)
+			rows := make([][]driver.Value, 0)
+
+			for gameId := offset + 1; gameId <= offset+limit && gameId <= fakeGameCount; gameId++ {
+				rows = append(rows, []driver.Value{gameId, tipOff.Add(-time.Duration(gameId) * time.Hour)})
+			}
+
+			return rows, nil
+
+		case strings.Contains(query, "WHERE Games.GameId = ANY($1)"):
+
+			values := fakeIntArray(t, args[0])
+			rows := make([][]driver.Value, 0)
+
+			for _, gameId := range values {
+				rows = append(rows, []driver.Value{
+					gameId,
+					int64(1), "Hawks",
+					int64(2), "Owls",
+					int64(1), "Stadium",
+					int64(1), "Winter", int64(4),
+					int64(1), "League", "A",
+					tipOff.Add(-time.Duration(gameId) * time.Hour),
+					"final",
+					int64(0),
+					int64(0),
+					int64(80),
+					int64(72),
+				})
+			}
+
+			return rows, nil
+		}
+
+		return nil, fmt.Errorf("Unexpected query %q", query)
+	}
+}
+
+/* the games of a page are loaded together, so a bigger page costs no more queries */
+func TestGetGamesCursorQueryCount(t *testing.T) {
+
+	statements := make(map[int32]int)
+
+	for _, count := range []int32{1, 10, 50} {
+
+		database, connector := newFakeDatabase(t, fakeGamesHandler(t))
+
+		cursor, err := database.GetGamesCursor(context.Background(), 0, count, nil)
+
+		if err != nil {
+			t.Fatalf("Error getting %v games: %v", count, err)
+		}
+
+		if int32(len(cursor.GetGames())) != count || cursor.GetNextOffset() != count || cursor.GetTotal() != fakeGameCount {
+			t.Fatalf("Page of %v has %v games, next offset %v and total %v", count, len(cursor.GetGames()), cursor.GetNextOffset(), cursor.GetTotal())
+		}
+
+		if game := cursor.GetGames()[0]; game.GetCompetition().GetLeague().GetName() != "League" || game.GetResult().GetHomeTeamPoints() != 80 {
+			t.Fatalf("Game was not fully loaded: %v", game)
+		}
+
+		statements[count] = connector.statements
+	}
+
+	if statements[1] != statements[10] || statements[1] != statements[50] {
+		t.Errorf("Queries grow with the page size: %v", statements)
+	}
+}
+
+func BenchmarkGetGamesCursor(b *testing.B) {
+
+	for _, count := range []int32{1, 10, 50} {
+		b.Run(fmt.Sprintf("count=%v", count), func(b *testing.B) {
+
+			database, connector := newFakeDatabase(b, fakeGamesHandler(b))
+
+			for i := 0; i < b.N; i++ {
+
+				_, err := database.GetGamesCursor(context.Background(), 0, count, nil)
+
+				if err != nil {
+					b.Fatalf("Error getting games: %v", err)
+				}
+			}
+
+			b.ReportMetric(float64(connector.statements)/float64(b.N), "queries/op")
+		})
+	}
+}
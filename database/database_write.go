@@ -0,0 +1,919 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	MaxRegularFouls   = 5
+	MaxTechnicalFouls = 2
+)
+
+/* accepted formats for GameTime on writes */
+var gameTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+}
+
+/* maps the postgres foreign key constraints onto the request field that caused them */
+var foreignKeyFields = map[string]string{
+	"games_competitionid_fkey":            "CompetitionId",
+	"games_locationid_fkey":               "LocationId",
+	"games_hometeamid_fkey":               "HomeTeamId",
+	"games_awayteamid_fkey":               "AwayTeamId",
+	"playergamestats_playerid_fkey":       "Stats.PlayerId",
+	"playergamestats_teamid_fkey":         "Stats.TeamId",
+	"competitionteams_competitionid_fkey": "CompetitionId",
+	"competitionteams_teamid_fkey":        "TeamId",
+	"competitionrosters_playerid_fkey":    "Players.PlayerId",
+	"gameevents_playerid_fkey":            "Event.PlayerId",
+	"gamesubstitutions_playerinid_fkey":   "Substitution.PlayerInId",
+	"gamesubstitutions_playeroutid_fkey":  "Substitution.PlayerOutId",
+	"games_forfeitteamid_fkey":            "ForfeitTeamId",
+	"games_seriesid_fkey":                 "SeriesId",
+	"awards_competitionid_fkey":           "Award.CompetitionId",
+	"awardvotes_playerid_fkey":            "PlayerIds",
+}
+
+type gameRow struct {
+	competitionId int32
+	locationId    int32
+	homeTeamId    int32
+	awayTeamId    int32
+	gameTime      time.Time
+	status        string
+	forfeitTeamId int32
+	seriesId      int32
+}
+
+func (database *HeroBallDatabase) CreateGame(ctx context.Context, request *pb.CreateGameRequest) (*pb.CreateGameResponse, error) {
+
+	violations := fieldViolations{}
+
+	game := validateGameRow(&violations, request.GetCompetitionId(), request.GetLocationId(), request.GetHomeTeamId(), request.GetAwayTeamId(), request.GetGameTime())
+	validatePlayerGameStatsEntries(&violations, request.GetStats(), game.homeTeamId, game.awayTeamId)
+
+	game.status = request.GetStatus()
+	game.forfeitTeamId = request.GetForfeitTeamId()
+	game.seriesId = request.GetSeriesId()
+
+	if game.seriesId < 0 {
+		violations.add("SeriesId", "Must be zero or greater")
+	}
+
+	/* a game entered with its stats has been played */
+	if game.status == "" && len(request.GetStats()) > 0 {
+		game.status = "final"
+	} else if game.status == "" {
+		game.status = "scheduled"
+	}
+
+	validateGameStatus(&violations, game.status, game.forfeitTeamId, game.homeTeamId, game.awayTeamId)
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	if game.seriesId != 0 {
+
+		err = validateSeriesGameInTx(ctx, tx, game, 0)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	gameId, err := createGameInTx(ctx, tx, game, request.GetStats())
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing game", err)
+	}
+
+	log.Printf("Created game %v with %v stat lines", gameId, len(request.GetStats()))
+
+	return &pb.CreateGameResponse{
+		GameId: gameId,
+	}, nil
+}
+
+/* the game must already be validated, entries are optional */
+func createGameInTx(ctx context.Context, tx *sql.Tx, game gameRow, entries []*pb.PlayerGameStatsEntry) (int32, error) {
+
+	var gameId int32
+
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO Games (
+			CompetitionId,
+			LocationId,
+			HomeTeamId,
+			AwayTeamId,
+			GameTime,
+			Status,
+			ForfeitTeamId,
+			SeriesId)
+		VALUES
+			($1, $2, $3, $4, $5, $6, NULLIF($7, 0), NULLIF($8, 0))
+		RETURNING GameId`,
+		game.competitionId,
+		game.locationId,
+		game.homeTeamId,
+		game.awayTeamId,
+		game.gameTime,
+		game.status,
+		game.forfeitTeamId,
+		game.seriesId).Scan(&gameId)
+
+	if err != nil {
+		return 0, writeError("Error inserting game", err)
+	}
+
+	_, err = upsertPlayerGameStatsInTx(ctx, tx, gameId, entries)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return gameId, nil
+}
+
+func (database *HeroBallDatabase) UpdateGame(ctx context.Context, request *pb.UpdateGameRequest) (*pb.UpdateGameResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetGameId() <= 0 {
+		violations.add("GameId", "Must be greater than zero")
+	}
+
+	game := validateGameRow(&violations, request.GetCompetitionId(), request.GetLocationId(), request.GetHomeTeamId(), request.GetAwayTeamId(), request.GetGameTime())
+	validatePlayerGameStatsEntries(&violations, request.GetStats(), game.homeTeamId, game.awayTeamId)
+
+	game.status = request.GetStatus()
+	game.forfeitTeamId = request.GetForfeitTeamId()
+	game.seriesId = request.GetSeriesId()
+
+	if game.seriesId < 0 {
+		violations.add("SeriesId", "Must be zero or greater")
+	}
+
+	if game.status != "" {
+		validateGameStatus(&violations, game.status, game.forfeitTeamId, game.homeTeamId, game.awayTeamId)
+	} else if game.forfeitTeamId != 0 {
+		violations.add("Status", "Must be forfeit when a ForfeitTeamId is given")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	if game.seriesId != 0 {
+
+		err = validateSeriesGameInTx(ctx, tx, game, request.GetGameId())
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	/* an empty status leaves the status and forfeit team as they were */
+	result, err := tx.ExecContext(ctx, `
+		UPDATE
+			Games
+		SET
+			CompetitionId = $2,
+			LocationId = $3,
+			HomeTeamId = $4,
+			AwayTeamId = $5,
+			GameTime = $6,
+			Status = COALESCE(NULLIF($7, '')::gamestatus, Status),
+			ForfeitTeamId = CASE WHEN $7 = '' THEN ForfeitTeamId ELSE NULLIF($8, 0) END,
+			SeriesId = NULLIF($9, 0)
+		WHERE
+			GameId = $1`,
+		request.GetGameId(),
+		game.competitionId,
+		game.locationId,
+		game.homeTeamId,
+		game.awayTeamId,
+		game.gameTime,
+		game.status,
+		game.forfeitTeamId,
+		game.seriesId)
+
+	if err != nil {
+		return nil, writeError("Error updating game", err)
+	}
+
+	updated, err := result.RowsAffected()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting updated game count: %w", err)
+	}
+
+	if updated == 0 {
+		return nil, notFound("gameId", request.GetGameId())
+	}
+
+	/* stats already recorded must still belong to one of the two teams */
+	var orphanedStats int32
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			COUNT(StatsId)
+		FROM
+			PlayerGameStats
+		WHERE
+			GameId = $1 AND
+			NOT (TeamId = $2 OR TeamId = $3) AND
+			NOT (PlayerId = ANY($4))`,
+		request.GetGameId(),
+		game.homeTeamId,
+		game.awayTeamId,
+		pq.Array(entryPlayerIds(request.GetStats()))).Scan(&orphanedStats)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error checking existing stats: %w", err)
+	}
+
+	if orphanedStats > 0 {
+		violations.add("HomeTeamId", "%v existing stat lines belong to a team no longer in this game", orphanedStats)
+		return nil, violations.err()
+	}
+
+	_, err = upsertPlayerGameStatsInTx(ctx, tx, request.GetGameId(), request.GetStats())
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing game", err)
+	}
+
+	log.Printf("Updated game %v with %v stat lines", request.GetGameId(), len(request.GetStats()))
+
+	return &pb.UpdateGameResponse{
+		GameId: request.GetGameId(),
+	}, nil
+}
+
+func (database *HeroBallDatabase) DeleteGame(ctx context.Context, request *pb.DeleteGameRequest) (*pb.DeleteGameResponse, error) {
+
+	if request.GetGameId() <= 0 {
+		violations := fieldViolations{}
+		violations.add("GameId", "Must be greater than zero")
+		return nil, violations.err()
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			GameEvents
+		WHERE
+			GameId = $1`,
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting game events: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			GameSubstitutions
+		WHERE
+			GameId = $1`,
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting game substitutions: %w", err)
+	}
+
+	/* the rest of the ratings catch up on the next refresh */
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			TeamRatings
+		WHERE
+			GameId = $1`,
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting team ratings: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			AwardVotes
+		WHERE
+			GameId = $1`,
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting award votes: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			GamePeriodScores
+		WHERE
+			GameId = $1`,
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting game period scores: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			PlayerGameStats
+		WHERE
+			GameId = $1`,
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting game stats: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM
+			Games
+		WHERE
+			GameId = $1`,
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting game: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting deleted game count: %w", err)
+	}
+
+	if deleted == 0 {
+		return nil, notFound("gameId", request.GetGameId())
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error committing game delete: %w", err)
+	}
+
+	log.Printf("Deleted game %v", request.GetGameId())
+
+	return &pb.DeleteGameResponse{
+		GameId: request.GetGameId(),
+	}, nil
+}
+
+func (database *HeroBallDatabase) UpsertPlayerGameStats(ctx context.Context, request *pb.UpsertPlayerGameStatsRequest) (*pb.UpsertPlayerGameStatsResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetGameId() <= 0 {
+		violations.add("GameId", "Must be greater than zero")
+	}
+
+	if len(request.GetStats()) == 0 {
+		violations.add("Stats", "Must supply at least one stat line")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	/* lock the game so the teams can't change underneath us */
+	game, err := lockGameInTx(ctx, tx, request.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	validatePlayerGameStatsEntries(&violations, request.GetStats(), game.homeTeamId, game.awayTeamId)
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	statsIds, err := upsertPlayerGameStatsInTx(ctx, tx, request.GetGameId(), request.GetStats())
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing stats", err)
+	}
+
+	log.Printf("Upserted %v stat lines for game %v", len(statsIds), request.GetGameId())
+
+	return &pb.UpsertPlayerGameStatsResponse{
+		StatsIds: statsIds,
+	}, nil
+}
+
+func (database *HeroBallDatabase) UpdateCompetitionRoster(ctx context.Context, request *pb.UpdateCompetitionRosterRequest) (*pb.UpdateCompetitionRosterResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetCompetitionId() <= 0 {
+		violations.add("CompetitionId", "Must be greater than zero")
+	}
+
+	if request.GetTeamId() <= 0 {
+		violations.add("TeamId", "Must be greater than zero")
+	}
+
+	seenPlayers := make(map[int32]bool)
+
+	for i, entry := range request.GetPlayers() {
+
+		field := fmt.Sprintf("Players[%v]", i)
+
+		if entry.GetPlayerId() <= 0 {
+			violations.add(field+".PlayerId", "Must be greater than zero")
+		} else if seenPlayers[entry.GetPlayerId()] {
+			violations.add(field+".PlayerId", "Player %v is listed more than once", entry.GetPlayerId())
+		}
+
+		seenPlayers[entry.GetPlayerId()] = true
+
+		if entry.GetJerseyNumber() < 0 {
+			violations.add(field+".JerseyNumber", "Must not be negative")
+		}
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO CompetitionTeams (
+			CompetitionId,
+			TeamId)
+		VALUES
+			($1, $2)
+		ON CONFLICT DO NOTHING`,
+		request.GetCompetitionId(),
+		request.GetTeamId())
+
+	if err != nil {
+		return nil, writeError("Error entering team in competition", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			CompetitionRosters
+		WHERE
+			CompetitionId = $1 AND TeamId = $2`,
+		request.GetCompetitionId(),
+		request.GetTeamId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error clearing roster: %w", err)
+	}
+
+	for _, entry := range request.GetPlayers() {
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO CompetitionRosters (
+				CompetitionId,
+				TeamId,
+				PlayerId,
+				JerseyNumber)
+			VALUES
+				($1, $2, $3, $4)`,
+			request.GetCompetitionId(),
+			request.GetTeamId(),
+			entry.GetPlayerId(),
+			entry.GetJerseyNumber())
+
+		if err != nil {
+			return nil, writeError(fmt.Sprintf("Error registering player %v", entry.GetPlayerId()), err)
+		}
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing roster", err)
+	}
+
+	log.Printf("Set roster of team %v in competition %v to %v players", request.GetTeamId(), request.GetCompetitionId(), len(request.GetPlayers()))
+
+	return &pb.UpdateCompetitionRosterResponse{
+		CompetitionId: request.GetCompetitionId(),
+		TeamId:        request.GetTeamId(),
+	}, nil
+}
+
+/* also enters the game's teams in its competition and registers any new players to their rosters */
+func upsertPlayerGameStatsInTx(ctx context.Context, tx *sql.Tx, gameId int32, entries []*pb.PlayerGameStatsEntry) ([]int32, error) {
+
+	err := enterGameTeamsInTx(ctx, tx, gameId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	/* lines for players with events are kept in step with those events instead */
+	derivedPlayerIds, err := getPlayersWithEventsInTx(ctx, tx, gameId, entryPlayerIds(entries))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(derivedPlayerIds) > 0 {
+
+		violations := fieldViolations{}
+
+		for i, entry := range entries {
+			if derivedPlayerIds[entry.GetPlayerId()] {
+				violations.add(fmt.Sprintf("Stats[%v].PlayerId", i), "Stats for player %v are derived from the game's events", entry.GetPlayerId())
+			}
+		}
+
+		return nil, violations.errWithCode(codes.FailedPrecondition, "Stat lines are derived from game events")
+	}
+
+	statsIds := make([]int32, 0)
+
+	for _, entry := range entries {
+
+		stats := entry.GetStats()
+
+		if stats == nil {
+			stats = &pb.Stats{}
+		}
+
+		var statsId int32
+
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO PlayerGameStats (
+				GameId,
+				PlayerId,
+				TeamId,
+				JerseyNumber,
+				TwoPointFGA,
+				TwoPointFGM,
+				ThreePointFGA,
+				ThreePointFGM,
+				FreeThrowsAttempted,
+				FreeThrowsMade,
+				OffensiveRebounds,
+				DefensiveRebounds,
+				Assists,
+				Blocks,
+				Steals,
+				Turnovers,
+				RegularFoulsForced,
+				RegularFoulsCommitted,
+				TechnicalFoulsCommitted,
+				MinutesPlayed)
+			VALUES
+				($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+			ON CONFLICT ON CONSTRAINT player_game_unique DO UPDATE SET
+				TeamId = EXCLUDED.TeamId,
+				JerseyNumber = EXCLUDED.JerseyNumber,
+				TwoPointFGA = EXCLUDED.TwoPointFGA,
+				TwoPointFGM = EXCLUDED.TwoPointFGM,
+				ThreePointFGA = EXCLUDED.ThreePointFGA,
+				ThreePointFGM = EXCLUDED.ThreePointFGM,
+				FreeThrowsAttempted = EXCLUDED.FreeThrowsAttempted,
+				FreeThrowsMade = EXCLUDED.FreeThrowsMade,
+				OffensiveRebounds = EXCLUDED.OffensiveRebounds,
+				DefensiveRebounds = EXCLUDED.DefensiveRebounds,
+				Assists = EXCLUDED.Assists,
+				Blocks = EXCLUDED.Blocks,
+				Steals = EXCLUDED.Steals,
+				Turnovers = EXCLUDED.Turnovers,
+				RegularFoulsForced = EXCLUDED.RegularFoulsForced,
+				RegularFoulsCommitted = EXCLUDED.RegularFoulsCommitted,
+				TechnicalFoulsCommitted = EXCLUDED.TechnicalFoulsCommitted,
+				MinutesPlayed = EXCLUDED.MinutesPlayed
+			RETURNING StatsId`,
+			gameId,
+			entry.GetPlayerId(),
+			entry.GetTeamId(),
+			entry.GetJerseyNumber(),
+			stats.TwoPointFGA,
+			stats.TwoPointFGM,
+			stats.ThreePointFGA,
+			stats.ThreePointFGM,
+			stats.FreeThrowsAttempted,
+			stats.FreeThrowsMade,
+			stats.OffensiveRebounds,
+			stats.DefensiveRebounds,
+			stats.Assists,
+			stats.Blocks,
+			stats.Steals,
+			stats.Turnovers,
+			stats.RegularFoulsForced,
+			stats.RegularFoulsCommitted,
+			stats.TechnicalFoulsCommitted,
+			stats.MinutesPlayed).Scan(&statsId)
+
+		if err != nil {
+			return nil, writeError(fmt.Sprintf("Error writing stats for player %v", entry.GetPlayerId()), err)
+		}
+
+		err = registerPlayerInTx(ctx, tx, gameId, entry.GetTeamId(), entry.GetPlayerId(), entry.GetJerseyNumber())
+
+		if err != nil {
+			return nil, err
+		}
+
+		statsIds = append(statsIds, statsId)
+	}
+
+	return statsIds, nil
+}
+
+func enterGameTeamsInTx(ctx context.Context, tx *sql.Tx, gameId int32) error {
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO CompetitionTeams (
+			CompetitionId,
+			TeamId)
+		SELECT CompetitionId, HomeTeamId FROM Games WHERE GameId = $1
+		UNION SELECT CompetitionId, AwayTeamId FROM Games WHERE GameId = $1
+		ON CONFLICT DO NOTHING`,
+		gameId)
+
+	if err != nil {
+		return writeError("Error entering teams in competition", err)
+	}
+
+	return nil
+}
+
+/* keeps the jersey number of an existing registration */
+func registerPlayerInTx(ctx context.Context, tx *sql.Tx, gameId int32, teamId int32, playerId int32, jerseyNumber int32) error {
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO CompetitionRosters (
+			CompetitionId,
+			TeamId,
+			PlayerId,
+			JerseyNumber)
+		SELECT
+			CompetitionId, $2, $3, $4
+		FROM
+			Games
+		WHERE
+			GameId = $1
+		ON CONFLICT DO NOTHING`,
+		gameId,
+		teamId,
+		playerId,
+		jerseyNumber)
+
+	if err != nil {
+		return writeError(fmt.Sprintf("Error registering player %v", playerId), err)
+	}
+
+	return nil
+}
+
+func validateGameRow(violations *fieldViolations, competitionId int32, locationId int32, homeTeamId int32, awayTeamId int32, gameTime string) gameRow {
+
+	game := gameRow{
+		competitionId: competitionId,
+		locationId:    locationId,
+		homeTeamId:    homeTeamId,
+		awayTeamId:    awayTeamId,
+	}
+
+	if competitionId <= 0 {
+		violations.add("CompetitionId", "Must be greater than zero")
+	}
+
+	if locationId <= 0 {
+		violations.add("LocationId", "Must be greater than zero")
+	}
+
+	if homeTeamId <= 0 {
+		violations.add("HomeTeamId", "Must be greater than zero")
+	}
+
+	if awayTeamId <= 0 {
+		violations.add("AwayTeamId", "Must be greater than zero")
+	}
+
+	if homeTeamId == awayTeamId {
+		violations.add("AwayTeamId", "Must be different to HomeTeamId")
+	}
+
+	parsed, err := ParseGameTime(gameTime)
+
+	if err != nil {
+		violations.add("GameTime", "%v", err)
+	}
+
+	game.gameTime = parsed
+
+	return game
+}
+
+func validatePlayerGameStatsEntries(violations *fieldViolations, entries []*pb.PlayerGameStatsEntry, homeTeamId int32, awayTeamId int32) {
+
+	seenPlayers := make(map[int32]bool)
+
+	for i, entry := range entries {
+
+		field := fmt.Sprintf("Stats[%v]", i)
+
+		if entry.GetPlayerId() <= 0 {
+			violations.add(field+".PlayerId", "Must be greater than zero")
+		} else if seenPlayers[entry.GetPlayerId()] {
+			violations.add(field+".PlayerId", "Player %v has more than one stat line", entry.GetPlayerId())
+		}
+
+		seenPlayers[entry.GetPlayerId()] = true
+
+		if entry.GetTeamId() != homeTeamId && entry.GetTeamId() != awayTeamId {
+			violations.add(field+".TeamId", "Must be the home (%v) or away (%v) team", homeTeamId, awayTeamId)
+		}
+
+		if entry.GetJerseyNumber() < 0 {
+			violations.add(field+".JerseyNumber", "Must not be negative")
+		}
+
+		validateStats(violations, field+".Stats", entry.GetStats())
+	}
+}
+
+/* mirrors the CHECK constraints on PlayerGameStats */
+func validateStats(violations *fieldViolations, field string, stats *pb.Stats) {
+
+	if stats == nil {
+		return
+	}
+
+	counts := []struct {
+		name  string
+		value int32
+	}{
+		{"TwoPointFGA", stats.TwoPointFGA},
+		{"TwoPointFGM", stats.TwoPointFGM},
+		{"ThreePointFGA", stats.ThreePointFGA},
+		{"ThreePointFGM", stats.ThreePointFGM},
+		{"FreeThrowsAttempted", stats.FreeThrowsAttempted},
+		{"FreeThrowsMade", stats.FreeThrowsMade},
+		{"OffensiveRebounds", stats.OffensiveRebounds},
+		{"DefensiveRebounds", stats.DefensiveRebounds},
+		{"Assists", stats.Assists},
+		{"Blocks", stats.Blocks},
+		{"Steals", stats.Steals},
+		{"Turnovers", stats.Turnovers},
+		{"RegularFoulsForced", stats.RegularFoulsForced},
+		{"RegularFoulsCommitted", stats.RegularFoulsCommitted},
+		{"TechnicalFoulsCommitted", stats.TechnicalFoulsCommitted},
+		{"MinutesPlayed", stats.MinutesPlayed},
+	}
+
+	for _, count := range counts {
+		if count.value < 0 {
+			violations.add(field+"."+count.name, "Must not be negative")
+		}
+	}
+
+	if stats.TwoPointFGM > stats.TwoPointFGA {
+		violations.add(field+".TwoPointFGM", "Made (%v) must not exceed attempted (%v)", stats.TwoPointFGM, stats.TwoPointFGA)
+	}
+
+	if stats.ThreePointFGM > stats.ThreePointFGA {
+		violations.add(field+".ThreePointFGM", "Made (%v) must not exceed attempted (%v)", stats.ThreePointFGM, stats.ThreePointFGA)
+	}
+
+	if stats.FreeThrowsMade > stats.FreeThrowsAttempted {
+		violations.add(field+".FreeThrowsMade", "Made (%v) must not exceed attempted (%v)", stats.FreeThrowsMade, stats.FreeThrowsAttempted)
+	}
+
+	if stats.RegularFoulsCommitted > MaxRegularFouls {
+		violations.add(field+".RegularFoulsCommitted", "Must be at most %v", MaxRegularFouls)
+	}
+
+	if stats.TechnicalFoulsCommitted > MaxTechnicalFouls {
+		violations.add(field+".TechnicalFoulsCommitted", "Must be at most %v", MaxTechnicalFouls)
+	}
+}
+
+func ParseGameTime(gameTime string) (time.Time, error) {
+
+	if gameTime == "" {
+		return time.Time{}, fmt.Errorf("Must supply a game time")
+	}
+
+	for _, layout := range gameTimeLayouts {
+
+		parsed, err := time.Parse(layout, gameTime)
+
+		if err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("Unrecognised time format %q, expecting RFC3339 or 2006-01-02 15:04", gameTime)
+}
+
+func entryPlayerIds(entries []*pb.PlayerGameStatsEntry) []int32 {
+
+	playerIds := make([]int32, 0)
+
+	for _, entry := range entries {
+		playerIds = append(playerIds, entry.GetPlayerId())
+	}
+
+	return playerIds
+}
+
+/* turns constraint failures from postgres into field violations, anything else is passed up */
+func writeError(message string, err error) error {
+
+	pqErr, ok := err.(*pq.Error)
+
+	if !ok {
+		return fmt.Errorf("%v: %w", message, err)
+	}
+
+	violations := fieldViolations{}
+
+	switch pqErr.Code.Name() {
+	case "foreign_key_violation":
+		field, found := foreignKeyFields[pqErr.Constraint]
+
+		if !found {
+			field = pqErr.Constraint
+		}
+
+		violations.add(field, "Referenced row does not exist")
+	case "check_violation":
+		violations.add(pqErr.Constraint, "Failed check constraint")
+	case "unique_violation":
+		violations.add(pqErr.Constraint, "Already exists")
+	default:
+		return fmt.Errorf("%v: %w", message, err)
+	}
+
+	return violations.err()
+}
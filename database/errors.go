@@ -0,0 +1,167 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ * errors meant for the caller carry a gRPC status, anything else is an internal
+ * failure whose detail (SQL and all) only goes to the log. Wrap with %w so
+ * StatusError can still find the status.
+ */
+
+/* collects invalid fields, returned to callers as InvalidArgument with BadRequest details */
+type fieldViolations []*errdetails.BadRequest_FieldViolation
+
+func (v *fieldViolations) add(field string, format string, args ...interface{}) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v fieldViolations) err() error {
+	return v.errWithCode(codes.InvalidArgument, "Request failed validation")
+}
+
+func (v fieldViolations) errWithCode(code codes.Code, message string) error {
+
+	if len(v) == 0 {
+		return nil
+	}
+
+	st, err := status.New(code, message).WithDetails(&errdetails.BadRequest{
+		FieldViolations: v,
+	})
+
+	if err != nil {
+		return status.Errorf(code, "%v: %v", message, v[0].Description)
+	}
+
+	return st.Err()
+}
+
+/* a single bad field */
+func invalidArgument(field string, format string, args ...interface{}) error {
+
+	violations := fieldViolations{}
+	violations.add(field, format, args...)
+
+	return violations.err()
+}
+
+/* a paging field that points past the results */
+func outOfRange(field string, format string, args ...interface{}) error {
+
+	violations := fieldViolations{}
+	violations.add(field, format, args...)
+
+	return violations.errWithCode(codes.OutOfRange, "Request is out of range")
+}
+
+/* resourceType is the field name the caller used, such as playerId */
+func notFound(resourceType string, id interface{}) error {
+
+	message := fmt.Sprintf("That %v does not exist", resourceType)
+
+	st, err := status.New(codes.NotFound, message).WithDetails(&errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: fmt.Sprint(id),
+		Description:  message,
+	})
+
+	if err != nil {
+		return status.Error(codes.NotFound, message)
+	}
+
+	return st.Err()
+}
+
+/* converts any error from this package into one that is safe to return over gRPC, callers log the original */
+func StatusError(err error) error {
+
+	if err == nil {
+		return nil
+	}
+
+	var statusErr interface {
+		GRPCStatus() *status.Status
+	}
+
+	if errors.As(err, &statusErr) {
+		return statusErr.GRPCStatus().Err()
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "Deadline exceeded")
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "Request cancelled")
+	}
+
+	if isUnavailable(err) {
+		return status.Error(codes.Unavailable, "Database is unavailable")
+	}
+
+	return status.Error(codes.Internal, "Internal error")
+}
+
+/*
+ * as StatusError, but blames ctx when it has ended. postgres reports a cancelled
+ * statement as its own error rather than the context's
+ */
+func ContextStatusError(ctx context.Context, err error) error {
+
+	if err == nil {
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		return StatusError(ctx.Err())
+	}
+
+	return StatusError(err)
+}
+
+/* failures to reach postgres, rather than failures of the query */
+func isUnavailable(err error) bool {
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+
+	var netErr net.Error
+
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var pqErr *pq.Error
+
+	if errors.As(err, &pqErr) {
+		/* connection_exception and insufficient_resources */
+		switch pqErr.Code.Class() {
+		case "08", "53":
+			return true
+		}
+
+		/* the server is shutting down or starting up */
+		switch pqErr.Code.Name() {
+		case "admin_shutdown", "crash_shutdown", "cannot_connect_now":
+			return true
+		}
+	}
+
+	return false
+}
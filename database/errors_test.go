@@ -0,0 +1,22 @@
+package database
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+/* the fields an error's BadRequest details name, in order */
+func fieldViolationsOf(err error) []string {
+
+	fields := make([]string, 0)
+
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,606 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+/* the values of the gameeventtype enum */
+var gameEventTypes = map[string]bool{
+	"two-point-made":     true,
+	"two-point-missed":   true,
+	"three-point-made":   true,
+	"three-point-missed": true,
+	"free-throw-made":    true,
+	"free-throw-missed":  true,
+	"offensive-rebound":  true,
+	"defensive-rebound":  true,
+	"assist":             true,
+	"steal":              true,
+	"block":              true,
+	"turnover":           true,
+	"foul":               true,
+	"technical-foul":     true,
+	"foul-drawn":         true,
+}
+
+/*
+ * rewrites the counting stats of a player's line from their events in the game,
+ * JerseyNumber and MinutesPlayed aren't events so an existing line keeps them
+ */
+const deriveStatsFromEvents = `
+	INSERT INTO PlayerGameStats (
+		GameId,
+		PlayerId,
+		TeamId,
+		JerseyNumber,
+		TwoPointFGA,
+		TwoPointFGM,
+		ThreePointFGA,
+		ThreePointFGM,
+		FreeThrowsAttempted,
+		FreeThrowsMade,
+		OffensiveRebounds,
+		DefensiveRebounds,
+		Assists,
+		Blocks,
+		Steals,
+		Turnovers,
+		RegularFoulsForced,
+		RegularFoulsCommitted,
+		TechnicalFoulsCommitted)
+	SELECT
+		$1,
+		$2,
+		$3,
+		COALESCE((
+			SELECT
+				CompetitionRosters.JerseyNumber
+			FROM
+				CompetitionRosters
+			JOIN
+				Games ON Games.CompetitionId = CompetitionRosters.CompetitionId
+			WHERE
+				Games.GameId = $1 AND
+				CompetitionRosters.TeamId = $3 AND
+				CompetitionRosters.PlayerId = $2), 0),
+		COUNT(*) FILTER (WHERE EventType IN ('two-point-made', 'two-point-missed')),
+		COUNT(*) FILTER (WHERE EventType = 'two-point-made'),
+		COUNT(*) FILTER (WHERE EventType IN ('three-point-made', 'three-point-missed')),
+		COUNT(*) FILTER (WHERE EventType = 'three-point-made'),
+		COUNT(*) FILTER (WHERE EventType IN ('free-throw-made', 'free-throw-missed')),
+		COUNT(*) FILTER (WHERE EventType = 'free-throw-made'),
+		COUNT(*) FILTER (WHERE EventType = 'offensive-rebound'),
+		COUNT(*) FILTER (WHERE EventType = 'defensive-rebound'),
+		COUNT(*) FILTER (WHERE EventType = 'assist'),
+		COUNT(*) FILTER (WHERE EventType = 'block'),
+		COUNT(*) FILTER (WHERE EventType = 'steal'),
+		COUNT(*) FILTER (WHERE EventType = 'turnover'),
+		COUNT(*) FILTER (WHERE EventType = 'foul-drawn'),
+		COUNT(*) FILTER (WHERE EventType = 'foul'),
+		COUNT(*) FILTER (WHERE EventType = 'technical-foul')
+	FROM
+		GameEvents
+	WHERE
+		GameId = $1 AND
+		PlayerId = $2
+	ON CONFLICT ON CONSTRAINT player_game_unique DO UPDATE SET
+		TwoPointFGA = EXCLUDED.TwoPointFGA,
+		TwoPointFGM = EXCLUDED.TwoPointFGM,
+		ThreePointFGA = EXCLUDED.ThreePointFGA,
+		ThreePointFGM = EXCLUDED.ThreePointFGM,
+		FreeThrowsAttempted = EXCLUDED.FreeThrowsAttempted,
+		FreeThrowsMade = EXCLUDED.FreeThrowsMade,
+		OffensiveRebounds = EXCLUDED.OffensiveRebounds,
+		DefensiveRebounds = EXCLUDED.DefensiveRebounds,
+		Assists = EXCLUDED.Assists,
+		Blocks = EXCLUDED.Blocks,
+		Steals = EXCLUDED.Steals,
+		Turnovers = EXCLUDED.Turnovers,
+		RegularFoulsForced = EXCLUDED.RegularFoulsForced,
+		RegularFoulsCommitted = EXCLUDED.RegularFoulsCommitted,
+		TechnicalFoulsCommitted = EXCLUDED.TechnicalFoulsCommitted`
+
+func (database *HeroBallDatabase) RecordEvent(ctx context.Context, request *pb.RecordEventRequest) (*pb.RecordEventResponse, error) {
+
+	event := request.GetEvent()
+
+	violations := fieldViolations{}
+
+	if event == nil {
+		violations.add("Event", "Must supply an event")
+		return nil, violations.err()
+	}
+
+	if event.GetGameId() <= 0 {
+		violations.add("Event.GameId", "Must be greater than zero")
+	}
+
+	if event.GetPlayerId() <= 0 {
+		violations.add("Event.PlayerId", "Must be greater than zero")
+	}
+
+	if event.GetTeamId() <= 0 {
+		violations.add("Event.TeamId", "Must be greater than zero")
+	}
+
+	if !gameEventTypes[event.GetEventType()] {
+		violations.add("Event.EventType", "Unrecognised event type: %q", event.GetEventType())
+	}
+
+	if event.GetPeriod() < 1 {
+		violations.add("Event.Period", "Must be at least 1")
+	}
+
+	if event.GetClockSeconds() < 0 {
+		violations.add("Event.ClockSeconds", "Must not be negative")
+	}
+
+	shotZone := validateShotLocation(&violations, event)
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	/* the coordinates are NULL for a shot recorded by zone alone */
+	var shotX, shotY sql.NullFloat64
+
+	if event.GetShotX() != 0 || event.GetShotY() != 0 {
+		shotX = sql.NullFloat64{Float64: event.GetShotX(), Valid: true}
+		shotY = sql.NullFloat64{Float64: event.GetShotY(), Valid: true}
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	game, err := lockGameInTx(ctx, tx, event.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if event.GetTeamId() != game.homeTeamId && event.GetTeamId() != game.awayTeamId {
+		return nil, invalidArgument("Event.TeamId", "Team %v did not play in game %v", event.GetTeamId(), event.GetGameId())
+	}
+
+	if closedGameStatuses[game.status] {
+		violations.add("Event.GameId", "Game %v is %v", event.GetGameId(), game.status)
+		return nil, violations.errWithCode(codes.FailedPrecondition, "Game is not being played")
+	}
+
+	/* the first event starts the game */
+	if game.status == "scheduled" {
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE
+				Games
+			SET
+				Status = 'in-progress'
+			WHERE
+				GameId = $1`,
+			event.GetGameId())
+
+		if err != nil {
+			return nil, fmt.Errorf("Error starting game: %w", err)
+		}
+	}
+
+	var lineTeamId int32
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			TeamId
+		FROM
+			PlayerGameStats
+		WHERE
+			GameId = $1 AND
+			PlayerId = $2`,
+		event.GetGameId(),
+		event.GetPlayerId()).Scan(&lineTeamId)
+
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("Error getting player's stat line: %w", err)
+	}
+
+	if err == nil && lineTeamId != event.GetTeamId() {
+		return nil, invalidArgument("Event.TeamId", "Player %v played for team %v in game %v", event.GetPlayerId(), lineTeamId, event.GetGameId())
+	}
+
+	var eventId int32
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO GameEvents (
+			GameId,
+			PlayerId,
+			TeamId,
+			EventType,
+			Period,
+			ClockSeconds,
+			ShotX,
+			ShotY,
+			ShotZone)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, '')::shotzone)
+		RETURNING
+			EventId`,
+		event.GetGameId(),
+		event.GetPlayerId(),
+		event.GetTeamId(),
+		event.GetEventType(),
+		event.GetPeriod(),
+		event.GetClockSeconds(),
+		shotX,
+		shotY,
+		shotZone).Scan(&eventId)
+
+	if err != nil {
+		return nil, writeError("Error recording event", err)
+	}
+
+	err = enterGameTeamsInTx(ctx, tx, event.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerPlayerInTx(ctx, tx, event.GetGameId(), event.GetTeamId(), event.GetPlayerId(), 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, deriveStatsFromEvents, event.GetGameId(), event.GetPlayerId(), event.GetTeamId())
+
+	if err != nil {
+		return nil, writeError("Error deriving stats from events", err)
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing event", err)
+	}
+
+	log.Printf("Recorded %v event %v for player %v in game %v", event.GetEventType(), eventId, event.GetPlayerId(), event.GetGameId())
+
+	stats, err := database.getPlayerStatsForGame(ctx, event.GetPlayerId(), event.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RecordEventResponse{
+		EventId: eventId,
+		Stats:   stats,
+	}, nil
+}
+
+/* removes the event, a player left without events loses their stat line too */
+func (database *HeroBallDatabase) UndoEvent(ctx context.Context, request *pb.UndoEventRequest) (*pb.UndoEventResponse, error) {
+
+	if request.GetEventId() <= 0 {
+		return nil, invalidArgument("EventId", "Must be greater than zero")
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var gameId int32
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			GameId
+		FROM
+			GameEvents
+		WHERE
+			EventId = $1`,
+		request.GetEventId()).Scan(&gameId)
+
+	if err == sql.ErrNoRows {
+		return nil, notFound("eventId", request.GetEventId())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting event: %w", err)
+	}
+
+	_, err = lockGameInTx(ctx, tx, gameId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var playerId int32
+	var teamId int32
+
+	/* it may have been undone while we waited on the game */
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM
+			GameEvents
+		WHERE
+			EventId = $1
+		RETURNING
+			PlayerId,
+			TeamId`,
+		request.GetEventId()).Scan(&playerId, &teamId)
+
+	if err == sql.ErrNoRows {
+		return nil, notFound("eventId", request.GetEventId())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting event: %w", err)
+	}
+
+	var remaining int32
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*)
+		FROM
+			GameEvents
+		WHERE
+			GameId = $1 AND
+			PlayerId = $2`,
+		gameId,
+		playerId).Scan(&remaining)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error counting player's events: %w", err)
+	}
+
+	if remaining == 0 {
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM
+				PlayerGameStats
+			WHERE
+				GameId = $1 AND
+				PlayerId = $2`,
+			gameId,
+			playerId)
+	} else {
+		_, err = tx.ExecContext(ctx, deriveStatsFromEvents, gameId, playerId, teamId)
+	}
+
+	if err != nil {
+		return nil, writeError("Error deriving stats from events", err)
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing event", err)
+	}
+
+	log.Printf("Undid event %v for player %v in game %v", request.GetEventId(), playerId, gameId)
+
+	response := &pb.UndoEventResponse{
+		EventId: request.GetEventId(),
+	}
+
+	if remaining == 0 {
+		return response, nil
+	}
+
+	response.Stats, err = database.getPlayerStatsForGame(ctx, playerId, gameId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (database *HeroBallDatabase) ListEvents(ctx context.Context, request *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetGameId() <= 0 {
+		violations.add("GameId", "Must be greater than zero")
+	}
+
+	if request.GetOffset() < 0 {
+		violations.add("Offset", "Must not be negative")
+	}
+
+	if request.GetCount() < 0 {
+		violations.add("Count", "Must not be negative")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	var total int32
+
+	err := database.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(GameEvents.EventId)
+		FROM
+			Games
+		LEFT JOIN
+			GameEvents ON Games.GameId = GameEvents.GameId
+		WHERE
+			Games.GameId = $1
+		GROUP BY
+			Games.GameId`,
+		request.GetGameId()).Scan(&total)
+
+	if err == sql.ErrNoRows {
+		return nil, notFound("gameId", request.GetGameId())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting event count: %w", err)
+	}
+
+	if request.GetOffset() > total {
+		return nil, outOfRange("Offset", "Offset %v is past the end of the %v results", request.GetOffset(), total)
+	}
+
+	/* a NULL limit is no limit */
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			EventId,
+			GameId,
+			PlayerId,
+			TeamId,
+			EventType,
+			Period,
+			ClockSeconds,
+			RecordedAt,
+			COALESCE(ShotX, 0),
+			COALESCE(ShotY, 0),
+			COALESCE(ShotZone::text, '')
+		FROM
+			GameEvents
+		WHERE
+			GameId = $1
+		ORDER BY
+			Period ASC,
+			ClockSeconds DESC,
+			EventId ASC
+		LIMIT NULLIF($2, 0)
+		OFFSET $3`,
+		request.GetGameId(),
+		request.GetCount(),
+		request.GetOffset())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting events: %w", err)
+	}
+
+	defer rows.Close()
+
+	events := make([]*pb.GameEvent, 0)
+
+	for rows.Next() {
+
+		event := &pb.GameEvent{}
+		var recordedAt time.Time
+
+		err = rows.Scan(
+			&event.EventId,
+			&event.GameId,
+			&event.PlayerId,
+			&event.TeamId,
+			&event.EventType,
+			&event.Period,
+			&event.ClockSeconds,
+			&recordedAt,
+			&event.ShotX,
+			&event.ShotY,
+			&event.ShotZone)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning event: %w", err)
+		}
+
+		event.RecordedAt = recordedAt.Format(time.RFC3339)
+
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return &pb.ListEventsResponse{
+		Events:     events,
+		NextOffset: request.GetOffset() + int32(len(events)),
+		Total:      total,
+	}, nil
+}
+
+/* serialises writers to the game's stats, so lines derived from events never miss one */
+func lockGameInTx(ctx context.Context, tx *sql.Tx, gameId int32) (gameRow, error) {
+
+	game := gameRow{}
+
+	err := tx.QueryRowContext(ctx, `
+		SELECT
+			CompetitionId,
+			HomeTeamId,
+			AwayTeamId,
+			Status
+		FROM
+			Games
+		WHERE
+			GameId = $1
+		FOR UPDATE`,
+		gameId).Scan(&game.competitionId, &game.homeTeamId, &game.awayTeamId, &game.status)
+
+	if err == sql.ErrNoRows {
+		return game, notFound("gameId", gameId)
+	}
+
+	if err != nil {
+		return game, fmt.Errorf("Error getting game: %w", err)
+	}
+
+	return game, nil
+}
+
+/* the given players whose lines in the game are derived from events */
+func getPlayersWithEventsInTx(ctx context.Context, tx *sql.Tx, gameId int32, playerIds []int32) (map[int32]bool, error) {
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			DISTINCT PlayerId
+		FROM
+			GameEvents
+		WHERE
+			GameId = $1 AND
+			PlayerId = ANY($2)`,
+		gameId,
+		pq.Array(playerIds))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting players with events: %w", err)
+	}
+
+	defer rows.Close()
+
+	players := make(map[int32]bool)
+
+	for rows.Next() {
+
+		var playerId int32
+
+		err = rows.Scan(&playerId)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning player with events: %w", err)
+		}
+
+		players[playerId] = true
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return players, nil
+}
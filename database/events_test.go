@@ -0,0 +1,336 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	pb "github.com/mlv9/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeEvent struct {
+	eventId   int64
+	playerId  int64
+	teamId    int64
+	eventType string
+}
+
+/*
+ * game 1 between teams 10 and 20, keeping its events and stat lines. Lines are
+ * derived by reading the columns and event types out of deriveStatsFromEvents
+ * itself, so a column counting the wrong events shows up here
+ */
+type fakeEventStore struct {
+	columns     []string
+	filters     [][]string
+	events      []fakeEvent
+	nextEventId int64
+	/* by PlayerId, each line by column */
+	lines map[int64]map[string]int64
+	teams map[int64]int64
+}
+
+var eventFilterPattern = regexp.MustCompile(`COUNT\(\*\) FILTER \(WHERE EventType (?:= '([a-z-]+)'|IN \(([^)]*)\))\)`)
+
+func newFakeEventStore(t testing.TB) *fakeEventStore {
+
+	query := strings.Join(strings.Fields(deriveStatsFromEvents), " ")
+
+	insert := strings.TrimPrefix(query, "INSERT INTO PlayerGameStats (")
+	columns := strings.Split(insert[:strings.Index(insert, ")")], ", ")
+
+	filters := make([][]string, 0)
+
+	for _, match := range eventFilterPattern.FindAllStringSubmatch(query, -1) {
+
+		if match[1] != "" {
+			filters = append(filters, []string{match[1]})
+			continue
+		}
+
+		eventTypes := make([]string, 0)
+
+		for _, eventType := range strings.Split(match[2], ", ") {
+			eventTypes = append(eventTypes, strings.Trim(eventType, "'"))
+		}
+
+		filters = append(filters, eventTypes)
+	}
+
+	/* GameId, PlayerId, TeamId and JerseyNumber come before the counts */
+	if len(columns) != len(filters)+4 {
+		t.Fatalf("Got %v columns for %v counts in %q", len(columns), len(filters), query)
+	}
+
+	return &fakeEventStore{
+		columns: columns[4:],
+		filters: filters,
+		lines:   make(map[int64]map[string]int64),
+		teams:   make(map[int64]int64),
+	}
+}
+
+func (store *fakeEventStore) derive(playerId int64, teamId int64) {
+
+	line := make(map[string]int64)
+
+	for i, column := range store.columns {
+		for _, event := range store.events {
+			for _, eventType := range store.filters[i] {
+				if event.playerId == playerId && event.eventType == eventType {
+					line[column]++
+				}
+			}
+		}
+	}
+
+	store.lines[playerId] = line
+	store.teams[playerId] = teamId
+}
+
+func (store *fakeEventStore) handle(query string, args []driver.NamedValue) ([][]driver.Value, error) {
+
+	switch {
+	case query == strings.Join(strings.Fields(deriveStatsFromEvents), " "):
+		store.derive(args[1].Value.(int64), args[2].Value.(int64))
+		return nil, nil
+
+	case strings.HasPrefix(query, "SELECT CompetitionId, HomeTeamId, AwayTeamId, Status FROM Games"):
+		return [][]driver.Value{{int64(1), int64(10), int64(20), "in-progress"}}, nil
+
+	case strings.HasPrefix(query, "SELECT TeamId FROM PlayerGameStats"):
+
+		if teamId, found := store.teams[args[1].Value.(int64)]; found {
+			return [][]driver.Value{{teamId}}, nil
+		}
+
+		return nil, nil
+
+	case strings.HasPrefix(query, "INSERT INTO GameEvents"):
+
+		store.nextEventId++
+		store.events = append(store.events, fakeEvent{
+			eventId:   store.nextEventId,
+			playerId:  args[1].Value.(int64),
+			teamId:    args[2].Value.(int64),
+			eventType: args[3].Value.(string),
+		})
+
+		return [][]driver.Value{{store.nextEventId}}, nil
+
+	case strings.HasPrefix(query, "INSERT INTO CompetitionTeams"), strings.HasPrefix(query, "INSERT INTO CompetitionRosters"):
+		return nil, nil
+
+	case strings.HasPrefix(query, "SELECT GameId FROM GameEvents"):
+
+		for _, event := range store.events {
+			if event.eventId == args[0].Value.(int64) {
+				return [][]driver.Value{{int64(1)}}, nil
+			}
+		}
+
+		return nil, nil
+
+	case strings.HasPrefix(query, "DELETE FROM GameEvents"):
+
+		for i, event := range store.events {
+			if event.eventId == args[0].Value.(int64) {
+				store.events = append(store.events[:i:i], store.events[i+1:]...)
+				return [][]driver.Value{{event.playerId, event.teamId}}, nil
+			}
+		}
+
+		return nil, nil
+
+	case strings.HasPrefix(query, "SELECT COUNT(*) FROM GameEvents"):
+
+		remaining := int64(0)
+
+		for _, event := range store.events {
+			if event.playerId == args[1].Value.(int64) {
+				remaining++
+			}
+		}
+
+		return [][]driver.Value{{remaining}}, nil
+
+	case strings.HasPrefix(query, "DELETE FROM PlayerGameStats"):
+		delete(store.lines, args[1].Value.(int64))
+		delete(store.teams, args[1].Value.(int64))
+		return nil, nil
+
+	/* the line as getPlayerStatsForGame reads it back */
+	case strings.HasPrefix(query, "SELECT PlayerGameStats.StatsId"):
+
+		playerId := args[0].Value.(int64)
+		line, found := store.lines[playerId]
+
+		if !found {
+			return nil, nil
+		}
+
+		selected := strings.TrimPrefix(query[:strings.Index(query, " FROM ")], "SELECT ")
+		row := make([]driver.Value, 0)
+
+		for _, column := range strings.Split(selected, ", ") {
+			switch column {
+			case "PlayerGameStats.StatsId", "Players.PlayerId":
+				row = append(row, playerId)
+			case "PlayerGameStats.GameId":
+				row = append(row, int64(1))
+			case "Teams.TeamId":
+				row = append(row, store.teams[playerId])
+			case "Teams.Name", "Players.Name", "Players.Position":
+				row = append(row, "")
+			default:
+				row = append(row, line[strings.TrimPrefix(column, "PlayerGameStats.")])
+			}
+		}
+
+		return [][]driver.Value{row}, nil
+	}
+
+	return nil, fmt.Errorf("Unexpected query %q", query)
+}
+
+/* the counting stats events make, in deriveStatsFromEvents order */
+func eventCounts(stats *pb.Stats) [15]int32 {
+	return [15]int32{
+		stats.GetTwoPointFGA(),
+		stats.GetTwoPointFGM(),
+		stats.GetThreePointFGA(),
+		stats.GetThreePointFGM(),
+		stats.GetFreeThrowsAttempted(),
+		stats.GetFreeThrowsMade(),
+		stats.GetOffensiveRebounds(),
+		stats.GetDefensiveRebounds(),
+		stats.GetAssists(),
+		stats.GetBlocks(),
+		stats.GetSteals(),
+		stats.GetTurnovers(),
+		stats.GetRegularFoulsForced(),
+		stats.GetRegularFoulsCommitted(),
+		stats.GetTechnicalFoulsCommitted(),
+	}
+}
+
+func TestRecordAndUndoEvents(t *testing.T) {
+
+	store := newFakeEventStore(t)
+	database, _ := newFakeDatabase(t, store.handle)
+
+	record := func(playerId int32, teamId int32, eventType string) (*pb.RecordEventResponse, error) {
+		return database.RecordEvent(context.Background(), &pb.RecordEventRequest{
+			Event: &pb.GameEvent{GameId: 1, PlayerId: playerId, TeamId: teamId, EventType: eventType, Period: 1, ClockSeconds: 300},
+		})
+	}
+
+	eventTypes := []string{
+		"two-point-made",
+		"two-point-missed",
+		"three-point-made",
+		"free-throw-made",
+		"free-throw-missed",
+		"offensive-rebound",
+		"defensive-rebound",
+		"assist",
+		"steal",
+		"block",
+		"turnover",
+		"foul",
+		"technical-foul",
+		"foul-drawn",
+		"two-point-made",
+	}
+
+	var response *pb.RecordEventResponse
+	var err error
+
+	for _, eventType := range eventTypes {
+
+		response, err = record(7, 10, eventType)
+
+		if err != nil {
+			t.Fatalf("Error recording %v: %v", eventType, err)
+		}
+	}
+
+	/* a player on the other team keeps their own line */
+	other, err := record(8, 20, "steal")
+
+	if err != nil {
+		t.Fatalf("Error recording steal: %v", err)
+	}
+
+	want := [15]int32{3, 2, 1, 1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+
+	if counts := eventCounts(response.GetStats().GetStats()); counts != want {
+		t.Errorf("Got %v, want %v", counts, want)
+	}
+
+	if counts := eventCounts(other.GetStats().GetStats()); counts != [15]int32{10: 1} {
+		t.Errorf("Got %v for the other player, want a steal", counts)
+	}
+
+	_, err = record(7, 20, "assist")
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Got %v recording for another team, want %v", err, codes.InvalidArgument)
+	}
+
+	/* undoing the last made two and the three takes them back off */
+	undone, err := database.UndoEvent(context.Background(), &pb.UndoEventRequest{EventId: int32(len(eventTypes))})
+
+	if err != nil {
+		t.Fatalf("Error undoing event: %v", err)
+	}
+
+	undone, err = database.UndoEvent(context.Background(), &pb.UndoEventRequest{EventId: 3})
+
+	if err != nil {
+		t.Fatalf("Error undoing event: %v", err)
+	}
+
+	want = [15]int32{2, 1, 0, 0, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+
+	if counts := eventCounts(undone.GetStats().GetStats()); counts != want {
+		t.Errorf("Got %v after undoing, want %v", counts, want)
+	}
+
+	_, err = database.UndoEvent(context.Background(), &pb.UndoEventRequest{EventId: 3})
+
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Got %v undoing twice, want %v", err, codes.NotFound)
+	}
+
+	/* undoing the player's last event takes their line with it */
+	for eventId := int32(1); eventId < int32(len(eventTypes)); eventId++ {
+
+		if eventId == 3 {
+			continue
+		}
+
+		undone, err = database.UndoEvent(context.Background(), &pb.UndoEventRequest{EventId: eventId})
+
+		if err != nil {
+			t.Fatalf("Error undoing event %v: %v", eventId, err)
+		}
+	}
+
+	if undone.GetStats() != nil {
+		t.Errorf("Got stats %v once every event is undone", undone.GetStats())
+	}
+
+	if _, found := store.lines[7]; found {
+		t.Errorf("Player still has a line once every event is undone")
+	}
+
+	if store.lines[8]["Steals"] != 1 {
+		t.Errorf("Got %v for the other player after undoing, want a steal", store.lines[8])
+	}
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+/* answers a query with its rows, the query has its whitespace collapsed */
+type fakeQueryHandler func(query string, args []driver.NamedValue) ([][]driver.Value, error)
+
+/* stands in for postgres, counting every statement sent to it */
+type fakeConnector struct {
+	handle     fakeQueryHandler
+	statements int
+}
+
+type fakeDriver struct{}
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+type fakeTx struct{}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	next int
+}
+
+func newFakeDatabase(t testing.TB, handle fakeQueryHandler) (*HeroBallDatabase, *fakeConnector) {
+
+	connector := &fakeConnector{
+		handle: handle,
+	}
+
+	db := sql.OpenDB(connector)
+	t.Cleanup(func() { db.Close() })
+
+	return &HeroBallDatabase{db: db}, connector
+}
+
+func (connector *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: connector}, nil
+}
+
+func (connector *fakeConnector) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, fmt.Errorf("Open a fake database with newFakeDatabase")
+}
+
+func (conn *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("Prepared statements are not faked")
+}
+
+func (conn *fakeConn) Close() error {
+	return nil
+}
+
+func (conn *fakeConn) Begin() (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+func (conn *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return fakeTx{}, nil
+}
+
+func (conn *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+
+	conn.connector.statements++
+
+	rows, err := conn.connector.handle(strings.Join(strings.Fields(query), " "), args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &fakeRows{rows: rows}, nil
+}
+
+func (conn *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+
+	rows, err := conn.QueryContext(ctx, query, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return driver.RowsAffected(len(rows.(*fakeRows).rows)), nil
+}
+
+func (fakeTx) Commit() error {
+	return nil
+}
+
+func (fakeTx) Rollback() error {
+	return nil
+}
+
+/* only the count matters, Scan checks it against the destinations */
+func (rows *fakeRows) Columns() []string {
+
+	if len(rows.rows) == 0 {
+		return nil
+	}
+
+	columns := make([]string, len(rows.rows[0]))
+
+	for i := range columns {
+		columns[i] = fmt.Sprintf("column%v", i)
+	}
+
+	return columns
+}
+
+func (rows *fakeRows) Close() error {
+	return nil
+}
+
+func (rows *fakeRows) Next(dest []driver.Value) error {
+
+	if rows.next >= len(rows.rows) {
+		return io.EOF
+	}
+
+	copy(dest, rows.rows[rows.next])
+	rows.next++
+
+	return nil
+}
+
+/* pq.Array arguments arrive as their postgres text form */
+func fakeIntArray(t testing.TB, arg driver.NamedValue) []int64 {
+
+	values := pq.Int64Array{}
+
+	err := values.Scan(arg.Value)
+
+	if err != nil {
+		t.Fatalf("Argument %v is not an int array: %v", arg.Ordinal, err)
+	}
+
+	return values
+}
+
+func fakeStringArray(t testing.TB, arg driver.NamedValue) []string {
+
+	values := pq.StringArray{}
+
+	err := values.Scan(arg.Value)
+
+	if err != nil {
+		t.Fatalf("Argument %v is not a string array: %v", arg.Ordinal, err)
+	}
+
+	return values
+}
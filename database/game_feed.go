@@ -0,0 +1,296 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	/* the payload is the GameId whose Games or PlayerGameStats rows changed */
+	gameChangedChannel = "heroball_game_changed"
+)
+
+/*
+ * fans game change notifications out to streams watching that game. Each
+ * subscriber has a one slot channel that is only ever sent to without blocking,
+ * so a change arriving while a slow stream is still busy folds into the one
+ * already pending and neither the listener nor the writers wait on it
+ */
+type gameFeed struct {
+	listener *pq.Listener
+	done     chan struct{}
+
+	mutex       sync.Mutex
+	subscribers map[int32]map[chan struct{}]bool
+}
+
+func newGameFeed() *gameFeed {
+	return &gameFeed{
+		subscribers: make(map[int32]map[chan struct{}]bool),
+	}
+}
+
+func (feed *gameFeed) start(connStr string) error {
+
+	feed.listener = pq.NewListener(connStr, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Game feed listener event %v: %v", event, err)
+		}
+	})
+
+	err := feed.listener.Listen(gameChangedChannel)
+
+	if err != nil {
+		feed.listener.Close()
+		feed.listener = nil
+		return fmt.Errorf("Error listening for game changes: %w", err)
+	}
+
+	feed.done = make(chan struct{})
+
+	go feed.run()
+
+	return nil
+}
+
+func (feed *gameFeed) run() {
+
+	defer close(feed.done)
+
+	ping := time.NewTicker(listenerPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case notification, ok := <-feed.listener.Notify:
+
+			if !ok {
+				return
+			}
+
+			/* we reconnected and may have missed changes to any game */
+			if notification == nil {
+				feed.notifyAll()
+				continue
+			}
+
+			gameId, err := strconv.ParseInt(notification.Extra, 10, 32)
+
+			if err != nil {
+				log.Printf("Ignoring game change with payload %q", notification.Extra)
+				continue
+			}
+
+			feed.notify(int32(gameId))
+
+		case <-ping.C:
+			go feed.listener.Ping()
+		}
+	}
+}
+
+func (feed *gameFeed) stop() error {
+
+	if feed.listener == nil {
+		return nil
+	}
+
+	err := feed.listener.Close()
+
+	<-feed.done
+
+	return err
+}
+
+/* the returned channel receives when the game may have changed, call unsubscribe when done */
+func (feed *gameFeed) subscribe(gameId int32) (<-chan struct{}, func()) {
+
+	changes := make(chan struct{}, 1)
+
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	if feed.subscribers[gameId] == nil {
+		feed.subscribers[gameId] = make(map[chan struct{}]bool)
+	}
+
+	feed.subscribers[gameId][changes] = true
+
+	unsubscribe := func() {
+
+		feed.mutex.Lock()
+		defer feed.mutex.Unlock()
+
+		delete(feed.subscribers[gameId], changes)
+
+		if len(feed.subscribers[gameId]) == 0 {
+			delete(feed.subscribers, gameId)
+		}
+	}
+
+	return changes, unsubscribe
+}
+
+func (feed *gameFeed) notify(gameId int32) {
+
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	for changes := range feed.subscribers[gameId] {
+		signal(changes)
+	}
+}
+
+func (feed *gameFeed) notifyAll() {
+
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	for _, subscribers := range feed.subscribers {
+		for changes := range subscribers {
+			signal(changes)
+		}
+	}
+}
+
+/* never blocks, a signal already pending covers this one */
+func signal(changes chan struct{}) {
+	select {
+	case changes <- struct{}{}:
+	default:
+	}
+}
+
+/* the game as the stream last sent it */
+type gameSnapshot struct {
+	game *pb.Game
+	/* in query order, and by StatsId */
+	lines []*pb.PlayerGameStats
+	stats map[int32]*pb.PlayerGameStats
+}
+
+func (database *HeroBallDatabase) getGameSnapshot(ctx context.Context, gameId int32) (*gameSnapshot, error) {
+
+	game, err := database.getGameById(ctx, gameId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := database.getPlayerGameStatsByConditionAndOffsetAndCount(ctx, "PlayerGameStats.GameId = $1", []interface{}{gameId}, 0, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting game stats: %w", err)
+	}
+
+	snapshot := &gameSnapshot{
+		game:  game,
+		lines: stats,
+		stats: make(map[int32]*pb.PlayerGameStats),
+	}
+
+	for _, stat := range stats {
+		snapshot.stats[stat.StatsId] = stat
+	}
+
+	return snapshot, nil
+}
+
+/* what changed between previous and current, nil when nothing did */
+func diffGameSnapshots(previous *gameSnapshot, current *gameSnapshot) *pb.GameUpdate {
+
+	update := &pb.GameUpdate{
+		Game:        current.game,
+		PlayerStats: make([]*pb.PlayerGameStats, 0),
+	}
+
+	for _, stat := range current.lines {
+		if !proto.Equal(stat, previous.stats[stat.StatsId]) {
+			update.PlayerStats = append(update.PlayerStats, stat)
+		}
+	}
+
+	for _, stat := range previous.lines {
+		if _, exists := current.stats[stat.StatsId]; !exists {
+			update.RemovedStatsIds = append(update.RemovedStatsIds, stat.StatsId)
+		}
+	}
+
+	if len(update.PlayerStats) == 0 && len(update.RemovedStatsIds) == 0 && proto.Equal(previous.game, current.game) {
+		return nil
+	}
+
+	return update
+}
+
+/* sends the game as it stands, then each change to it until ctx ends or the game is deleted */
+func (database *HeroBallDatabase) StreamGame(ctx context.Context, gameId int32, send func(*pb.GameUpdate) error) error {
+
+	if gameId <= 0 {
+		return invalidArgument("GameId", "Must be greater than zero")
+	}
+
+	/* subscribe before reading so nothing between the two is missed */
+	changes, unsubscribe := database.games.subscribe(gameId)
+	defer unsubscribe()
+
+	previous, err := database.getGameSnapshot(ctx, gameId)
+
+	if err != nil {
+		return err
+	}
+
+	err = send(&pb.GameUpdate{
+		Game:        previous.game,
+		PlayerStats: previous.lines,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changes:
+		}
+
+		current, err := database.getGameSnapshot(ctx, gameId)
+
+		if status.Code(err) == codes.NotFound {
+			return send(&pb.GameUpdate{
+				Deleted: true,
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		update := diffGameSnapshots(previous, current)
+
+		if update == nil {
+			continue
+		}
+
+		err = send(update)
+
+		if err != nil {
+			return err
+		}
+
+		previous = current
+	}
+}
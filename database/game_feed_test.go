@@ -0,0 +1,126 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+func newTestSnapshot(homePoints int32, lines ...*pb.PlayerGameStats) *gameSnapshot {
+
+	snapshot := &gameSnapshot{
+		game: &pb.Game{
+			GameId: 1,
+			Status: "in-progress",
+			Result: &pb.GameResult{HomeTeamPoints: homePoints},
+		},
+		lines: lines,
+		stats: make(map[int32]*pb.PlayerGameStats),
+	}
+
+	for _, line := range lines {
+		snapshot.stats[line.StatsId] = line
+	}
+
+	return snapshot
+}
+
+func newTestLine(statsId int32, points int32) *pb.PlayerGameStats {
+	return &pb.PlayerGameStats{
+		StatsId: statsId,
+		GameId:  1,
+		Stats:   &pb.Stats{TwoPointFGM: points},
+	}
+}
+
+func TestDiffGameSnapshots(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		previous *gameSnapshot
+		current  *gameSnapshot
+		/* nil when no update is expected */
+		changed []int32
+		removed []int32
+	}{
+		{
+			name:     "unchanged",
+			previous: newTestSnapshot(4, newTestLine(1, 1), newTestLine(2, 1)),
+			current:  newTestSnapshot(4, newTestLine(1, 1), newTestLine(2, 1)),
+		},
+		{
+			name:     "added",
+			previous: newTestSnapshot(2, newTestLine(1, 1)),
+			current:  newTestSnapshot(4, newTestLine(1, 1), newTestLine(2, 1)),
+			changed:  []int32{2},
+		},
+		{
+			name:     "changed",
+			previous: newTestSnapshot(4, newTestLine(1, 1), newTestLine(2, 1)),
+			current:  newTestSnapshot(6, newTestLine(1, 1), newTestLine(2, 2)),
+			changed:  []int32{2},
+		},
+		{
+			name:     "removed",
+			previous: newTestSnapshot(4, newTestLine(1, 1), newTestLine(2, 1)),
+			current:  newTestSnapshot(2, newTestLine(1, 1)),
+			changed:  []int32{},
+			removed:  []int32{2},
+		},
+		{
+			name:     "added, changed and removed",
+			previous: newTestSnapshot(4, newTestLine(1, 1), newTestLine(2, 1)),
+			current:  newTestSnapshot(6, newTestLine(1, 2), newTestLine(3, 1)),
+			changed:  []int32{1, 3},
+			removed:  []int32{2},
+		},
+		{
+			/* a status change with no stat lines still goes out */
+			name:     "game only",
+			previous: newTestSnapshot(4, newTestLine(1, 2)),
+			current: func() *gameSnapshot {
+				snapshot := newTestSnapshot(4, newTestLine(1, 2))
+				snapshot.game.Status = "final"
+				return snapshot
+			}(),
+			changed: []int32{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			update := diffGameSnapshots(test.previous, test.current)
+
+			if test.changed == nil {
+				if update != nil {
+					t.Fatalf("Got update %v for an unchanged game", update)
+				}
+				return
+			}
+
+			if update == nil {
+				t.Fatalf("Got no update")
+			}
+
+			changed := make([]int32, 0)
+
+			for _, line := range update.GetPlayerStats() {
+				changed = append(changed, line.GetStatsId())
+			}
+
+			if !reflect.DeepEqual(changed, test.changed) {
+				t.Errorf("Got changed lines %v, want %v", changed, test.changed)
+			}
+
+			if len(update.GetRemovedStatsIds()) != len(test.removed) || (len(test.removed) > 0 && !reflect.DeepEqual(update.GetRemovedStatsIds(), test.removed)) {
+				t.Errorf("Got removed lines %v, want %v", update.GetRemovedStatsIds(), test.removed)
+			}
+
+			if update.GetGame() != test.current.game {
+				t.Errorf("Update does not carry the current game")
+			}
+		})
+	}
+}
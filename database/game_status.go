@@ -0,0 +1,66 @@
+package database
+
+import "fmt"
+
+/* the values of the gamestatus enum */
+var gameStatuses = map[string]bool{
+	"scheduled":   true,
+	"in-progress": true,
+	"final":       true,
+	"postponed":   true,
+	"cancelled":   true,
+	"forfeit":     true,
+}
+
+/* only games that are over count towards stats and standings */
+const countedGamesCondition = "Games.Status IN ('final', 'forfeit')"
+
+/*
+ * the points the team in a column of Games scored, worked out live the way
+ * GameScoresView has them: ForfeitScore() to nil for a forfeit, otherwise the
+ * team's lines summed. Results read from here and from the view can't disagree
+ */
+const teamPointsTemplate = `
+	CASE Games.Status
+		WHEN 'forfeit' THEN CASE WHEN Games.ForfeitTeamId = %[1]v THEN 0 ELSE ForfeitScore() END
+		ELSE (
+			SELECT
+				TotalPoints(SUM(TeamLines.ThreePointFGM), SUM(TeamLines.TwoPointFGM), SUM(TeamLines.FreeThrowsMade))
+			FROM
+				PlayerGameStats TeamLines
+			WHERE
+				TeamLines.GameId = Games.GameId AND
+				TeamLines.TeamId = %[1]v)
+	END`
+
+func teamPoints(teamIdColumn string) string {
+	return fmt.Sprintf(teamPointsTemplate, teamIdColumn)
+}
+
+/* statuses a game can't have events recorded in */
+var closedGameStatuses = map[string]bool{
+	"postponed": true,
+	"cancelled": true,
+	"forfeit":   true,
+}
+
+func validateGameStatus(violations *fieldViolations, status string, forfeitTeamId int32, homeTeamId int32, awayTeamId int32) {
+
+	if !gameStatuses[status] {
+		violations.add("Status", "Unrecognised status: %q", status)
+		return
+	}
+
+	if status != "forfeit" {
+
+		if forfeitTeamId != 0 {
+			violations.add("ForfeitTeamId", "Only a forfeit has a forfeiting team")
+		}
+
+		return
+	}
+
+	if forfeitTeamId != homeTeamId && forfeitTeamId != awayTeamId {
+		violations.add("ForfeitTeamId", "Must be the home or away team")
+	}
+}
@@ -0,0 +1,341 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+/* players listed in a head to head's TopPerformers */
+const headToHeadTopPerformerCount = 5
+
+/* how two teams or two players have done against each other */
+func (database *HeroBallDatabase) GetHeadToHead(ctx context.Context, request *pb.GetHeadToHeadRequest) (*pb.HeadToHead, error) {
+
+	violations := fieldViolations{}
+
+	byTeams := request.GetTeamId() != 0 || request.GetOpponentTeamId() != 0
+	byPlayers := request.GetPlayerId() != 0 || request.GetOpponentPlayerId() != 0
+
+	switch {
+	case byTeams && byPlayers:
+		violations.add("PlayerId", "Must give either two teams or two players, not both")
+	case byTeams:
+		validateHeadToHeadSides(&violations, "TeamId", request.GetTeamId(), "OpponentTeamId", request.GetOpponentTeamId())
+	case byPlayers:
+		validateHeadToHeadSides(&violations, "PlayerId", request.GetPlayerId(), "OpponentPlayerId", request.GetOpponentPlayerId())
+	default:
+		violations.add("TeamId", "Must give either two teams or two players")
+	}
+
+	if request.GetOffset() < 0 {
+		violations.add("Offset", "Must be zero or greater")
+	}
+
+	if request.GetCount() < 0 {
+		violations.add("Count", "Must be zero or greater")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	phaseCondition, err := getPhaseCondition(request.GetPhase())
+
+	if err != nil {
+		return nil, err
+	}
+
+	ordering := request.GetOrdering()
+
+	if ordering == "" {
+		ordering = "PPG"
+	}
+
+	topPerformerOrdering, err := getStatsOrdering(ordering)
+
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &pb.GamesFilter{
+		CompetitionIds: request.GetCompetitionIds(),
+		Phase:          request.GetPhase(),
+	}
+
+	if byTeams {
+		filter.TeamIds = []int32{request.GetTeamId()}
+		filter.OpponentTeamIds = []int32{request.GetOpponentTeamId()}
+	} else {
+		filter.PlayerIds = []int32{request.GetPlayerId()}
+		filter.OpponentPlayerIds = []int32{request.GetOpponentPlayerId()}
+	}
+
+	headToHead := &pb.HeadToHead{}
+
+	/* check both sides exist, so no meetings means they haven't met */
+	if byTeams {
+
+		teams, err := database.getTeamsById(ctx, []int32{request.GetTeamId(), request.GetOpponentTeamId()})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, teamId := range []int32{request.GetTeamId(), request.GetOpponentTeamId()} {
+			if findTeam(teams, teamId) == nil {
+				return nil, notFound("teamId", teamId)
+			}
+		}
+
+		headToHead.TeamStats = &pb.TeamAggregateStats{
+			Team: findTeam(teams, request.GetTeamId()),
+		}
+
+	} else {
+
+		players, err := database.getPlayersById(ctx, []int32{request.GetPlayerId(), request.GetOpponentPlayerId()})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, playerId := range []int32{request.GetPlayerId(), request.GetOpponentPlayerId()} {
+			if findPlayer(players, playerId) == nil {
+				return nil, notFound("playerId", playerId)
+			}
+		}
+
+		headToHead.PlayerStats = &pb.PlayerAggregateStats{
+			Player: findPlayer(players, request.GetPlayerId()),
+		}
+
+		headToHead.OpponentPlayerStats = &pb.PlayerAggregateStats{
+			Player: findPlayer(players, request.GetOpponentPlayerId()),
+		}
+	}
+
+	count := request.GetCount()
+
+	if count == 0 {
+		count = recentGameCount
+	}
+
+	headToHead.Meetings, err = database.GetGamesCursor(ctx, request.GetOffset(), count, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	/* the meetings that are over, with the team the first side played for */
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			DISTINCT
+			Games.GameId,
+			COALESCE(Sides.TeamId, $1)
+		FROM
+			Games
+		LEFT JOIN
+			PlayerGameStats Sides ON Sides.GameId = Games.GameId AND Sides.PlayerId = $3
+		LEFT JOIN
+			PlayerGameStats Opponents ON Opponents.GameId = Games.GameId AND Opponents.PlayerId = $4
+		WHERE
+			(cardinality($5::int[]) IS NULL OR Games.CompetitionId = ANY($5)) AND
+			(($1 <> 0 AND ((Games.HomeTeamId = $1 AND Games.AwayTeamId = $2) OR (Games.HomeTeamId = $2 AND Games.AwayTeamId = $1))) OR
+			($3 <> 0 AND Sides.TeamId IS NOT NULL AND Opponents.TeamId IS NOT NULL AND Sides.TeamId <> Opponents.TeamId)) AND
+			`+countedGamesCondition+phaseCondition,
+		request.GetTeamId(),
+		request.GetOpponentTeamId(),
+		request.GetPlayerId(),
+		request.GetOpponentPlayerId(),
+		pq.Array(request.GetCompetitionIds()))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting meetings: %w", err)
+	}
+
+	defer rows.Close()
+
+	gameIds := make([]int32, 0)
+	sides := make(map[int32]int32)
+
+	for rows.Next() {
+
+		var gameId int32
+		var teamId int32
+
+		err = rows.Scan(&gameId, &teamId)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning meeting: %w", err)
+		}
+
+		gameIds = append(gameIds, gameId)
+		sides[gameId] = teamId
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	if len(gameIds) == 0 {
+		return headToHead, nil
+	}
+
+	games, err := database.getGamesById(ctx, gameIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var margin int32
+
+	for _, game := range games {
+
+		points, against := game.GetResult().GetHomeTeamPoints(), game.GetResult().GetAwayTeamPoints()
+
+		if sides[game.GetGameId()] == game.GetAwayTeam().GetTeamId() {
+			points, against = against, points
+		}
+
+		switch {
+		case points > against:
+			headToHead.Won++
+		case points == against:
+			headToHead.Drawn++
+		default:
+			headToHead.Lost++
+		}
+
+		margin += points - against
+	}
+
+	headToHead.AverageMargin = ratio(float64(margin), float64(len(games)))
+
+	if byTeams {
+
+		teamStats, teamIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
+			`PlayerGameStats.GameId = ANY($1)`,
+			[]interface{}{pq.Array(gameIds)},
+			"GROUP BY PlayerGameStats.TeamId",
+			"PlayerGameStats.TeamId",
+			"",
+			nil,
+			"",
+			2,
+			0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, teamId := range teamIds {
+			if teamId == request.GetTeamId() {
+				headToHead.TeamStats.Stats = teamStats[i]
+			} else {
+				headToHead.TeamStats.OpponentStats = teamStats[i]
+			}
+		}
+
+	} else {
+
+		playerStats, playerIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
+			`PlayerGameStats.GameId = ANY($1) AND PlayerGameStats.PlayerId = ANY($2)`,
+			[]interface{}{pq.Array(gameIds), pq.Array([]int32{request.GetPlayerId(), request.GetOpponentPlayerId()})},
+			"GROUP BY PlayerGameStats.PlayerId",
+			"PlayerGameStats.PlayerId",
+			"",
+			nil,
+			"",
+			2,
+			0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, playerId := range playerIds {
+			if playerId == request.GetPlayerId() {
+				headToHead.PlayerStats.Stats = playerStats[i]
+			} else {
+				headToHead.OpponentPlayerStats.Stats = playerStats[i]
+			}
+		}
+	}
+
+	topStats, topPlayerIds, err := database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
+		`PlayerGameStats.GameId = ANY($1)`,
+		[]interface{}{pq.Array(gameIds)},
+		"GROUP BY PlayerGameStats.PlayerId",
+		"PlayerGameStats.PlayerId",
+		"",
+		nil,
+		topPerformerOrdering,
+		headToHeadTopPerformerCount,
+		0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	headToHead.TopPerformers = make([]*pb.PlayerAggregateStats, 0)
+
+	if len(topPlayerIds) == 0 {
+		return headToHead, nil
+	}
+
+	topPlayers, err := database.getPlayersById(ctx, topPlayerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, playerId := range topPlayerIds {
+		headToHead.TopPerformers = append(headToHead.TopPerformers, &pb.PlayerAggregateStats{
+			Player: findPlayer(topPlayers, playerId),
+			Stats:  topStats[i],
+		})
+	}
+
+	return headToHead, nil
+}
+
+func validateHeadToHeadSides(violations *fieldViolations, field string, id int32, opponentField string, opponentId int32) {
+
+	if id <= 0 {
+		violations.add(field, "Must be greater than zero")
+	}
+
+	if opponentId <= 0 {
+		violations.add(opponentField, "Must be greater than zero")
+	} else if opponentId == id {
+		violations.add(opponentField, "Must be different to %v", field)
+	}
+}
+
+func findTeam(teams []*pb.Team, teamId int32) *pb.Team {
+
+	for _, team := range teams {
+		if team.GetTeamId() == teamId {
+			return team
+		}
+	}
+
+	return nil
+}
+
+func findPlayer(players []*pb.Player, playerId int32) *pb.Player {
+
+	for _, player := range players {
+		if player.GetPlayerId() == playerId {
+			return player
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,785 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	lineupSize              = 5
+	defaultLineupStatsCount = 10
+	/* a free throw is worth less than a possession, as and-ones and second shots don't end one */
+	possessionFreeThrowWeight = 0.44
+)
+
+var eventPoints = map[string]int32{
+	"three-point-made": 3,
+	"two-point-made":   2,
+	"free-throw-made":  1,
+}
+
+/* what each event adds to the estimate of its team's possessions */
+var eventPossessions = map[string]float64{
+	"two-point-made":     1,
+	"two-point-missed":   1,
+	"three-point-made":   1,
+	"three-point-missed": 1,
+	"free-throw-made":    possessionFreeThrowWeight,
+	"free-throw-missed":  possessionFreeThrowWeight,
+	"offensive-rebound":  -1,
+	"turnover":           1,
+}
+
+/* a GameSubstitutions row, 0 for a player that isn't there */
+type substitution struct {
+	substitutionId int32
+	gameId         int32
+	playerInId     int32
+	playerOutId    int32
+	period         int32
+	clockSeconds   int32
+	recordedAt     time.Time
+}
+
+/* a GameEvents row that scores or counts towards possessions */
+type lineupEvent struct {
+	gameId       int32
+	teamId       int32
+	eventType    string
+	period       int32
+	clockSeconds int32
+	recordedAt   time.Time
+}
+
+type lineupTotals struct {
+	playerIds           []int32
+	games               map[int32]bool
+	pointsFor           int32
+	pointsAgainst       int32
+	possessions         float64
+	opponentPossessions float64
+}
+
+type plusMinusTotals struct {
+	plusMinus int32
+	games     map[int32]bool
+}
+
+/* plays the team's substitutions in order, failing on one that can't happen, and returns who is left on the court */
+func replayLineup(substitutions []substitution) ([]int32, error) {
+
+	onCourt := make(map[int32]bool)
+
+	for _, sub := range substitutions {
+
+		if sub.playerOutId != 0 {
+
+			if !onCourt[sub.playerOutId] {
+				return nil, fmt.Errorf("Player %v is not on the court in period %v with %v seconds left", sub.playerOutId, sub.period, sub.clockSeconds)
+			}
+
+			delete(onCourt, sub.playerOutId)
+		}
+
+		if sub.playerInId != 0 {
+
+			if onCourt[sub.playerInId] {
+				return nil, fmt.Errorf("Player %v is already on the court in period %v with %v seconds left", sub.playerInId, sub.period, sub.clockSeconds)
+			}
+
+			onCourt[sub.playerInId] = true
+
+			if len(onCourt) > lineupSize {
+				return nil, fmt.Errorf("More than %v players on the court in period %v with %v seconds left", lineupSize, sub.period, sub.clockSeconds)
+			}
+		}
+	}
+
+	return sortedPlayerIds(onCourt), nil
+}
+
+func sortedPlayerIds(players map[int32]bool) []int32 {
+
+	playerIds := make([]int32, 0, len(players))
+
+	for playerId := range players {
+		playerIds = append(playerIds, playerId)
+	}
+
+	sort.Slice(playerIds, func(i, j int) bool { return playerIds[i] < playerIds[j] })
+
+	return playerIds
+}
+
+/*
+ * follows one game for the team, crediting every event to whoever was on the
+ * court at the time. Substitutions and events are taken in game clock order,
+ * and in the order they were recorded at the same clock, so a substitution
+ * made before free throws were recorded is on the court for them. Lineups are
+ * only tracked with a full five on the court
+ */
+func accumulateLineups(teamId int32, gameId int32, substitutions []substitution, events []lineupEvent, lineups map[string]*lineupTotals, plusMinus map[int32]*plusMinusTotals) {
+
+	type timelineEntry struct {
+		period       int32
+		clockSeconds int32
+		recordedAt   time.Time
+		sub          *substitution
+		event        *lineupEvent
+	}
+
+	timeline := make([]timelineEntry, 0, len(substitutions)+len(events))
+
+	for i := range substitutions {
+		sub := &substitutions[i]
+		timeline = append(timeline, timelineEntry{period: sub.period, clockSeconds: sub.clockSeconds, recordedAt: sub.recordedAt, sub: sub})
+	}
+
+	for i := range events {
+		event := &events[i]
+		timeline = append(timeline, timelineEntry{period: event.period, clockSeconds: event.clockSeconds, recordedAt: event.recordedAt, event: event})
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		switch {
+		case timeline[i].period != timeline[j].period:
+			return timeline[i].period < timeline[j].period
+		case timeline[i].clockSeconds != timeline[j].clockSeconds:
+			return timeline[i].clockSeconds > timeline[j].clockSeconds
+		}
+		return timeline[i].recordedAt.Before(timeline[j].recordedAt)
+	})
+
+	onCourt := make(map[int32]bool)
+
+	for _, entry := range timeline {
+
+		if entry.sub != nil {
+
+			/* undoing a substitution can leave later ones out of step, so go with what was recorded */
+			delete(onCourt, entry.sub.playerOutId)
+
+			if entry.sub.playerInId != 0 {
+				onCourt[entry.sub.playerInId] = true
+			}
+
+			continue
+		}
+
+		points := eventPoints[entry.event.eventType]
+		possessions := eventPossessions[entry.event.eventType]
+		scored := entry.event.teamId == teamId
+
+		if !scored {
+			points = -points
+		}
+
+		for playerId := range onCourt {
+
+			totals, found := plusMinus[playerId]
+
+			if !found {
+				totals = &plusMinusTotals{games: make(map[int32]bool)}
+				plusMinus[playerId] = totals
+			}
+
+			totals.plusMinus += points
+			totals.games[gameId] = true
+		}
+
+		if len(onCourt) != lineupSize {
+			continue
+		}
+
+		playerIds := sortedPlayerIds(onCourt)
+		key := fmt.Sprint(playerIds)
+
+		totals, found := lineups[key]
+
+		if !found {
+			totals = &lineupTotals{playerIds: playerIds, games: make(map[int32]bool)}
+			lineups[key] = totals
+		}
+
+		totals.games[gameId] = true
+
+		if scored {
+			totals.pointsFor += points
+			totals.possessions += possessions
+		} else {
+			totals.pointsAgainst -= points
+			totals.opponentPossessions += possessions
+		}
+	}
+}
+
+/* ties go to the lineup that has played more, then to the lowest player ids for a stable order */
+func sortLineupStats(lineups []*pb.LineupStats) {
+	sort.Slice(lineups, func(i, j int) bool {
+
+		first, second := lineups[i], lineups[j]
+
+		switch {
+		case first.NetRating != second.NetRating:
+			return first.NetRating > second.NetRating
+		case first.Possessions != second.Possessions:
+			return first.Possessions > second.Possessions
+		}
+
+		/* both sets of players are in PlayerId order */
+		for k := 0; k < len(first.Players) && k < len(second.Players); k++ {
+			if first.Players[k].GetPlayerId() != second.Players[k].GetPlayerId() {
+				return first.Players[k].GetPlayerId() < second.Players[k].GetPlayerId()
+			}
+		}
+
+		return len(first.Players) < len(second.Players)
+	})
+}
+
+func (database *HeroBallDatabase) RecordSubstitution(ctx context.Context, request *pb.RecordSubstitutionRequest) (*pb.RecordSubstitutionResponse, error) {
+
+	sub := request.GetSubstitution()
+
+	violations := fieldViolations{}
+
+	if sub == nil {
+		violations.add("Substitution", "Must supply a substitution")
+		return nil, violations.err()
+	}
+
+	if sub.GetGameId() <= 0 {
+		violations.add("Substitution.GameId", "Must be greater than zero")
+	}
+
+	if sub.GetTeamId() <= 0 {
+		violations.add("Substitution.TeamId", "Must be greater than zero")
+	}
+
+	if sub.GetPlayerInId() < 0 {
+		violations.add("Substitution.PlayerInId", "Must not be negative")
+	}
+
+	if sub.GetPlayerOutId() < 0 {
+		violations.add("Substitution.PlayerOutId", "Must not be negative")
+	}
+
+	if sub.GetPlayerInId() == 0 && sub.GetPlayerOutId() == 0 {
+		violations.add("Substitution.PlayerInId", "Must give a player coming on, going off or both")
+	} else if sub.GetPlayerInId() == sub.GetPlayerOutId() {
+		violations.add("Substitution.PlayerOutId", "Must be a different player to PlayerInId")
+	}
+
+	if sub.GetPeriod() < 1 {
+		violations.add("Substitution.Period", "Must be at least 1")
+	}
+
+	if sub.GetClockSeconds() < 0 {
+		violations.add("Substitution.ClockSeconds", "Must not be negative")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	game, err := lockGameInTx(ctx, tx, sub.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.GetTeamId() != game.homeTeamId && sub.GetTeamId() != game.awayTeamId {
+		return nil, invalidArgument("Substitution.TeamId", "Team %v did not play in game %v", sub.GetTeamId(), sub.GetGameId())
+	}
+
+	if closedGameStatuses[game.status] {
+		violations.add("Substitution.GameId", "Game %v is %v", sub.GetGameId(), game.status)
+		return nil, violations.errWithCode(codes.FailedPrecondition, "Game is not being played")
+	}
+
+	var otherTeamPlayerId int32
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			PlayerId
+		FROM
+			PlayerGameStats
+		WHERE
+			GameId = $1 AND
+			PlayerId IN ($2, $3) AND
+			TeamId <> $4
+		LIMIT 1`,
+		sub.GetGameId(),
+		sub.GetPlayerInId(),
+		sub.GetPlayerOutId(),
+		sub.GetTeamId()).Scan(&otherTeamPlayerId)
+
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("Error checking players' stat lines: %w", err)
+	}
+
+	if err == nil {
+		return nil, invalidArgument("Substitution.TeamId", "Player %v played for the other team in game %v", otherTeamPlayerId, sub.GetGameId())
+	}
+
+	var substitutionId int32
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO GameSubstitutions (
+			GameId,
+			TeamId,
+			PlayerInId,
+			PlayerOutId,
+			Period,
+			ClockSeconds)
+		VALUES
+			($1, $2, NULLIF($3, 0), NULLIF($4, 0), $5, $6)
+		RETURNING
+			SubstitutionId`,
+		sub.GetGameId(),
+		sub.GetTeamId(),
+		sub.GetPlayerInId(),
+		sub.GetPlayerOutId(),
+		sub.GetPeriod(),
+		sub.GetClockSeconds()).Scan(&substitutionId)
+
+	if err != nil {
+		return nil, writeError("Error recording substitution", err)
+	}
+
+	substitutions, err := getTeamSubstitutionsInTx(ctx, tx, sub.GetGameId(), sub.GetTeamId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	onCourt, err := replayLineup(substitutions)
+
+	if err != nil {
+		violations.add("Substitution", "%v", err)
+		return nil, violations.errWithCode(codes.FailedPrecondition, "Substitution does not fit the lineup")
+	}
+
+	if sub.GetPlayerInId() != 0 {
+
+		err = enterGameTeamsInTx(ctx, tx, sub.GetGameId())
+
+		if err != nil {
+			return nil, err
+		}
+
+		err = registerPlayerInTx(ctx, tx, sub.GetGameId(), sub.GetTeamId(), sub.GetPlayerInId(), 0)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing substitution", err)
+	}
+
+	log.Printf("Recorded substitution %v for team %v in game %v", substitutionId, sub.GetTeamId(), sub.GetGameId())
+
+	return &pb.RecordSubstitutionResponse{
+		SubstitutionId: substitutionId,
+		OnCourt:        onCourt,
+	}, nil
+}
+
+/* removes the substitution, unless the ones after it depend on it */
+func (database *HeroBallDatabase) UndoSubstitution(ctx context.Context, request *pb.UndoSubstitutionRequest) (*pb.UndoSubstitutionResponse, error) {
+
+	if request.GetSubstitutionId() <= 0 {
+		return nil, invalidArgument("SubstitutionId", "Must be greater than zero")
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var gameId int32
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			GameId
+		FROM
+			GameSubstitutions
+		WHERE
+			SubstitutionId = $1`,
+		request.GetSubstitutionId()).Scan(&gameId)
+
+	if err == sql.ErrNoRows {
+		return nil, notFound("substitutionId", request.GetSubstitutionId())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting substitution: %w", err)
+	}
+
+	_, err = lockGameInTx(ctx, tx, gameId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var teamId int32
+
+	/* it may have been undone while we waited on the game */
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM
+			GameSubstitutions
+		WHERE
+			SubstitutionId = $1
+		RETURNING
+			TeamId`,
+		request.GetSubstitutionId()).Scan(&teamId)
+
+	if err == sql.ErrNoRows {
+		return nil, notFound("substitutionId", request.GetSubstitutionId())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting substitution: %w", err)
+	}
+
+	substitutions, err := getTeamSubstitutionsInTx(ctx, tx, gameId, teamId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = replayLineup(substitutions)
+
+	if err != nil {
+		violations := fieldViolations{}
+		violations.add("SubstitutionId", "Later substitutions depend on it: %v", err)
+		return nil, violations.errWithCode(codes.FailedPrecondition, "Substitution is needed by later ones")
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing substitution", err)
+	}
+
+	log.Printf("Undid substitution %v for team %v in game %v", request.GetSubstitutionId(), teamId, gameId)
+
+	return &pb.UndoSubstitutionResponse{
+		SubstitutionId: request.GetSubstitutionId(),
+	}, nil
+}
+
+func getTeamSubstitutionsInTx(ctx context.Context, tx *sql.Tx, gameId int32, teamId int32) ([]substitution, error) {
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			SubstitutionId,
+			GameId,
+			COALESCE(PlayerInId, 0),
+			COALESCE(PlayerOutId, 0),
+			Period,
+			ClockSeconds,
+			RecordedAt
+		FROM
+			GameSubstitutions
+		WHERE
+			GameId = $1 AND
+			TeamId = $2
+		ORDER BY
+			Period ASC,
+			ClockSeconds DESC,
+			RecordedAt ASC,
+			SubstitutionId ASC`,
+		gameId,
+		teamId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting substitutions: %w", err)
+	}
+
+	return scanSubstitutions(rows)
+}
+
+func scanSubstitutions(rows *sql.Rows) ([]substitution, error) {
+
+	defer rows.Close()
+
+	substitutions := make([]substitution, 0)
+
+	for rows.Next() {
+
+		var sub substitution
+
+		err := rows.Scan(
+			&sub.substitutionId,
+			&sub.gameId,
+			&sub.playerInId,
+			&sub.playerOutId,
+			&sub.period,
+			&sub.clockSeconds,
+			&sub.recordedAt)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning substitution: %w", err)
+		}
+
+		substitutions = append(substitutions, sub)
+	}
+
+	err := rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return substitutions, nil
+}
+
+/* a team's lineups ranked by net rating, and its players' plus/minus */
+func (database *HeroBallDatabase) GetLineupStats(ctx context.Context, request *pb.GetLineupStatsRequest) (*pb.GetLineupStatsResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetTeamId() <= 0 {
+		violations.add("TeamId", "Must be greater than zero")
+	}
+
+	if request.GetCompetitionId() < 0 {
+		violations.add("CompetitionId", "Must be zero or greater")
+	}
+
+	if request.GetGameId() < 0 {
+		violations.add("GameId", "Must be zero or greater")
+	}
+
+	if request.GetMinimumPossessions() < 0 {
+		violations.add("MinimumPossessions", "Must not be negative")
+	}
+
+	if request.GetCount() < 0 {
+		violations.add("Count", "Must not be negative")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	_, err := database.getTeamById(ctx, request.GetTeamId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	count := request.GetCount()
+
+	if count == 0 {
+		count = defaultLineupStatsCount
+	}
+
+	/* a single game counts even while it's being played */
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			GameSubstitutions.SubstitutionId,
+			GameSubstitutions.GameId,
+			COALESCE(GameSubstitutions.PlayerInId, 0),
+			COALESCE(GameSubstitutions.PlayerOutId, 0),
+			GameSubstitutions.Period,
+			GameSubstitutions.ClockSeconds,
+			GameSubstitutions.RecordedAt
+		FROM
+			GameSubstitutions
+		JOIN
+			Games ON GameSubstitutions.GameId = Games.GameId
+		WHERE
+			GameSubstitutions.TeamId = $1 AND
+			($2 = 0 OR Games.CompetitionId = $2) AND
+			(($3 = 0 AND `+countedGamesCondition+`) OR Games.GameId = $3)
+		ORDER BY
+			GameSubstitutions.GameId ASC`,
+		request.GetTeamId(),
+		request.GetCompetitionId(),
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting substitutions: %w", err)
+	}
+
+	substitutions, err := scanSubstitutions(rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	substitutionsByGame := make(map[int32][]substitution)
+	gameIds := make([]int32, 0)
+
+	for _, sub := range substitutions {
+
+		if _, found := substitutionsByGame[sub.gameId]; !found {
+			gameIds = append(gameIds, sub.gameId)
+		}
+
+		substitutionsByGame[sub.gameId] = append(substitutionsByGame[sub.gameId], sub)
+	}
+
+	eventTypes := make([]string, 0)
+
+	for eventType := range eventPossessions {
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	rows, err = database.db.QueryContext(ctx, `
+		SELECT
+			GameId,
+			TeamId,
+			EventType,
+			Period,
+			ClockSeconds,
+			RecordedAt
+		FROM
+			GameEvents
+		WHERE
+			GameId = ANY($1) AND
+			EventType::text = ANY($2)`,
+		pq.Array(gameIds),
+		pq.Array(eventTypes))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting game events: %w", err)
+	}
+
+	defer rows.Close()
+
+	eventsByGame := make(map[int32][]lineupEvent)
+
+	for rows.Next() {
+
+		var event lineupEvent
+
+		err = rows.Scan(&event.gameId, &event.teamId, &event.eventType, &event.period, &event.clockSeconds, &event.recordedAt)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning game event: %w", err)
+		}
+
+		eventsByGame[event.gameId] = append(eventsByGame[event.gameId], event)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	lineups := make(map[string]*lineupTotals)
+	plusMinus := make(map[int32]*plusMinusTotals)
+
+	for _, gameId := range gameIds {
+		accumulateLineups(request.GetTeamId(), gameId, substitutionsByGame[gameId], eventsByGame[gameId], lineups, plusMinus)
+	}
+
+	response := &pb.GetLineupStatsResponse{
+		Lineups:   make([]*pb.LineupStats, 0),
+		PlusMinus: make([]*pb.PlayerPlusMinus, 0),
+	}
+
+	for _, totals := range lineups {
+
+		if totals.possessions < request.GetMinimumPossessions() {
+			continue
+		}
+
+		stats := &pb.LineupStats{
+			Players:             make([]*pb.Player, 0, lineupSize),
+			GameCount:           int32(len(totals.games)),
+			PointsFor:           totals.pointsFor,
+			PointsAgainst:       totals.pointsAgainst,
+			Possessions:         totals.possessions,
+			OpponentPossessions: totals.opponentPossessions,
+			OffensiveRating:     100 * ratio(float64(totals.pointsFor), totals.possessions),
+			DefensiveRating:     100 * ratio(float64(totals.pointsAgainst), totals.opponentPossessions),
+		}
+
+		stats.NetRating = stats.OffensiveRating - stats.DefensiveRating
+
+		for _, playerId := range totals.playerIds {
+			stats.Players = append(stats.Players, &pb.Player{PlayerId: playerId})
+		}
+
+		response.Lineups = append(response.Lineups, stats)
+	}
+
+	sortLineupStats(response.Lineups)
+
+	if len(response.Lineups) > int(count) {
+		response.Lineups = response.Lineups[:count]
+	}
+
+	for playerId, totals := range plusMinus {
+		response.PlusMinus = append(response.PlusMinus, &pb.PlayerPlusMinus{
+			Player:    &pb.Player{PlayerId: playerId},
+			PlusMinus: totals.plusMinus,
+			GameCount: int32(len(totals.games)),
+		})
+	}
+
+	sort.Slice(response.PlusMinus, func(i, j int) bool {
+		if response.PlusMinus[i].PlusMinus != response.PlusMinus[j].PlusMinus {
+			return response.PlusMinus[i].PlusMinus > response.PlusMinus[j].PlusMinus
+		}
+		return response.PlusMinus[i].Player.PlayerId < response.PlusMinus[j].Player.PlayerId
+	})
+
+	/* fill in the players' names */
+	playerIds := make([]int32, 0)
+
+	for _, entry := range response.PlusMinus {
+		playerIds = append(playerIds, entry.Player.PlayerId)
+	}
+
+	if len(playerIds) == 0 {
+		return response, nil
+	}
+
+	players, err := database.getPlayersById(ctx, playerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range response.PlusMinus {
+		entry.Player = findPlayer(players, entry.Player.PlayerId)
+	}
+
+	for _, lineup := range response.Lineups {
+		for i, player := range lineup.Players {
+			lineup.Players[i] = findPlayer(players, player.PlayerId)
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,263 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/mlv9/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var lineupTestStart = time.Date(2021, 9, 4, 10, 0, 0, 0, time.UTC)
+
+/* players 1 to 5 start the first period */
+func startingFive() []substitution {
+
+	subs := make([]substitution, 0)
+
+	for playerId := int32(1); playerId <= lineupSize; playerId++ {
+		subs = append(subs, substitution{
+			substitutionId: playerId,
+			gameId:         1,
+			playerInId:     playerId,
+			period:         1,
+			clockSeconds:   600,
+			recordedAt:     lineupTestStart,
+		})
+	}
+
+	return subs
+}
+
+func TestReplayLineup(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		subs    []substitution
+		onCourt []int32
+		err     string
+	}{
+		{
+			name:    "starting five",
+			subs:    startingFive(),
+			onCourt: []int32{1, 2, 3, 4, 5},
+		},
+		{
+			name:    "six in for five",
+			subs:    append(startingFive(), substitution{playerInId: 6, playerOutId: 5, period: 1, clockSeconds: 300}),
+			onCourt: []int32{1, 2, 3, 4, 6},
+		},
+		{
+			name: "off without being on",
+			subs: append(startingFive(), substitution{playerInId: 7, playerOutId: 6, period: 1, clockSeconds: 300}),
+			err:  "Player 6 is not on the court",
+		},
+		{
+			name: "on twice",
+			subs: append(startingFive(), substitution{playerInId: 3, period: 1, clockSeconds: 300}),
+			err:  "Player 3 is already on the court",
+		},
+		{
+			name: "six on the court",
+			subs: append(startingFive(), substitution{playerInId: 6, period: 1, clockSeconds: 300}),
+			err:  "More than 5 players on the court",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			onCourt, err := replayLineup(test.subs)
+
+			if test.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), test.err) {
+					t.Fatalf("Got error %v, want %q", err, test.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Error replaying lineup: %v", err)
+			}
+
+			if !reflect.DeepEqual(onCourt, test.onCourt) {
+				t.Errorf("Got %v, want %v", onCourt, test.onCourt)
+			}
+		})
+	}
+}
+
+/* serves the substitutions of team 10 in game 1 to UndoSubstitution, deleting as asked */
+func fakeSubstitutionsHandler(subs []substitution) fakeQueryHandler {
+	return func(query string, args []driver.NamedValue) ([][]driver.Value, error) {
+
+		switch {
+		case strings.HasPrefix(query, "SELECT GameId FROM GameSubstitutions"):
+			return [][]driver.Value{{int64(1)}}, nil
+
+		case strings.HasPrefix(query, "SELECT CompetitionId, HomeTeamId, AwayTeamId, Status FROM Games"):
+			return [][]driver.Value{{int64(1), int64(10), int64(20), "in-progress"}}, nil
+
+		case strings.HasPrefix(query, "DELETE FROM GameSubstitutions"):
+
+			for i, sub := range subs {
+				if int64(sub.substitutionId) == args[0].Value.(int64) {
+					subs = append(subs[:i:i], subs[i+1:]...)
+					return [][]driver.Value{{int64(10)}}, nil
+				}
+			}
+
+			return nil, nil
+
+		case strings.HasPrefix(query, "SELECT SubstitutionId, GameId"):
+
+			rows := make([][]driver.Value, 0)
+
+			for _, sub := range subs {
+				rows = append(rows, []driver.Value{
+					int64(sub.substitutionId),
+					int64(sub.gameId),
+					int64(sub.playerInId),
+					int64(sub.playerOutId),
+					int64(sub.period),
+					int64(sub.clockSeconds),
+					sub.recordedAt,
+				})
+			}
+
+			return rows, nil
+		}
+
+		return nil, fmt.Errorf("Unexpected query %q", query)
+	}
+}
+
+func TestUndoSubstitution(t *testing.T) {
+
+	/* 6 comes on for 5, then 7 comes on for 6 */
+	subs := append(startingFive(),
+		substitution{substitutionId: 6, gameId: 1, playerInId: 6, playerOutId: 5, period: 1, clockSeconds: 300, recordedAt: lineupTestStart},
+		substitution{substitutionId: 7, gameId: 1, playerInId: 7, playerOutId: 6, period: 1, clockSeconds: 200, recordedAt: lineupTestStart},
+	)
+
+	tests := []struct {
+		name           string
+		substitutionId int32
+		code           codes.Code
+	}{
+		{"the latest", 7, codes.OK},
+		{"one a later substitution depends on", 6, codes.FailedPrecondition},
+		{"not there", 8, codes.NotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			database, _ := newFakeDatabase(t, fakeSubstitutionsHandler(subs))
+
+			_, err := database.UndoSubstitution(context.Background(), &pb.UndoSubstitutionRequest{SubstitutionId: test.substitutionId})
+
+			if status.Code(err) != test.code {
+				t.Fatalf("Got %v, want %v", err, test.code)
+			}
+
+			if test.code == codes.FailedPrecondition {
+				if violations := fieldViolationsOf(err); len(violations) != 1 || violations[0] != "SubstitutionId" {
+					t.Errorf("Got error %v, want a violation on SubstitutionId", err)
+				}
+			}
+		})
+	}
+}
+
+/*
+ * A basket, a substitution and another basket are all at 5:00 in the first
+ * period. Only the order they were recorded in says who was on for each, so
+ * 5 gets the first basket and 6 the second
+ */
+func TestAccumulateLineupsSameClock(t *testing.T) {
+
+	subs := append(startingFive(), substitution{substitutionId: 6, gameId: 1, playerInId: 6, playerOutId: 5, period: 1, clockSeconds: 300, recordedAt: lineupTestStart.Add(2 * time.Minute)})
+
+	events := []lineupEvent{
+		{gameId: 1, teamId: 10, eventType: "two-point-made", period: 1, clockSeconds: 300, recordedAt: lineupTestStart.Add(1 * time.Minute)},
+		{gameId: 1, teamId: 10, eventType: "three-point-made", period: 1, clockSeconds: 300, recordedAt: lineupTestStart.Add(3 * time.Minute)},
+		{gameId: 1, teamId: 20, eventType: "free-throw-made", period: 1, clockSeconds: 300, recordedAt: lineupTestStart.Add(4 * time.Minute)},
+	}
+
+	lineups := make(map[string]*lineupTotals)
+	plusMinus := make(map[int32]*plusMinusTotals)
+
+	accumulateLineups(10, 1, subs, events, lineups, plusMinus)
+
+	tests := []struct {
+		playerId  int32
+		plusMinus int32
+	}{
+		{1, 2 + 3 - 1},
+		{5, 2},
+		{6, 3 - 1},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("player %v", test.playerId), func(t *testing.T) {
+
+			totals, found := plusMinus[test.playerId]
+
+			if !found || totals.plusMinus != test.plusMinus {
+				t.Errorf("Got %+v, want plus/minus %v", totals, test.plusMinus)
+			}
+		})
+	}
+
+	starting := lineups[fmt.Sprint([]int32{1, 2, 3, 4, 5})]
+	after := lineups[fmt.Sprint([]int32{1, 2, 3, 4, 6})]
+
+	if len(lineups) != 2 || starting == nil || after == nil {
+		t.Fatalf("Got lineups %v", lineups)
+	}
+
+	if starting.pointsFor != 2 || starting.pointsAgainst != 0 || after.pointsFor != 3 || after.pointsAgainst != 1 {
+		t.Errorf("Got starting lineup %+v and the one after %+v", starting, after)
+	}
+}
+
+func lineupTestStats(netRating float64, possessions float64, playerIds ...int32) *pb.LineupStats {
+
+	lineup := &pb.LineupStats{NetRating: netRating, Possessions: possessions}
+
+	for _, playerId := range playerIds {
+		lineup.Players = append(lineup.Players, &pb.Player{PlayerId: playerId})
+	}
+
+	return lineup
+}
+
+func TestSortLineupStats(t *testing.T) {
+
+	/* 10 sorts before 2 as text, the ids must be compared as numbers */
+	lineups := []*pb.LineupStats{
+		lineupTestStats(5, 20, 1, 10, 11, 12, 13),
+		lineupTestStats(5, 20, 1, 2, 11, 12, 13),
+		lineupTestStats(5, 30, 3, 4, 5, 6, 7),
+		lineupTestStats(8, 10, 20, 21, 22, 23, 24),
+	}
+
+	sortLineupStats(lineups)
+
+	order := make([]int32, 0)
+
+	for _, lineup := range lineups {
+		order = append(order, lineup.GetPlayers()[1].GetPlayerId())
+	}
+
+	if want := []int32{21, 4, 2, 10}; !reflect.DeepEqual(order, want) {
+		t.Errorf("Got lineups with second players %v, want %v", order, want)
+	}
+}
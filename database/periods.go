@@ -0,0 +1,362 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+/* points added to each side at one point in the game, a made shot or a whole period */
+type scoringPlay struct {
+	period     int32
+	homePoints int32
+	awayPoints int32
+}
+
+/* the game's scoring by period and its flow, from its events if it has any, otherwise the stored period scores */
+func (database *HeroBallDatabase) getGamePeriods(ctx context.Context, game *pb.Game) ([]*pb.PeriodScore, *pb.GameFlow, error) {
+
+	plays, err := database.getEventScoringPlays(ctx, game)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fromEvents := len(plays) > 0
+
+	if !fromEvents {
+
+		plays, err = database.getStoredPeriodScores(ctx, game.GameId)
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(plays) == 0 {
+		return nil, nil, nil
+	}
+
+	periods, flow := summarisePlays(plays, game.GetCompetition().GetPeriodCount())
+	flow.FromEvents = fromEvents
+
+	return periods, flow, nil
+}
+
+/* every event as a play in the order they happened, most are worth nothing */
+func (database *HeroBallDatabase) getEventScoringPlays(ctx context.Context, game *pb.Game) ([]scoringPlay, error) {
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			Period,
+			TeamId,
+			CASE EventType
+				WHEN 'three-point-made' THEN 3
+				WHEN 'two-point-made' THEN 2
+				WHEN 'free-throw-made' THEN 1
+				ELSE 0
+			END
+		FROM
+			GameEvents
+		WHERE
+			GameId = $1
+		ORDER BY
+			Period ASC,
+			ClockSeconds DESC,
+			EventId ASC`,
+		game.GameId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting game events: %w", err)
+	}
+
+	defer rows.Close()
+
+	plays := make([]scoringPlay, 0)
+
+	for rows.Next() {
+
+		var play scoringPlay
+		var teamId int32
+		var points int32
+
+		err = rows.Scan(&play.period, &teamId, &points)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning game event: %w", err)
+		}
+
+		if teamId == game.GetHomeTeam().GetTeamId() {
+			play.homePoints = points
+		} else {
+			play.awayPoints = points
+		}
+
+		plays = append(plays, play)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return plays, nil
+}
+
+func (database *HeroBallDatabase) getStoredPeriodScores(ctx context.Context, gameId int32) ([]scoringPlay, error) {
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			Period,
+			HomeTeamPoints,
+			AwayTeamPoints
+		FROM
+			GamePeriodScores
+		WHERE
+			GameId = $1
+		ORDER BY
+			Period ASC`,
+		gameId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting period scores: %w", err)
+	}
+
+	defer rows.Close()
+
+	plays := make([]scoringPlay, 0)
+
+	for rows.Next() {
+
+		var play scoringPlay
+
+		err = rows.Scan(&play.period, &play.homePoints, &play.awayPoints)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning period score: %w", err)
+		}
+
+		plays = append(plays, play)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return plays, nil
+}
+
+/*
+ * totals the plays into periods 1 through the last one played and follows the
+ * running score. A lead change is one team going ahead when the other led
+ * last, ties in between don't count as a lead
+ */
+func summarisePlays(plays []scoringPlay, periodCount int32) ([]*pb.PeriodScore, *pb.GameFlow) {
+
+	periods := make([]*pb.PeriodScore, 0)
+	flow := &pb.GameFlow{}
+
+	var homeScore int32
+	var awayScore int32
+	var leader int32 /* 1 home, -1 away, 0 nobody yet */
+
+	for _, play := range plays {
+
+		for int32(len(periods)) < play.period {
+			periods = append(periods, &pb.PeriodScore{
+				Period:   int32(len(periods)) + 1,
+				Overtime: int32(len(periods)) >= periodCount,
+			})
+		}
+
+		period := periods[play.period-1]
+		period.HomeTeamPoints += play.homePoints
+		period.AwayTeamPoints += play.awayPoints
+
+		homeScore += play.homePoints
+		awayScore += play.awayPoints
+
+		switch {
+		case homeScore > awayScore:
+
+			if leader == -1 {
+				flow.LeadChanges++
+			}
+
+			leader = 1
+
+			if homeScore-awayScore > flow.HomeTeamLargestLead {
+				flow.HomeTeamLargestLead = homeScore - awayScore
+			}
+
+		case awayScore > homeScore:
+
+			if leader == 1 {
+				flow.LeadChanges++
+			}
+
+			leader = -1
+
+			if awayScore-homeScore > flow.AwayTeamLargestLead {
+				flow.AwayTeamLargestLead = awayScore - homeScore
+			}
+		}
+	}
+
+	return periods, flow
+}
+
+func (database *HeroBallDatabase) UpdateGamePeriodScores(ctx context.Context, request *pb.UpdateGamePeriodScoresRequest) (*pb.UpdateGamePeriodScoresResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetGameId() <= 0 {
+		violations.add("GameId", "Must be greater than zero")
+	}
+
+	for i, period := range request.GetPeriodScores() {
+
+		if period.GetPeriod() != int32(i)+1 {
+			violations.add(fmt.Sprintf("PeriodScores[%v].Period", i), "Must be %v, periods run in order from 1", i+1)
+		}
+
+		if period.GetHomeTeamPoints() < 0 {
+			violations.add(fmt.Sprintf("PeriodScores[%v].HomeTeamPoints", i), "Must not be negative")
+		}
+
+		if period.GetAwayTeamPoints() < 0 {
+			violations.add(fmt.Sprintf("PeriodScores[%v].AwayTeamPoints", i), "Must not be negative")
+		}
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	_, err = lockGameInTx(ctx, tx, request.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var hasEvents bool
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT
+			EXISTS (SELECT 1 FROM GameEvents WHERE GameId = $1)`,
+		request.GetGameId()).Scan(&hasEvents)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for game events: %w", err)
+	}
+
+	if hasEvents {
+		violations.add("GameId", "Game %v has play-by-play events, its period scores are derived from them", request.GetGameId())
+		return nil, violations.errWithCode(codes.FailedPrecondition, "Period scores are derived from game events")
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			GamePeriodScores
+		WHERE
+			GameId = $1`,
+		request.GetGameId())
+
+	if err != nil {
+		return nil, fmt.Errorf("Error deleting period scores: %w", err)
+	}
+
+	for _, period := range request.GetPeriodScores() {
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO GamePeriodScores (
+				GameId,
+				Period,
+				HomeTeamPoints,
+				AwayTeamPoints)
+			VALUES
+				($1, $2, $3, $4)`,
+			request.GetGameId(),
+			period.GetPeriod(),
+			period.GetHomeTeamPoints(),
+			period.GetAwayTeamPoints())
+
+		if err != nil {
+			return nil, writeError(fmt.Sprintf("Error inserting period %v score", period.GetPeriod()), err)
+		}
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing period scores", err)
+	}
+
+	log.Printf("Updated %v period scores for game %v", len(request.GetPeriodScores()), request.GetGameId())
+
+	return &pb.UpdateGamePeriodScoresResponse{
+		GameId: request.GetGameId(),
+	}, nil
+}
+
+func (database *HeroBallDatabase) UpdateCompetitionPeriods(ctx context.Context, request *pb.UpdateCompetitionPeriodsRequest) (*pb.UpdateCompetitionPeriodsResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetCompetitionId() <= 0 {
+		violations.add("CompetitionId", "Must be greater than zero")
+	}
+
+	if request.GetPeriodCount() != 4 && request.GetPeriodCount() != 2 {
+		violations.add("PeriodCount", "Must be 4 for quarters or 2 for halves")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	result, err := database.db.ExecContext(ctx, `
+		UPDATE
+			Competitions
+		SET
+			PeriodCount = $2
+		WHERE
+			CompetitionId = $1`,
+		request.GetCompetitionId(),
+		request.GetPeriodCount())
+
+	if err != nil {
+		return nil, writeError("Error updating competition periods", err)
+	}
+
+	updated, err := result.RowsAffected()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting updated competition count: %w", err)
+	}
+
+	if updated == 0 {
+		return nil, notFound("competitionId", request.GetCompetitionId())
+	}
+
+	log.Printf("Competition %v now has %v periods", request.GetCompetitionId(), request.GetPeriodCount())
+
+	return &pb.UpdateCompetitionPeriodsResponse{
+		CompetitionId: request.GetCompetitionId(),
+	}, nil
+}
@@ -0,0 +1,514 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+
+	"github.com/lib/pq"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	initialRating = 1500
+	/* the most a rating moves on a game, before the margin of victory */
+	ratingK = 20
+	/* rating points the home team is worth on its own court */
+	homeCourtAdvantage = 100
+)
+
+/* a final game as it goes into the ratings */
+type ratedGame struct {
+	gameId     int32
+	homeTeamId int32
+	awayTeamId int32
+	homePoints int32
+	awayPoints int32
+}
+
+type ratingChange struct {
+	teamId int32
+	gameId int32
+	before float64
+	after  float64
+}
+
+/* the chance the home team wins, from each side's rating going in */
+func homeWinProbability(homeRating float64, awayRating float64) float64 {
+	return 1 / (1 + math.Pow(10, (awayRating-homeRating-homeCourtAdvantage)/400))
+}
+
+/*
+ * a bigger win moves the ratings further, but by less the more the winner was
+ * already expected to win, so favourites running up scores don't inflate
+ */
+func marginOfVictoryMultiplier(margin int32, winnerRatingDifference float64) float64 {
+
+	if margin == 0 {
+		return 1
+	}
+
+	return math.Log(math.Abs(float64(margin))+1) * 2.2 / (winnerRatingDifference*0.001 + 2.2)
+}
+
+/* plays the games through in order, every team starts at initialRating and carries its rating between competitions */
+func computeRatings(games []ratedGame) []ratingChange {
+
+	ratings := make(map[int32]float64)
+	changes := make([]ratingChange, 0, len(games)*2)
+
+	rating := func(teamId int32) float64 {
+		if current, found := ratings[teamId]; found {
+			return current
+		}
+		return initialRating
+	}
+
+	for _, game := range games {
+
+		home, away := rating(game.homeTeamId), rating(game.awayTeamId)
+		expected := homeWinProbability(home, away)
+
+		/* from the home team's side */
+		actual := 0.5
+		difference := home + homeCourtAdvantage - away
+
+		switch {
+		case game.homePoints > game.awayPoints:
+			actual = 1
+		case game.homePoints < game.awayPoints:
+			actual = 0
+			difference = -difference
+		}
+
+		shift := ratingK * marginOfVictoryMultiplier(game.homePoints-game.awayPoints, difference) * (actual - expected)
+
+		ratings[game.homeTeamId] = home + shift
+		ratings[game.awayTeamId] = away - shift
+
+		changes = append(changes,
+			ratingChange{teamId: game.homeTeamId, gameId: game.gameId, before: home, after: home + shift},
+			ratingChange{teamId: game.awayTeamId, gameId: game.gameId, before: away, after: away - shift})
+	}
+
+	return changes
+}
+
+/*
+ * replays every final game into TeamRatings. A corrected score or a game played
+ * out of order changes every rating after it, so the whole history is rebuilt.
+ * Forfeits say nothing about how strong the teams are and are left out
+ */
+func rebuildRatings(ctx context.Context, db *sql.DB) error {
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT
+			Games.GameId,
+			Games.HomeTeamId,
+			Games.AwayTeamId,
+			COALESCE(GameScoresView.HomeTeamPoints, 0),
+			COALESCE(GameScoresView.AwayTeamPoints, 0)
+		FROM
+			Games
+		JOIN
+			GameScoresView ON Games.GameId = GameScoresView.GameId
+		WHERE
+			Games.Status = 'final'
+		ORDER BY
+			Games.GameTime ASC,
+			Games.GameId ASC`)
+
+	if err != nil {
+		return fmt.Errorf("Error getting games to rate: %w", err)
+	}
+
+	defer rows.Close()
+
+	games := make([]ratedGame, 0)
+
+	for rows.Next() {
+
+		var game ratedGame
+
+		err = rows.Scan(&game.gameId, &game.homeTeamId, &game.awayTeamId, &game.homePoints, &game.awayPoints)
+
+		if err != nil {
+			return fmt.Errorf("Error scanning game to rate: %w", err)
+		}
+
+		games = append(games, game)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return fmt.Errorf("Error following scan: %w", err)
+	}
+
+	changes := computeRatings(games)
+
+	teamIds := make([]int32, 0, len(changes))
+	gameIds := make([]int32, 0, len(changes))
+	before := make([]float64, 0, len(changes))
+	after := make([]float64, 0, len(changes))
+
+	for _, change := range changes {
+		teamIds = append(teamIds, change.teamId)
+		gameIds = append(gameIds, change.gameId)
+		before = append(before, change.before)
+		after = append(after, change.after)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM
+			TeamRatings`)
+
+	if err != nil {
+		return fmt.Errorf("Error clearing team ratings: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO TeamRatings (
+			TeamId,
+			GameId,
+			RatingBefore,
+			RatingAfter)
+		SELECT
+			*
+		FROM
+			unnest($1::int[], $2::int[], $3::float8[], $4::float8[])`,
+		pq.Array(teamIds),
+		pq.Array(gameIds),
+		pq.Array(before),
+		pq.Array(after))
+
+	if err != nil {
+		return fmt.Errorf("Error writing team ratings: %w", err)
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return fmt.Errorf("Error committing team ratings: %w", err)
+	}
+
+	return nil
+}
+
+/* teams ranked by their rating after their latest final game */
+func (database *HeroBallDatabase) GetTeamRatings(ctx context.Context, request *pb.GetTeamRatingsRequest) (*pb.GetTeamRatingsResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetCompetitionId() < 0 {
+		violations.add("CompetitionId", "Must be zero or greater")
+	}
+
+	for i, teamId := range request.GetTeamIds() {
+		if teamId <= 0 {
+			violations.add(fmt.Sprintf("TeamIds[%v]", i), "Must be greater than zero")
+		}
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	if request.GetCompetitionId() != 0 {
+
+		_, err := database.getCompetitionById(ctx, request.GetCompetitionId())
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			Teams.TeamId,
+			COALESCE((
+				SELECT
+					TeamRatings.RatingAfter
+				FROM
+					TeamRatings
+				JOIN
+					Games ON TeamRatings.GameId = Games.GameId
+				WHERE
+					TeamRatings.TeamId = Teams.TeamId
+				ORDER BY
+					Games.GameTime DESC,
+					Games.GameId DESC
+				LIMIT 1), $3),
+			(SELECT COUNT(*) FROM TeamRatings WHERE TeamRatings.TeamId = Teams.TeamId)
+		FROM
+			Teams
+		WHERE
+			($1 = 0 OR Teams.TeamId IN (SELECT TeamId FROM CompetitionTeams WHERE CompetitionId = $1)) AND
+			(cardinality($2::int[]) IS NULL OR Teams.TeamId = ANY($2))
+		ORDER BY
+			2 DESC,
+			Teams.TeamId ASC`,
+		request.GetCompetitionId(),
+		pq.Array(request.GetTeamIds()),
+		initialRating)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting team ratings: %w", err)
+	}
+
+	defer rows.Close()
+
+	response := &pb.GetTeamRatingsResponse{
+		Ratings: make([]*pb.TeamRating, 0),
+	}
+
+	teamIds := make([]int32, 0)
+
+	for rows.Next() {
+
+		rating := &pb.TeamRating{
+			Team: &pb.Team{},
+		}
+
+		err = rows.Scan(&rating.Team.TeamId, &rating.Rating, &rating.GameCount)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning team rating: %w", err)
+		}
+
+		response.Ratings = append(response.Ratings, rating)
+		teamIds = append(teamIds, rating.Team.TeamId)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	/* a team asked for by id that isn't there is an error rather than a missing row */
+	if request.GetCompetitionId() == 0 {
+		for _, teamId := range request.GetTeamIds() {
+			if !containsTeamId(teamIds, teamId) {
+				return nil, notFound("teamId", teamId)
+			}
+		}
+	}
+
+	if len(teamIds) == 0 {
+		return response, nil
+	}
+
+	teams, err := database.getTeamsById(ctx, teamIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rating := range response.Ratings {
+		rating.Team = findTeam(teams, rating.Team.TeamId)
+	}
+
+	if !request.GetIncludeHistory() {
+		return response, nil
+	}
+
+	history, err := database.getRatingHistory(ctx, teamIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rating := range response.Ratings {
+		rating.History = history[rating.GetTeam().GetTeamId()]
+	}
+
+	return response, nil
+}
+
+func containsTeamId(teamIds []int32, teamId int32) bool {
+
+	for _, id := range teamIds {
+		if id == teamId {
+			return true
+		}
+	}
+
+	return false
+}
+
+/* each team's rating changes, oldest first */
+func (database *HeroBallDatabase) getRatingHistory(ctx context.Context, teamIds []int32) (map[int32][]*pb.RatingChange, error) {
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			TeamRatings.TeamId,
+			Games.GameId,
+			Games.GameTime,
+			CASE WHEN TeamRatings.TeamId = Games.HomeTeamId THEN Games.AwayTeamId ELSE Games.HomeTeamId END,
+			COALESCE(CASE WHEN TeamRatings.TeamId = Games.HomeTeamId THEN GameScoresView.HomeTeamPoints ELSE GameScoresView.AwayTeamPoints END, 0),
+			COALESCE(CASE WHEN TeamRatings.TeamId = Games.HomeTeamId THEN GameScoresView.AwayTeamPoints ELSE GameScoresView.HomeTeamPoints END, 0),
+			TeamRatings.RatingBefore,
+			TeamRatings.RatingAfter
+		FROM
+			TeamRatings
+		JOIN
+			Games ON TeamRatings.GameId = Games.GameId
+		JOIN
+			GameScoresView ON TeamRatings.GameId = GameScoresView.GameId
+		WHERE
+			TeamRatings.TeamId = ANY($1)
+		ORDER BY
+			Games.GameTime ASC,
+			Games.GameId ASC`,
+		pq.Array(teamIds))
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting rating history: %w", err)
+	}
+
+	defer rows.Close()
+
+	history := make(map[int32][]*pb.RatingChange)
+	changes := make([]*pb.RatingChange, 0)
+	opponentIds := make([]int32, 0)
+
+	for rows.Next() {
+
+		var teamId int32
+		change := &pb.RatingChange{
+			Opponent: &pb.Team{},
+		}
+
+		err = rows.Scan(
+			&teamId,
+			&change.GameId,
+			&change.GameTime,
+			&change.Opponent.TeamId,
+			&change.PointsFor,
+			&change.PointsAgainst,
+			&change.RatingBefore,
+			&change.RatingAfter)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning rating change: %w", err)
+		}
+
+		history[teamId] = append(history[teamId], change)
+		changes = append(changes, change)
+		opponentIds = append(opponentIds, change.Opponent.TeamId)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	if len(changes) == 0 {
+		return history, nil
+	}
+
+	opponents, err := database.getTeamsById(ctx, opponentIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range changes {
+		change.Opponent = findTeam(opponents, change.Opponent.TeamId)
+	}
+
+	return history, nil
+}
+
+/* the win probability of a game from the ratings the teams took into it */
+func (database *HeroBallDatabase) PredictGame(ctx context.Context, request *pb.PredictGameRequest) (*pb.GamePrediction, error) {
+
+	if request.GetGameId() <= 0 {
+		return nil, invalidArgument("GameId", "Must be greater than zero")
+	}
+
+	var homeTeamId, awayTeamId int32
+
+	err := database.db.QueryRowContext(ctx, `
+		SELECT
+			HomeTeamId,
+			AwayTeamId
+		FROM
+			Games
+		WHERE
+			GameId = $1`,
+		request.GetGameId()).Scan(&homeTeamId, &awayTeamId)
+
+	if err == sql.ErrNoRows {
+		return nil, notFound("gameId", request.GetGameId())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting game: %w", err)
+	}
+
+	prediction := &pb.GamePrediction{
+		GameId: request.GetGameId(),
+	}
+
+	prediction.HomeTeamRating, err = database.getRatingBeforeGame(ctx, homeTeamId, request.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	prediction.AwayTeamRating, err = database.getRatingBeforeGame(ctx, awayTeamId, request.GetGameId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	prediction.HomeWinProbability = homeWinProbability(prediction.HomeTeamRating, prediction.AwayTeamRating)
+	prediction.AwayWinProbability = 1 - prediction.HomeWinProbability
+
+	return prediction, nil
+}
+
+/* the team's rating after its last final game before this one */
+func (database *HeroBallDatabase) getRatingBeforeGame(ctx context.Context, teamId int32, gameId int32) (float64, error) {
+
+	var rating float64
+
+	err := database.db.QueryRowContext(ctx, `
+		SELECT
+			COALESCE((
+				SELECT
+					TeamRatings.RatingAfter
+				FROM
+					TeamRatings
+				JOIN
+					Games ON TeamRatings.GameId = Games.GameId
+				WHERE
+					TeamRatings.TeamId = $1 AND
+					(Games.GameTime, Games.GameId) < (SELECT GameTime, GameId FROM Games WHERE GameId = $2)
+				ORDER BY
+					Games.GameTime DESC,
+					Games.GameId DESC
+				LIMIT 1), $3)`,
+		teamId,
+		gameId,
+		initialRating).Scan(&rating)
+
+	if err != nil {
+		return 0, fmt.Errorf("Error getting rating of team %v: %w", teamId, err)
+	}
+
+	return rating, nil
+}
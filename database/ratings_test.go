@@ -0,0 +1,156 @@
+package database
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+const ratingTolerance = 1e-9
+
+func TestHomeWinProbability(t *testing.T) {
+
+	tests := []struct {
+		home        float64
+		away        float64
+		probability float64
+	}{
+		/* home court alone makes the home team favourites */
+		{1500, 1500, 0.6400649998028851},
+		{1600, 1500, 0.7597469266479578},
+		/* home court makes up the difference */
+		{1400, 1500, 0.5},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v v %v", test.home, test.away), func(t *testing.T) {
+
+			probability := homeWinProbability(test.home, test.away)
+
+			if math.Abs(probability-test.probability) > ratingTolerance {
+				t.Errorf("Got %v, want %v", probability, test.probability)
+			}
+		})
+	}
+}
+
+func TestMarginOfVictoryMultiplier(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		margin     int32
+		difference float64
+		multiplier float64
+	}{
+		{"draw", 0, 100, 1},
+		{"one point", 1, 0, 0.6931471805599453},
+		{"ten points", 10, 0, 2.3978952727983707},
+		{"ten points the other way", -10, 0, 2.3978952727983707},
+		{"ten points by the favourite", 10, 400, 2.0289883077524675},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			multiplier := marginOfVictoryMultiplier(test.margin, test.difference)
+
+			if math.Abs(multiplier-test.multiplier) > ratingTolerance {
+				t.Errorf("Got %v, want %v", multiplier, test.multiplier)
+			}
+		})
+	}
+}
+
+func TestComputeRatings(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		games   []ratedGame
+		ratings map[int32]float64
+	}{
+		{
+			name:    "home win",
+			games:   []ratedGame{{gameId: 1, homeTeamId: 1, awayTeamId: 2, homePoints: 80, awayPoints: 70}},
+			ratings: map[int32]float64{1: 1516.511218765845, 2: 1483.488781234155},
+		},
+		{
+			/* bigger than the home win, as the away team wasn't expected to */
+			name:    "away win",
+			games:   []ratedGame{{gameId: 1, homeTeamId: 1, awayTeamId: 2, homePoints: 70, awayPoints: 80}},
+			ratings: map[int32]float64{1: 1467.8421005515784, 2: 1532.1578994484216},
+		},
+		{
+			/* half a win is less than the home team was expected to get */
+			name:    "draw",
+			games:   []ratedGame{{gameId: 1, homeTeamId: 1, awayTeamId: 2, homePoints: 75, awayPoints: 75}},
+			ratings: map[int32]float64{1: 1497.1987000039423, 2: 1502.8012999960577},
+		},
+		{
+			name:    "narrow home win",
+			games:   []ratedGame{{gameId: 1, homeTeamId: 1, awayTeamId: 2, homePoints: 71, awayPoints: 70}},
+			ratings: map[int32]float64{1: 1504.7728125848455, 2: 1495.2271874151545},
+		},
+		{
+			name:    "big home win",
+			games:   []ratedGame{{gameId: 1, homeTeamId: 1, awayTeamId: 2, homePoints: 100, awayPoints: 70}},
+			ratings: map[int32]float64{1: 1523.64545049801, 2: 1476.35454950199},
+		},
+		{
+			/* the same ten point home wins, the second from where the first left them */
+			name: "home and away",
+			games: []ratedGame{
+				{gameId: 1, homeTeamId: 1, awayTeamId: 2, homePoints: 80, awayPoints: 70},
+				{gameId: 2, homeTeamId: 2, awayTeamId: 1, homePoints: 80, awayPoints: 70},
+			},
+			ratings: map[int32]float64{1: 1497.6719786840035, 2: 1502.3280213159965},
+		},
+		{
+			name: "home and away the other way round",
+			games: []ratedGame{
+				{gameId: 1, homeTeamId: 2, awayTeamId: 1, homePoints: 80, awayPoints: 70},
+				{gameId: 2, homeTeamId: 1, awayTeamId: 2, homePoints: 80, awayPoints: 70},
+			},
+			ratings: map[int32]float64{1: 1502.3280213159965, 2: 1497.6719786840035},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			changes := computeRatings(test.games)
+
+			if len(changes) != 2*len(test.games) {
+				t.Fatalf("Got %v rating changes for %v games", len(changes), len(test.games))
+			}
+
+			ratings := make(map[int32]float64)
+
+			for i, change := range changes {
+
+				/* each game starts from the rating the last one left */
+				if current, found := ratings[change.teamId]; found && current != change.before {
+					t.Errorf("Team %v went into game %v on %v, want %v", change.teamId, change.gameId, change.before, current)
+				}
+
+				if _, found := ratings[change.teamId]; !found && change.before != initialRating {
+					t.Errorf("Team %v started on %v, want %v", change.teamId, change.before, initialRating)
+				}
+
+				ratings[change.teamId] = change.after
+
+				/* what one team gains the other loses */
+				if i%2 == 1 {
+					if gained := change.after - change.before + changes[i-1].after - changes[i-1].before; math.Abs(gained) > ratingTolerance {
+						t.Errorf("Game %v adds %v rating points", change.gameId, gained)
+					}
+				}
+			}
+
+			for teamId, want := range test.ratings {
+				if math.Abs(ratings[teamId]-want) > ratingTolerance {
+					t.Errorf("Team %v is on %v, want %v", teamId, ratings[teamId], want)
+				}
+			}
+		})
+	}
+}
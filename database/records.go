@@ -0,0 +1,394 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	defaultRecordsMinimumGames = 5
+	defaultRecordsCount        = 5
+	maxRecordsCount            = 50
+)
+
+/* a stat a single game record is kept for, and the per game average kept for it over a season */
+type recordCategory struct {
+	name       string
+	expression string
+	season     string
+	/* counts towards double and triple doubles */
+	doubleFigures bool
+}
+
+/* in the order they're returned */
+var recordCategories = []recordCategory{
+	{
+		name:          "points",
+		expression:    "COALESCE(PlayerGameStats.ThreePointFGM, 0)*3 + COALESCE(PlayerGameStats.TwoPointFGM, 0)*2 + COALESCE(PlayerGameStats.FreeThrowsMade, 0)",
+		season:        "PPG",
+		doubleFigures: true,
+	},
+	{
+		name:          "rebounds",
+		expression:    "COALESCE(PlayerGameStats.OffensiveRebounds, 0) + COALESCE(PlayerGameStats.DefensiveRebounds, 0)",
+		season:        "RPG",
+		doubleFigures: true,
+	},
+	{
+		name:          "assists",
+		expression:    "COALESCE(PlayerGameStats.Assists, 0)",
+		season:        "APG",
+		doubleFigures: true,
+	},
+	{
+		name:          "steals",
+		expression:    "COALESCE(PlayerGameStats.Steals, 0)",
+		season:        "SPG",
+		doubleFigures: true,
+	},
+	{
+		name:          "blocks",
+		expression:    "COALESCE(PlayerGameStats.Blocks, 0)",
+		season:        "BPG",
+		doubleFigures: true,
+	},
+	{
+		name:       "three-pointers-made",
+		expression: "COALESCE(PlayerGameStats.ThreePointFGM, 0)",
+	},
+}
+
+/* the best games in the category matching the condition */
+func (database *HeroBallDatabase) getGameRecords(ctx context.Context, category recordCategory, condition string, args []interface{}, count int32) ([]*pb.GameRecord, error) {
+
+	rows, err := database.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			%v,
+			Games.GameId,
+			Games.GameTime,
+			Players.PlayerId,
+			Players.Name,
+			Players.Position,
+			Teams.TeamId,
+			Teams.Name
+		FROM
+			PlayerGameStats
+		JOIN
+			Games ON PlayerGameStats.GameId = Games.GameId
+		JOIN
+			Players ON PlayerGameStats.PlayerId = Players.PlayerId
+		JOIN
+			Teams ON PlayerGameStats.TeamId = Teams.TeamId
+		WHERE
+			(%v) AND %v
+		ORDER BY
+			1 DESC,
+			Games.GameTime ASC,
+			Games.GameId ASC
+		LIMIT %v`,
+		category.expression, condition, countedGamesCondition, count), args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting %v records: %w", category.name, err)
+	}
+
+	defer rows.Close()
+
+	records := make([]*pb.GameRecord, 0)
+
+	for rows.Next() {
+
+		record := &pb.GameRecord{
+			Category: category.name,
+			Player:   &pb.Player{},
+			Team:     &pb.Team{},
+		}
+
+		err = rows.Scan(
+			&record.Value,
+			&record.GameId,
+			&record.GameTime,
+			&record.Player.PlayerId,
+			&record.Player.Name,
+			&record.Player.Position,
+			&record.Team.TeamId,
+			&record.Team.Name)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning %v record: %w", category.name, err)
+		}
+
+		records = append(records, record)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return records, nil
+}
+
+/* the player's best game in each category, none for a player yet to play */
+func (database *HeroBallDatabase) getCareerHighs(ctx context.Context, playerId int32) ([]*pb.GameRecord, error) {
+
+	highs := make([]*pb.GameRecord, 0)
+
+	for _, category := range recordCategories {
+
+		records, err := database.getGameRecords(ctx, category, "PlayerGameStats.PlayerId = $1", []interface{}{playerId}, 1)
+
+		if err != nil {
+			return nil, err
+		}
+
+		highs = append(highs, records...)
+	}
+
+	return highs, nil
+}
+
+func (database *HeroBallDatabase) getDoubleDoubles(ctx context.Context, playerId int32) (int32, int32, error) {
+
+	/* how many of the categories each game reached double figures in */
+	doubleFigures := ""
+
+	for _, category := range recordCategories {
+		if category.doubleFigures {
+			doubleFigures += fmt.Sprintf(" + (%v >= 10)::int", category.expression)
+		}
+	}
+
+	var doubleDoubles int32
+	var tripleDoubles int32
+
+	err := database.db.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT
+			COUNT(*) FILTER (WHERE DoubleFigures >= 2),
+			COUNT(*) FILTER (WHERE DoubleFigures >= 3)
+		FROM (
+			SELECT
+				0%v AS DoubleFigures
+			FROM
+				PlayerGameStats
+			JOIN
+				Games ON PlayerGameStats.GameId = Games.GameId
+			WHERE
+				PlayerGameStats.PlayerId = $1 AND %v
+		) PlayerGames`,
+		doubleFigures, countedGamesCondition),
+		playerId).Scan(&doubleDoubles, &tripleDoubles)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("Error counting double doubles: %w", err)
+	}
+
+	return doubleDoubles, tripleDoubles, nil
+}
+
+/* the best single games and seasons in a competition or across a league's competitions */
+func (database *HeroBallDatabase) GetRecordsBook(ctx context.Context, request *pb.GetRecordsBookRequest) (*pb.RecordsBook, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetCompetitionId() < 0 {
+		violations.add("CompetitionId", "Must be zero or greater")
+	}
+
+	if request.GetLeagueId() < 0 {
+		violations.add("LeagueId", "Must be zero or greater")
+	}
+
+	if (request.GetCompetitionId() == 0) == (request.GetLeagueId() == 0) {
+		violations.add("CompetitionId", "Must give either a CompetitionId or a LeagueId")
+	}
+
+	if request.GetMinimumGames() < 0 {
+		violations.add("MinimumGames", "Must be zero or greater")
+	}
+
+	if request.GetCount() < 0 || request.GetCount() > maxRecordsCount {
+		violations.add("Count", "Must be from 0 to %v", maxRecordsCount)
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	minimumGames := request.GetMinimumGames()
+
+	if minimumGames == 0 {
+		minimumGames = defaultRecordsMinimumGames
+	}
+
+	count := request.GetCount()
+
+	if count == 0 {
+		count = defaultRecordsCount
+	}
+
+	var condition string
+	var scopeId int32
+
+	if request.GetCompetitionId() != 0 {
+
+		_, err := database.getCompetitionById(ctx, request.GetCompetitionId())
+
+		if err != nil {
+			return nil, err
+		}
+
+		condition = "Games.CompetitionId = $1"
+		scopeId = request.GetCompetitionId()
+
+	} else {
+
+		var exists bool
+
+		err := database.db.QueryRowContext(ctx, `
+			SELECT
+				EXISTS (SELECT 1 FROM Leagues WHERE LeagueId = $1)`,
+			request.GetLeagueId()).Scan(&exists)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error checking for league: %w", err)
+		}
+
+		if !exists {
+			return nil, notFound("leagueId", request.GetLeagueId())
+		}
+
+		condition = "Games.CompetitionId IN (SELECT CompetitionId FROM Competitions WHERE LeagueId = $1)"
+		scopeId = request.GetLeagueId()
+	}
+
+	book := &pb.RecordsBook{
+		GameRecords:   make([]*pb.GameRecords, 0),
+		SeasonRecords: make([]*pb.SeasonRecords, 0),
+	}
+
+	for _, category := range recordCategories {
+
+		records, err := database.getGameRecords(ctx, category, condition, []interface{}{scopeId}, count)
+
+		if err != nil {
+			return nil, err
+		}
+
+		book.GameRecords = append(book.GameRecords, &pb.GameRecords{
+			Category: category.name,
+			Records:  records,
+		})
+
+		if category.season == "" {
+			continue
+		}
+
+		seasonRecords, err := database.getSeasonRecords(ctx, category, condition, scopeId, minimumGames, count)
+
+		if err != nil {
+			return nil, err
+		}
+
+		book.SeasonRecords = append(book.SeasonRecords, &pb.SeasonRecords{
+			Category: category.season,
+			Records:  seasonRecords,
+		})
+	}
+
+	return book, nil
+}
+
+/* the best per game averages in the category over a competition, for players with minimumGames there */
+func (database *HeroBallDatabase) getSeasonRecords(ctx context.Context, category recordCategory, condition string, scopeId int32, minimumGames int32, count int32) ([]*pb.SeasonRecord, error) {
+
+	rows, err := database.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			PlayerGameStats.PlayerId,
+			Games.CompetitionId,
+			SUM(%v)::float / COUNT(DISTINCT PlayerGameStats.GameId),
+			COUNT(DISTINCT PlayerGameStats.GameId)
+		FROM
+			PlayerGameStats
+		JOIN
+			Games ON PlayerGameStats.GameId = Games.GameId
+		WHERE
+			(%v) AND %v
+		GROUP BY
+			PlayerGameStats.PlayerId,
+			Games.CompetitionId
+		HAVING
+			COUNT(DISTINCT PlayerGameStats.GameId) >= $2
+		ORDER BY
+			3 DESC,
+			4 DESC
+		LIMIT %v`,
+		category.expression, condition, countedGamesCondition, count),
+		scopeId,
+		minimumGames)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting %v records: %w", category.season, err)
+	}
+
+	defer rows.Close()
+
+	records := make([]*pb.SeasonRecord, 0)
+	playerIds := make([]int32, 0)
+	competitionIds := make([]int32, 0)
+
+	for rows.Next() {
+
+		var playerId int32
+		var competitionId int32
+		record := &pb.SeasonRecord{}
+
+		err = rows.Scan(&playerId, &competitionId, &record.Value, &record.GameCount)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning %v record: %w", category.season, err)
+		}
+
+		records = append(records, record)
+		playerIds = append(playerIds, playerId)
+		competitionIds = append(competitionIds, competitionId)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	if len(records) == 0 {
+		return records, nil
+	}
+
+	players, err := database.getPlayersById(ctx, playerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	competitions, err := database.getCompetitionsById(ctx, competitionIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, record := range records {
+
+		record.Player = findPlayer(players, playerIds[i])
+
+		for _, competition := range competitions {
+			if competition.GetCompetitionId() == competitionIds[i] {
+				record.Competition = competition
+			}
+		}
+	}
+
+	return records, nil
+}
@@ -0,0 +1,356 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	scheduleDateLayout = "2006-01-02"
+	scheduleTimeLayout = "15:04"
+	/* how far past the start date we look for game days before giving up */
+	maxScheduleDays = 3 * 366
+)
+
+type fixture struct {
+	round      int32
+	homeTeamId int32
+	awayTeamId int32
+}
+
+/* a slot on a weekday, as an offset from midnight */
+type scheduleSlot struct {
+	weekday time.Weekday
+	offset  time.Duration
+}
+
+/*
+ * pairs every team with every other once, or twice with home and away swapped,
+ * using the circle method: the first team stays put while the rest rotate
+ * around it a place each round. With an odd number of teams a bye makes up the
+ * numbers, and whoever draws it sits the round out. Each pairing is hosted by
+ * the team that has had fewer home games so far
+ */
+func roundRobin(teamIds []int32, double bool) ([][]fixture, []*pb.ScheduleBye) {
+
+	teams := append([]int32{}, teamIds...)
+
+	/* 0 is the bye */
+	if len(teams)%2 == 1 {
+		teams = append(teams, 0)
+	}
+
+	count := len(teams)
+	homeBalance := make(map[int32]int)
+
+	rounds := make([][]fixture, 0)
+	byes := make([]*pb.ScheduleBye, 0)
+
+	for round := int32(1); round < int32(count); round++ {
+
+		fixtures := make([]fixture, 0)
+
+		for i := 0; i < count/2; i++ {
+
+			home := teams[i]
+			away := teams[count-1-i]
+
+			if home == 0 || away == 0 {
+				byes = append(byes, &pb.ScheduleBye{
+					Round:  round,
+					TeamId: home + away,
+				})
+				continue
+			}
+
+			/* ties alternate so nobody starts every round at home */
+			if homeBalance[away] < homeBalance[home] || (homeBalance[away] == homeBalance[home] && (int(round)+i)%2 == 0) {
+				home, away = away, home
+			}
+
+			homeBalance[home]++
+			homeBalance[away]--
+
+			fixtures = append(fixtures, fixture{
+				round:      round,
+				homeTeamId: home,
+				awayTeamId: away,
+			})
+		}
+
+		rounds = append(rounds, fixtures)
+
+		last := teams[count-1]
+		copy(teams[2:], teams[1:count-1])
+		teams[1] = last
+	}
+
+	if !double {
+		return rounds, byes
+	}
+
+	firstHalf := int32(len(rounds))
+
+	for _, fixtures := range rounds[:firstHalf] {
+
+		mirrored := make([]fixture, 0)
+
+		for _, game := range fixtures {
+			mirrored = append(mirrored, fixture{
+				round:      game.round + firstHalf,
+				homeTeamId: game.awayTeamId,
+				awayTeamId: game.homeTeamId,
+			})
+		}
+
+		rounds = append(rounds, mirrored)
+	}
+
+	singleByes := len(byes)
+
+	for _, bye := range byes[:singleByes] {
+		byes = append(byes, &pb.ScheduleBye{
+			Round:  bye.Round + firstHalf,
+			TeamId: bye.TeamId,
+		})
+	}
+
+	return rounds, byes
+}
+
+/*
+ * fills each location at each slot from the start date on, skipping blackout
+ * dates. A round can spread over several game days if it has to, but the next
+ * round never starts on a day the previous one was still being played
+ */
+func assignScheduleSlots(rounds [][]fixture, start time.Time, slots []scheduleSlot, locationIds []int32, blackouts map[string]bool) ([]*pb.ScheduledGame, error) {
+
+	slotsByWeekday := make(map[time.Weekday][]time.Duration)
+
+	for _, slot := range slots {
+		slotsByWeekday[slot.weekday] = append(slotsByWeekday[slot.weekday], slot.offset)
+	}
+
+	for _, offsets := range slotsByWeekday {
+		sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	}
+
+	games := make([]*pb.ScheduledGame, 0)
+	day := start
+
+	for _, fixtures := range rounds {
+
+		for len(fixtures) > 0 {
+
+			for len(slotsByWeekday[day.Weekday()]) == 0 || blackouts[day.Format(scheduleDateLayout)] {
+
+				day = day.AddDate(0, 0, 1)
+
+				if day.Sub(start) > maxScheduleDays*24*time.Hour {
+					return nil, invalidArgument("Slots", "Could not fit the schedule into the %v days after StartDate", maxScheduleDays)
+				}
+			}
+
+			for _, offset := range slotsByWeekday[day.Weekday()] {
+				for _, locationId := range locationIds {
+
+					if len(fixtures) == 0 {
+						break
+					}
+
+					games = append(games, &pb.ScheduledGame{
+						Round:      fixtures[0].round,
+						HomeTeamId: fixtures[0].homeTeamId,
+						AwayTeamId: fixtures[0].awayTeamId,
+						LocationId: locationId,
+						GameTime:   day.Add(offset).Format(time.RFC3339),
+					})
+
+					fixtures = fixtures[1:]
+				}
+			}
+
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+
+	return games, nil
+}
+
+/* works out a round robin for the competition, and unless previewing creates its games as scheduled */
+func (database *HeroBallDatabase) GenerateSchedule(ctx context.Context, request *pb.GenerateScheduleRequest) (*pb.GenerateScheduleResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetCompetitionId() <= 0 {
+		violations.add("CompetitionId", "Must be greater than zero")
+	}
+
+	validateScheduleIds(&violations, "TeamIds", request.GetTeamIds())
+
+	if len(request.GetLocationIds()) == 0 {
+		violations.add("LocationIds", "Must supply at least one location")
+	}
+
+	validateScheduleIds(&violations, "LocationIds", request.GetLocationIds())
+
+	start, err := time.Parse(scheduleDateLayout, request.GetStartDate())
+
+	if err != nil {
+		violations.add("StartDate", "Expecting a date as %v", scheduleDateLayout)
+	}
+
+	if len(request.GetSlots()) == 0 {
+		violations.add("Slots", "Must supply at least one slot")
+	}
+
+	slots := make([]scheduleSlot, 0)
+	seenSlots := make(map[scheduleSlot]bool)
+
+	for i, requestSlot := range request.GetSlots() {
+
+		field := fmt.Sprintf("Slots[%v]", i)
+
+		if requestSlot.GetWeekday() < 0 || requestSlot.GetWeekday() > 6 {
+			violations.add(field+".Weekday", "Must be from 0 (Sunday) to 6 (Saturday)")
+			continue
+		}
+
+		slotTime, err := time.Parse(scheduleTimeLayout, requestSlot.GetTime())
+
+		if err != nil {
+			violations.add(field+".Time", "Expecting a time as %v", scheduleTimeLayout)
+			continue
+		}
+
+		slot := scheduleSlot{
+			weekday: time.Weekday(requestSlot.GetWeekday()),
+			offset:  time.Duration(slotTime.Hour())*time.Hour + time.Duration(slotTime.Minute())*time.Minute,
+		}
+
+		if seenSlots[slot] {
+			violations.add(field, "Duplicate slot")
+			continue
+		}
+
+		seenSlots[slot] = true
+		slots = append(slots, slot)
+	}
+
+	blackouts := make(map[string]bool)
+
+	for i, blackout := range request.GetBlackoutDates() {
+
+		parsed, err := time.Parse(scheduleDateLayout, blackout)
+
+		if err != nil {
+			violations.add(fmt.Sprintf("BlackoutDates[%v]", i), "Expecting a date as %v", scheduleDateLayout)
+			continue
+		}
+
+		blackouts[parsed.Format(scheduleDateLayout)] = true
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	_, err = database.getCompetitionById(ctx, request.GetCompetitionId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	teamIds := request.GetTeamIds()
+
+	if len(teamIds) == 0 {
+
+		teamIds, err = database.getCompetitionTeams(ctx, request.GetCompetitionId())
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(teamIds) < 2 {
+		return nil, invalidArgument("TeamIds", "Need at least two teams to make a schedule, found %v", len(teamIds))
+	}
+
+	rounds, byes := roundRobin(teamIds, request.GetDoubleRoundRobin())
+
+	games, err := assignScheduleSlots(rounds, start, slots, request.GetLocationIds(), blackouts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GenerateScheduleResponse{
+		Games: games,
+		Byes:  byes,
+	}
+
+	if request.GetPreview() {
+		return response, nil
+	}
+
+	tx, err := database.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error starting transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	for _, game := range games {
+
+		gameTime, err := time.Parse(time.RFC3339, game.GameTime)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing scheduled game time: %w", err)
+		}
+
+		game.GameId, err = createGameInTx(ctx, tx, gameRow{
+			competitionId: request.GetCompetitionId(),
+			locationId:    game.LocationId,
+			homeTeamId:    game.HomeTeamId,
+			awayTeamId:    game.AwayTeamId,
+			gameTime:      gameTime,
+			status:        "scheduled",
+		}, nil)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return nil, writeError("Error committing schedule", err)
+	}
+
+	log.Printf("Scheduled %v games over %v rounds for competition %v", len(games), len(rounds), request.GetCompetitionId())
+
+	return response, nil
+}
+
+func validateScheduleIds(violations *fieldViolations, field string, ids []int32) {
+
+	seen := make(map[int32]bool)
+
+	for i, id := range ids {
+
+		if id <= 0 {
+			violations.add(fmt.Sprintf("%v[%v]", field, i), "Must be greater than zero")
+		} else if seen[id] {
+			violations.add(fmt.Sprintf("%v[%v]", field, i), "Duplicate id %v", id)
+		}
+
+		seen[id] = true
+	}
+}
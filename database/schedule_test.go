@@ -0,0 +1,247 @@
+package database
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func scheduleTeams(count int) []int32 {
+
+	teams := make([]int32, count)
+
+	for i := range teams {
+		teams[i] = int32(i + 1)
+	}
+
+	return teams
+}
+
+func TestRoundRobin(t *testing.T) {
+
+	tests := []struct {
+		teams  int
+		double bool
+		rounds int
+		byes   int
+	}{
+		{teams: 2, rounds: 1},
+		{teams: 3, rounds: 3, byes: 3},
+		{teams: 4, rounds: 3},
+		{teams: 5, rounds: 5, byes: 5},
+		{teams: 8, rounds: 7},
+		{teams: 9, rounds: 9, byes: 9},
+		{teams: 4, double: true, rounds: 6},
+		{teams: 5, double: true, rounds: 10, byes: 10},
+		{teams: 10, double: true, rounds: 18},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v teams double %v", test.teams, test.double), func(t *testing.T) {
+
+			rounds, byes := roundRobin(scheduleTeams(test.teams), test.double)
+
+			if len(rounds) != test.rounds || len(byes) != test.byes {
+				t.Fatalf("Got %v rounds and %v byes, want %v and %v", len(rounds), len(byes), test.rounds, test.byes)
+			}
+
+			meetings := make(map[string]int)
+			homeBalance := make(map[int32]int)
+			byeRounds := make(map[int32]int32)
+
+			for _, bye := range byes {
+				byeRounds[bye.TeamId]++
+			}
+
+			for i, fixtures := range rounds {
+
+				/* everyone plays once a round, bar the team with the bye */
+				playing := make(map[int32]bool)
+
+				for _, game := range fixtures {
+
+					if game.round != int32(i+1) {
+						t.Errorf("Fixture in round %v says round %v", i+1, game.round)
+					}
+
+					if playing[game.homeTeamId] || playing[game.awayTeamId] || game.homeTeamId == game.awayTeamId {
+						t.Errorf("Round %v plays a team twice: %+v", i+1, fixtures)
+					}
+
+					playing[game.homeTeamId] = true
+					playing[game.awayTeamId] = true
+
+					meetings[fmt.Sprintf("%v v %v", game.homeTeamId, game.awayTeamId)]++
+					homeBalance[game.homeTeamId]++
+					homeBalance[game.awayTeamId]--
+				}
+
+				if len(playing) != test.teams-test.teams%2 {
+					t.Errorf("Round %v has %v teams playing", i+1, len(playing))
+				}
+			}
+
+			for _, home := range scheduleTeams(test.teams) {
+
+				/* a bye for each team in each half of the season */
+				if test.teams%2 == 1 && int(byeRounds[home]) != len(rounds)/test.teams {
+					t.Errorf("Team %v has %v byes", home, byeRounds[home])
+				}
+
+				/* once each way in a double, with everything level */
+				if test.double && homeBalance[home] != 0 {
+					t.Errorf("Team %v has %v more home games than away", home, homeBalance[home])
+				}
+
+				if homeBalance[home] > 1 || homeBalance[home] < -1 {
+					t.Errorf("Team %v has %v more home games than away", home, homeBalance[home])
+				}
+
+				for _, away := range scheduleTeams(test.teams) {
+
+					if home >= away {
+						continue
+					}
+
+					there := meetings[fmt.Sprintf("%v v %v", home, away)]
+					back := meetings[fmt.Sprintf("%v v %v", away, home)]
+
+					if test.double && (there != 1 || back != 1) {
+						t.Errorf("Teams %v and %v meet %v and %v times, want once each way", home, away, there, back)
+					}
+
+					if !test.double && there+back != 1 {
+						t.Errorf("Teams %v and %v meet %v times, want once", home, away, there+back)
+					}
+				}
+			}
+		})
+	}
+}
+
+/* the second half of a double round robin is the first again with home and away swapped */
+func TestRoundRobinMirrored(t *testing.T) {
+
+	single, singleByes := roundRobin(scheduleTeams(7), false)
+	double, doubleByes := roundRobin(scheduleTeams(7), true)
+
+	half := len(single)
+
+	for i, fixtures := range single {
+
+		if len(double[i]) != len(fixtures) || len(double[i+half]) != len(fixtures) {
+			t.Fatalf("Round %v has a different number of games in the double", i+1)
+		}
+
+		for j, game := range fixtures {
+
+			mirror := double[i+half][j]
+
+			if double[i][j] != game {
+				t.Errorf("Round %v game %v differs in the double: %+v and %+v", i+1, j, double[i][j], game)
+			}
+
+			if mirror.round != game.round+int32(half) || mirror.homeTeamId != game.awayTeamId || mirror.awayTeamId != game.homeTeamId {
+				t.Errorf("Round %v game %v mirrors %+v as %+v", i+1, j, game, mirror)
+			}
+		}
+	}
+
+	for i, bye := range singleByes {
+		if mirror := doubleByes[i+len(singleByes)]; mirror.TeamId != bye.TeamId || mirror.Round != bye.Round+int32(half) {
+			t.Errorf("Bye %+v mirrors as %+v", bye, mirror)
+		}
+	}
+}
+
+func TestAssignScheduleSlots(t *testing.T) {
+
+	/* a Saturday */
+	start := time.Date(2021, 9, 4, 0, 0, 0, 0, time.UTC)
+
+	slots := []scheduleSlot{
+		{weekday: time.Saturday, offset: 12 * time.Hour},
+		{weekday: time.Saturday, offset: 10 * time.Hour},
+	}
+
+	rounds, _ := roundRobin(scheduleTeams(6), false)
+
+	tests := []struct {
+		name      string
+		locations []int32
+		blackouts map[string]bool
+		times     []string
+	}{
+		{
+			/* three games a round fit into two slots at two locations */
+			name:      "one day a round",
+			locations: []int32{1, 2},
+			times:     []string{"2021-09-04T10:00:00Z", "2021-09-04T10:00:00Z", "2021-09-04T12:00:00Z", "2021-09-11T10:00:00Z"},
+		},
+		{
+			name:      "blackouts skipped",
+			locations: []int32{1, 2},
+			blackouts: map[string]bool{"2021-09-04": true, "2021-09-18": true},
+			times:     []string{"2021-09-11T10:00:00Z", "2021-09-11T10:00:00Z", "2021-09-11T12:00:00Z", "2021-09-25T10:00:00Z"},
+		},
+		{
+			/* a round that overflows carries on to the next game day, and the next round waits for it */
+			name:      "round spread over days",
+			locations: []int32{1},
+			times:     []string{"2021-09-04T10:00:00Z", "2021-09-04T12:00:00Z", "2021-09-11T10:00:00Z", "2021-09-18T10:00:00Z"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			games, err := assignScheduleSlots(rounds, start, slots, test.locations, test.blackouts)
+
+			if err != nil {
+				t.Fatalf("Error assigning slots: %v", err)
+			}
+
+			if len(games) != 15 {
+				t.Fatalf("Got %v games, want 15", len(games))
+			}
+
+			for i, want := range test.times {
+				if games[i].GameTime != want {
+					t.Errorf("Game %v is at %v, want %v", i, games[i].GameTime, want)
+				}
+			}
+
+			for _, game := range games {
+
+				gameTime, _ := time.Parse(time.RFC3339, game.GameTime)
+
+				if test.blackouts[gameTime.Format(scheduleDateLayout)] {
+					t.Errorf("Game %+v is on a blackout date", game)
+				}
+			}
+		})
+	}
+}
+
+func TestAssignScheduleSlotsTooLong(t *testing.T) {
+
+	start := time.Date(2021, 9, 4, 0, 0, 0, 0, time.UTC)
+	blackouts := make(map[string]bool)
+
+	/* every Saturday blacked out but the first */
+	for day := start.AddDate(0, 0, 7); day.Sub(start) <= (maxScheduleDays+7)*24*time.Hour; day = day.AddDate(0, 0, 7) {
+		blackouts[day.Format(scheduleDateLayout)] = true
+	}
+
+	rounds, _ := roundRobin(scheduleTeams(4), false)
+
+	_, err := assignScheduleSlots(rounds, start, []scheduleSlot{{weekday: time.Saturday, offset: 10 * time.Hour}}, []int32{1}, blackouts)
+
+	if err == nil {
+		t.Fatalf("A schedule that can't fit was assigned")
+	}
+
+	if violations := fieldViolationsOf(err); len(violations) != 1 || violations[0] != "Slots" {
+		t.Errorf("Got error %v, want a violation on Slots", err)
+	}
+}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+/* what a write touches, for checking it against the caller's roles */
+type Scope struct {
+	LeagueId      int32
+	CompetitionId int32
+	TeamIds       []int32
+}
+
+func (database *HeroBallDatabase) CompetitionScope(ctx context.Context, competitionId int32) (Scope, error) {
+
+	scope := Scope{
+		CompetitionId: competitionId,
+	}
+
+	err := database.db.QueryRowContext(ctx, `
+		SELECT
+			LeagueId
+		FROM
+			Competitions
+		WHERE
+			CompetitionId = $1`,
+		competitionId).Scan(&scope.LeagueId)
+
+	if err == sql.ErrNoRows {
+		return scope, notFound("competitionId", competitionId)
+	}
+
+	if err != nil {
+		return scope, fmt.Errorf("Error getting competition scope: %w", err)
+	}
+
+	return scope, nil
+}
+
+/* the game's competition and both its teams */
+func (database *HeroBallDatabase) GameScope(ctx context.Context, gameId int32) (Scope, error) {
+	return database.getGameScope(ctx, "Games.GameId = $1", "gameId", gameId)
+}
+
+func (database *HeroBallDatabase) EventScope(ctx context.Context, eventId int32) (Scope, error) {
+	return database.getGameScope(ctx, "Games.GameId = (SELECT GameId FROM GameEvents WHERE EventId = $1)", "eventId", eventId)
+}
+
+/* only the team that made the substitution */
+func (database *HeroBallDatabase) SubstitutionScope(ctx context.Context, substitutionId int32) (Scope, error) {
+
+	scope, err := database.getGameScope(ctx, "Games.GameId = (SELECT GameId FROM GameSubstitutions WHERE SubstitutionId = $1)", "substitutionId", substitutionId)
+
+	if err != nil {
+		return scope, err
+	}
+
+	var teamId int32
+
+	err = database.db.QueryRowContext(ctx, `
+		SELECT
+			TeamId
+		FROM
+			GameSubstitutions
+		WHERE
+			SubstitutionId = $1`,
+		substitutionId).Scan(&teamId)
+
+	if err == sql.ErrNoRows {
+		return scope, notFound("substitutionId", substitutionId)
+	}
+
+	if err != nil {
+		return scope, fmt.Errorf("Error getting substitution scope: %w", err)
+	}
+
+	scope.TeamIds = []int32{teamId}
+
+	return scope, nil
+}
+
+/* resourceType and id are what the caller asked for, reported when the game can't be found */
+func (database *HeroBallDatabase) getGameScope(ctx context.Context, condition string, resourceType string, id int32) (Scope, error) {
+
+	scope := Scope{}
+	var homeTeamId, awayTeamId int32
+
+	err := database.db.QueryRowContext(ctx, `
+		SELECT
+			Competitions.LeagueId,
+			Games.CompetitionId,
+			Games.HomeTeamId,
+			Games.AwayTeamId
+		FROM
+			Games
+		JOIN
+			Competitions ON Games.CompetitionId = Competitions.CompetitionId
+		WHERE
+			`+condition,
+		id).Scan(&scope.LeagueId, &scope.CompetitionId, &homeTeamId, &awayTeamId)
+
+	if err == sql.ErrNoRows {
+		return scope, notFound(resourceType, id)
+	}
+
+	if err != nil {
+		return scope, fmt.Errorf("Error getting game scope: %w", err)
+	}
+
+	scope.TeamIds = []int32{homeTeamId, awayTeamId}
+
+	return scope, nil
+}
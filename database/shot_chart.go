@@ -0,0 +1,295 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/lib/pq"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	courtHalfWidth  = 25
+	courtLength     = 94
+	basketY         = 5.25
+	restrictedArc   = 4
+	paintHalfWidth  = 8
+	paintLength     = 19
+	cornerThreeX    = 22
+	cornerThreeTopY = 14
+)
+
+type shotZone struct {
+	name   string
+	points int32
+}
+
+/* the values of the shotzone enum, closest to the basket first */
+var shotZones = []shotZone{
+	{name: "restricted-area", points: 2},
+	{name: "paint", points: 2},
+	{name: "mid-range", points: 2},
+	{name: "corner-three", points: 3},
+	{name: "above-the-break-three", points: 3},
+}
+
+/* the field goal attempt events and what they're worth */
+var shotEventPoints = map[string]int32{
+	"two-point-made":     2,
+	"two-point-missed":   2,
+	"three-point-made":   3,
+	"three-point-missed": 3,
+}
+
+/* where attempts without a location are counted */
+var unlocatedShotZones = map[int32]string{
+	2: "two-point-unlocated",
+	3: "three-point-unlocated",
+}
+
+func getShotZonePoints(zone string) (int32, bool) {
+
+	for _, shotZone := range shotZones {
+		if shotZone.name == zone {
+			return shotZone.points, true
+		}
+	}
+
+	return 0, false
+}
+
+/* the zone a shot worth points was taken from, the value of the shot decides which side of the arc it's on */
+func courtShotZone(x float64, y float64, points int32) string {
+
+	if points == 3 {
+		if math.Abs(x) >= cornerThreeX && y <= cornerThreeTopY {
+			return "corner-three"
+		}
+		return "above-the-break-three"
+	}
+
+	switch {
+	case math.Hypot(x, y-basketY) <= restrictedArc:
+		return "restricted-area"
+	case math.Abs(x) <= paintHalfWidth && y <= paintLength:
+		return "paint"
+	}
+
+	return "mid-range"
+}
+
+/* checks an event's shot location, returning its zone or empty for an event without one */
+func validateShotLocation(violations *fieldViolations, event *pb.GameEvent) string {
+
+	located := event.GetShotX() != 0 || event.GetShotY() != 0
+
+	if !located && event.GetShotZone() == "" {
+		return ""
+	}
+
+	points, isShot := shotEventPoints[event.GetEventType()]
+
+	if !isShot {
+		violations.add("Event.ShotZone", "Only field goal attempts have a location")
+		return ""
+	}
+
+	if located && math.Abs(event.GetShotX()) > courtHalfWidth {
+		violations.add("Event.ShotX", "Must be from -%v to %v", courtHalfWidth, courtHalfWidth)
+	}
+
+	if located && (event.GetShotY() < 0 || event.GetShotY() > courtLength) {
+		violations.add("Event.ShotY", "Must be from 0 to %v", courtLength)
+	}
+
+	if event.GetShotZone() == "" {
+		return courtShotZone(event.GetShotX(), event.GetShotY(), points)
+	}
+
+	zonePoints, found := getShotZonePoints(event.GetShotZone())
+
+	if !found {
+		violations.add("Event.ShotZone", "Unrecognised shot zone: %q", event.GetShotZone())
+		return ""
+	}
+
+	if zonePoints != points {
+		violations.add("Event.ShotZone", "A %v is not taken from %v", event.GetEventType(), event.GetShotZone())
+	}
+
+	return event.GetShotZone()
+}
+
+/* a player's or team's field goals by where they were taken */
+func (database *HeroBallDatabase) GetShotChart(ctx context.Context, request *pb.GetShotChartRequest) (*pb.ShotChart, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetPlayerId() < 0 {
+		violations.add("PlayerId", "Must be zero or greater")
+	}
+
+	if request.GetTeamId() < 0 {
+		violations.add("TeamId", "Must be zero or greater")
+	}
+
+	if (request.GetPlayerId() == 0) == (request.GetTeamId() == 0) {
+		violations.add("PlayerId", "Must give either a PlayerId or a TeamId")
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	phaseCondition, err := getPhaseCondition(request.GetPhase())
+
+	if err != nil {
+		return nil, err
+	}
+
+	chart := &pb.ShotChart{
+		Zones: make([]*pb.ShotZoneStats, 0, len(shotZones)+len(unlocatedShotZones)),
+		Shots: make([]*pb.Shot, 0),
+	}
+
+	if request.GetPlayerId() != 0 {
+		chart.Player, err = database.getPlayerById(ctx, request.GetPlayerId())
+	} else {
+		chart.Team, err = database.getTeamById(ctx, request.GetTeamId())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	condition := `(($1 <> 0 AND PlayerGameStats.PlayerId = $1) OR ($2 <> 0 AND PlayerGameStats.TeamId = $2)) AND
+			(cardinality($3::int[]) IS NULL OR Games.CompetitionId = ANY($3)) AND
+			(cardinality($4::int[]) IS NULL OR ` + opponentTeamKey + ` = ANY($4)) AND
+			` + countedGamesCondition + phaseCondition
+
+	args := []interface{}{
+		request.GetPlayerId(),
+		request.GetTeamId(),
+		pq.Array(request.GetCompetitionIds()),
+		pq.Array(request.GetOpponentTeamIds())}
+
+	/* the totals the zones have to add up to */
+	var twoPointFGM, twoPointFGA, threePointFGM, threePointFGA int32
+
+	err = database.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(DISTINCT PlayerGameStats.GameId),
+			COALESCE(SUM(PlayerGameStats.TwoPointFGM), 0),
+			COALESCE(SUM(PlayerGameStats.TwoPointFGA), 0),
+			COALESCE(SUM(PlayerGameStats.ThreePointFGM), 0),
+			COALESCE(SUM(PlayerGameStats.ThreePointFGA), 0)
+		FROM
+			PlayerGameStats
+		JOIN
+			Games ON PlayerGameStats.GameId = Games.GameId
+		WHERE
+			`+condition,
+		args...).Scan(&chart.GameCount, &twoPointFGM, &twoPointFGA, &threePointFGM, &threePointFGA)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting field goal totals: %w", err)
+	}
+
+	/* the events of players with events make up their lines, so these are part of the totals */
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			GameEvents.GameId,
+			GameEvents.EventType,
+			COALESCE(GameEvents.ShotX, 0),
+			COALESCE(GameEvents.ShotY, 0),
+			GameEvents.ShotZone
+		FROM
+			GameEvents
+		JOIN
+			PlayerGameStats ON GameEvents.GameId = PlayerGameStats.GameId AND GameEvents.PlayerId = PlayerGameStats.PlayerId
+		JOIN
+			Games ON PlayerGameStats.GameId = Games.GameId
+		WHERE
+			GameEvents.ShotZone IS NOT NULL AND
+			`+condition+`
+		ORDER BY
+			Games.GameTime ASC,
+			GameEvents.Period ASC,
+			GameEvents.ClockSeconds DESC,
+			GameEvents.EventId ASC`,
+		args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting shots: %w", err)
+	}
+
+	defer rows.Close()
+
+	zoneStats := make(map[string]*pb.ShotZoneStats)
+
+	for _, zone := range shotZones {
+		zoneStats[zone.name] = &pb.ShotZoneStats{Zone: zone.name, Points: zone.points}
+		chart.Zones = append(chart.Zones, zoneStats[zone.name])
+	}
+
+	for _, points := range []int32{2, 3} {
+		zoneStats[unlocatedShotZones[points]] = &pb.ShotZoneStats{Zone: unlocatedShotZones[points], Points: points}
+		chart.Zones = append(chart.Zones, zoneStats[unlocatedShotZones[points]])
+	}
+
+	for rows.Next() {
+
+		shot := &pb.Shot{}
+		var eventType string
+
+		err = rows.Scan(&shot.GameId, &eventType, &shot.ShotX, &shot.ShotY, &shot.ShotZone)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning shot: %w", err)
+		}
+
+		shot.Made = eventType == "two-point-made" || eventType == "three-point-made"
+
+		stats := zoneStats[shot.ShotZone]
+
+		if stats == nil {
+			return nil, fmt.Errorf("Error counting shot: unknown zone %v", shot.ShotZone)
+		}
+
+		stats.Attempted++
+
+		if shot.Made {
+			stats.Made++
+		}
+
+		chart.Shots = append(chart.Shots, shot)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	/* whatever the located shots don't account for wasn't located */
+	unlocatedTwos, unlocatedThrees := zoneStats[unlocatedShotZones[2]], zoneStats[unlocatedShotZones[3]]
+
+	unlocatedTwos.Made, unlocatedTwos.Attempted = twoPointFGM, twoPointFGA
+	unlocatedThrees.Made, unlocatedThrees.Attempted = threePointFGM, threePointFGA
+
+	for _, zone := range shotZones {
+
+		unlocated := zoneStats[unlocatedShotZones[zone.points]]
+
+		unlocated.Made -= zoneStats[zone.name].Made
+		unlocated.Attempted -= zoneStats[zone.name].Attempted
+	}
+
+	for _, stats := range chart.Zones {
+		stats.Percentage = ratio(float64(stats.Made), float64(stats.Attempted))
+	}
+
+	return chart, nil
+}
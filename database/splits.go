@@ -0,0 +1,168 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/lib/pq"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	/* no limit on the groups in a split */
+	splitsLimit = math.MaxInt32
+
+	/* 1 at home, 0 away */
+	homeSplitKey = "CASE WHEN PlayerGameStats.TeamId = Games.HomeTeamId THEN 1 ELSE 0 END"
+
+	/* 200601 for January 2006 */
+	monthSplitKey = "(EXTRACT(YEAR FROM Games.GameTime) * 100 + EXTRACT(MONTH FROM Games.GameTime))::int"
+)
+
+/* 1 for a win, -1 a loss and 0 a draw, for the player's team, from the same scores as the game's result */
+var resultSplitKey = "SIGN(" + teamPoints("PlayerGameStats.TeamId") + " - " +
+	teamPoints("CASE WHEN PlayerGameStats.TeamId = Games.HomeTeamId THEN Games.AwayTeamId ELSE Games.HomeTeamId END") + ")::int"
+
+/* a player's stats split by where, when, who against and how the game went */
+func (database *HeroBallDatabase) GetPlayerSplits(ctx context.Context, request *pb.GetPlayerSplitsRequest) (*pb.PlayerSplits, error) {
+
+	if request.GetPlayerId() <= 0 {
+		return nil, invalidArgument("PlayerId", "Must be greater than zero")
+	}
+
+	phaseCondition, err := getPhaseCondition(request.GetPhase())
+
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := database.getPlayerById(ctx, request.GetPlayerId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	splits := &pb.PlayerSplits{
+		Player:    player,
+		Months:    make([]*pb.MonthSplit, 0),
+		Locations: make([]*pb.LocationSplit, 0),
+		Opponents: make([]*pb.OpponentSplit, 0),
+	}
+
+	condition := `PlayerGameStats.PlayerId = $1 AND
+		(cardinality($2::int[]) IS NULL OR Games.CompetitionId = ANY($2))` + phaseCondition
+
+	args := []interface{}{
+		request.GetPlayerId(),
+		pq.Array(request.GetCompetitionIds())}
+
+	/* each split is grouped by its key, the first column */
+	split := func(key string, ordering string) ([]*pb.Stats, []int32, error) {
+		return database.getAggregateStatsByConditionAndGroupingAndOrderAndLimitAndOffset(ctx,
+			condition,
+			args,
+			"GROUP BY 1",
+			key,
+			"",
+			nil,
+			ordering,
+			splitsLimit,
+			0)
+	}
+
+	stats, keys, err := split(homeSplitKey, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		if key == 1 {
+			splits.Home = stats[i]
+		} else {
+			splits.Away = stats[i]
+		}
+	}
+
+	stats, keys, err = split(resultSplitKey, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		switch key {
+		case 1:
+			splits.Wins = stats[i]
+		case -1:
+			splits.Losses = stats[i]
+		default:
+			splits.Draws = stats[i]
+		}
+	}
+
+	stats, keys, err = split(monthSplitKey, "ORDER BY 1 ASC")
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		splits.Months = append(splits.Months, &pb.MonthSplit{
+			Month: fmt.Sprintf("%04d-%02d", key/100, key%100),
+			Stats: stats[i],
+		})
+	}
+
+	stats, keys, err = split("Games.LocationId", "ORDER BY 2 DESC, 1 ASC")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) > 0 {
+
+		locations, err := database.getLocations(ctx, keys)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, key := range keys {
+			for _, location := range locations {
+				if location.GetLocationId() == key {
+					splits.Locations = append(splits.Locations, &pb.LocationSplit{
+						Location: location,
+						Stats:    stats[i],
+					})
+				}
+			}
+		}
+	}
+
+	stats, keys, err = split(opponentTeamKey, "ORDER BY 2 DESC, 1 ASC")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) > 0 {
+
+		opponents, err := database.getTeamsById(ctx, keys)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, key := range keys {
+			splits.Opponents = append(splits.Opponents, &pb.OpponentSplit{
+				Opponent: findTeam(opponents, key),
+				Stats:    stats[i],
+			})
+		}
+	}
+
+	return splits, nil
+}
@@ -0,0 +1,462 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/lib/pq"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+/* results shown in a team's form */
+const standingsFormLength = 5
+
+var standingsTiebreakers = map[string]bool{
+	"head-to-head":        true,
+	"points-differential": true,
+	"points-for":          true,
+	"points-percentage":   true,
+}
+
+/* a regular season game that counts towards the standings */
+type standingsGame struct {
+	homeTeamId    int32
+	awayTeamId    int32
+	homePoints    int32
+	awayPoints    int32
+	forfeitTeamId int32
+}
+
+/* ladder points the team earned from the game, which it played in */
+func (game standingsGame) ladderPoints(teamId int32, rules *pb.StandingsRules) int32 {
+
+	points, against := game.homePoints, game.awayPoints
+
+	if teamId == game.awayTeamId {
+		points, against = against, points
+	}
+
+	switch {
+	case points > against:
+		return rules.GetWinPoints()
+	case points == against:
+		return rules.GetDrawPoints()
+	case game.forfeitTeamId == teamId:
+		return rules.GetForfeitPoints()
+	}
+
+	return rules.GetLossPoints()
+}
+
+/*
+ * the competition's teams in standings order. Everything comes from
+ * GameScoresView, so the standings can be a refresh behind the latest result
+ */
+func (database *HeroBallDatabase) getStandingsForCompetition(ctx context.Context, competitionId int32) ([]*pb.CompetitionTeam, error) {
+
+	if competitionId <= 0 {
+		return nil, invalidArgument("CompetitionId", "Must be greater than zero")
+	}
+
+	rules, err := database.getStandingsRules(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	teamIds, err := database.getCompetitionTeams(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := database.getTeamsById(ctx, teamIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.db.QueryContext(ctx, `
+		SELECT
+			Games.HomeTeamId,
+			Games.AwayTeamId,
+			GameScoresView.HomeTeamPoints,
+			GameScoresView.AwayTeamPoints,
+			COALESCE(Games.ForfeitTeamId, 0)
+		FROM
+			Games
+		JOIN
+			GameScoresView ON Games.GameId = GameScoresView.GameId
+		WHERE
+			Games.CompetitionId = $1 AND
+			GameScoresView.SeriesId IS NULL AND
+			GameScoresView.Status IN ('final', 'forfeit')
+		ORDER BY
+			Games.GameTime ASC,
+			Games.GameId ASC`,
+		competitionId)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting standings games: %w", err)
+	}
+
+	defer rows.Close()
+
+	games := make([]standingsGame, 0)
+
+	for rows.Next() {
+
+		var game standingsGame
+
+		err = rows.Scan(&game.homeTeamId, &game.awayTeamId, &game.homePoints, &game.awayPoints, &game.forfeitTeamId)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error scanning standings game: %w", err)
+		}
+
+		games = append(games, game)
+	}
+
+	err = rows.Err()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error following scan: %w", err)
+	}
+
+	return computeStandings(teams, games, rules), nil
+}
+
+/* tallies each team's record from the games, oldest first, and orders them by the rules */
+func computeStandings(teams []*pb.Team, games []standingsGame, rules *pb.StandingsRules) []*pb.CompetitionTeam {
+
+	standings := make([]*pb.CompetitionTeam, 0, len(teams))
+	byTeam := make(map[int32]*pb.CompetitionTeam)
+	results := make(map[int32]string)
+
+	for _, team := range teams {
+
+		standing := &pb.CompetitionTeam{
+			Team: team,
+		}
+
+		standings = append(standings, standing)
+		byTeam[team.GetTeamId()] = standing
+	}
+
+	for _, game := range games {
+		for _, teamId := range []int32{game.homeTeamId, game.awayTeamId} {
+
+			standing, found := byTeam[teamId]
+
+			/* every team that has played is entered, but just in case */
+			if !found {
+				continue
+			}
+
+			points, against := game.homePoints, game.awayPoints
+
+			if teamId == game.awayTeamId {
+				points, against = against, points
+			}
+
+			standing.Played++
+			standing.PointsFor += points
+			standing.PointsAgainst += against
+			standing.LadderPoints += game.ladderPoints(teamId, rules)
+
+			switch {
+			case points > against:
+				standing.Won++
+				results[teamId] += "W"
+			case points == against:
+				standing.Drawn++
+				results[teamId] += "D"
+			default:
+				standing.Lost++
+				results[teamId] += "L"
+
+				if game.forfeitTeamId == teamId {
+					standing.Forfeited++
+				}
+			}
+		}
+	}
+
+	for _, standing := range standings {
+
+		result := results[standing.GetTeam().GetTeamId()]
+
+		standing.PointsDifferential = standing.PointsFor - standing.PointsAgainst
+		standing.PointsPercentage = ratio(float64(standing.PointsFor), float64(standing.PointsAgainst))
+		standing.WinPercentage = ratio(float64(standing.Won)+0.5*float64(standing.Drawn), float64(standing.Played))
+
+		if len(result) > 0 {
+			last := result[len(result)-1:]
+			run := len(result) - len(strings.TrimRight(result, last))
+			standing.Streak = fmt.Sprintf("%v%v", last, run)
+		}
+
+		if len(result) > standingsFormLength {
+			result = result[len(result)-standingsFormLength:]
+		}
+
+		standing.Form = result
+	}
+
+	ladderPoints := func(standing *pb.CompetitionTeam) float64 {
+		return float64(standing.GetLadderPoints())
+	}
+
+	sortStandings(standings, ladderPoints)
+
+	ordered := make([]*pb.CompetitionTeam, 0, len(standings))
+
+	for _, level := range splitStandings(standings, ladderPoints) {
+		ordered = append(ordered, breakTies(level, games, rules, 0)...)
+	}
+
+	return ordered
+}
+
+/*
+ * orders teams that are level, by the tiebreaker at index and then the ones
+ * after it. Once a tiebreaker separates the group any teams still level start
+ * over from the first one, as head-to-head only counts their games against
+ * each other. Teams level on everything are listed by name
+ */
+func breakTies(level []*pb.CompetitionTeam, games []standingsGame, rules *pb.StandingsRules, index int) []*pb.CompetitionTeam {
+
+	if len(level) < 2 {
+		return level
+	}
+
+	if index >= len(rules.GetTiebreakers()) {
+
+		sort.SliceStable(level, func(i, j int) bool {
+			return level[i].GetTeam().GetName() < level[j].GetTeam().GetName()
+		})
+
+		return level
+	}
+
+	key := tiebreakerKey(rules.GetTiebreakers()[index], level, games, rules)
+
+	sortStandings(level, key)
+	groups := splitStandings(level, key)
+
+	if len(groups) == 1 {
+		return breakTies(level, games, rules, index+1)
+	}
+
+	ordered := make([]*pb.CompetitionTeam, 0, len(level))
+
+	for _, group := range groups {
+		ordered = append(ordered, breakTies(group, games, rules, 0)...)
+	}
+
+	return ordered
+}
+
+/* what the teams are compared on for the tiebreaker, higher is better */
+func tiebreakerKey(tiebreaker string, level []*pb.CompetitionTeam, games []standingsGame, rules *pb.StandingsRules) func(*pb.CompetitionTeam) float64 {
+
+	switch tiebreaker {
+	case "head-to-head":
+
+		inLevel := make(map[int32]bool)
+
+		for _, standing := range level {
+			inLevel[standing.GetTeam().GetTeamId()] = true
+		}
+
+		headToHead := make(map[int32]int32)
+
+		for _, game := range games {
+			if inLevel[game.homeTeamId] && inLevel[game.awayTeamId] {
+				headToHead[game.homeTeamId] += game.ladderPoints(game.homeTeamId, rules)
+				headToHead[game.awayTeamId] += game.ladderPoints(game.awayTeamId, rules)
+			}
+		}
+
+		return func(standing *pb.CompetitionTeam) float64 {
+			return float64(headToHead[standing.GetTeam().GetTeamId()])
+		}
+
+	case "points-differential":
+		return func(standing *pb.CompetitionTeam) float64 {
+			return float64(standing.GetPointsDifferential())
+		}
+
+	case "points-percentage":
+		return func(standing *pb.CompetitionTeam) float64 {
+
+			/* scoring without conceding beats any percentage */
+			if standing.GetPointsAgainst() == 0 && standing.GetPointsFor() > 0 {
+				return math.Inf(1)
+			}
+
+			return standing.GetPointsPercentage()
+		}
+
+	case "points-for":
+		return func(standing *pb.CompetitionTeam) float64 {
+			return float64(standing.GetPointsFor())
+		}
+	}
+
+	/* validated when the rules are saved */
+	return func(*pb.CompetitionTeam) float64 {
+		return 0
+	}
+}
+
+func sortStandings(standings []*pb.CompetitionTeam, key func(*pb.CompetitionTeam) float64) {
+	sort.SliceStable(standings, func(i, j int) bool {
+		return key(standings[i]) > key(standings[j])
+	})
+}
+
+/* the sorted standings in runs of teams with the same key */
+func splitStandings(standings []*pb.CompetitionTeam, key func(*pb.CompetitionTeam) float64) [][]*pb.CompetitionTeam {
+
+	groups := make([][]*pb.CompetitionTeam, 0)
+
+	for i, standing := range standings {
+
+		if i == 0 || key(standing) != key(standings[i-1]) {
+			groups = append(groups, make([]*pb.CompetitionTeam, 0))
+		}
+
+		groups[len(groups)-1] = append(groups[len(groups)-1], standing)
+	}
+
+	return groups
+}
+
+func (database *HeroBallDatabase) getStandingsRules(ctx context.Context, competitionId int32) (*pb.StandingsRules, error) {
+
+	rules := &pb.StandingsRules{}
+
+	err := database.db.QueryRowContext(ctx, `
+		SELECT
+			WinPoints,
+			DrawPoints,
+			LossPoints,
+			ForfeitPoints,
+			Tiebreakers
+		FROM
+			Competitions
+		WHERE
+			CompetitionId = $1`,
+		competitionId).Scan(
+		&rules.WinPoints,
+		&rules.DrawPoints,
+		&rules.LossPoints,
+		&rules.ForfeitPoints,
+		pq.Array(&rules.Tiebreakers))
+
+	if err == sql.ErrNoRows {
+		return nil, notFound("competitionId", competitionId)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting standings rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (database *HeroBallDatabase) UpdateStandingsRules(ctx context.Context, request *pb.UpdateStandingsRulesRequest) (*pb.UpdateStandingsRulesResponse, error) {
+
+	violations := fieldViolations{}
+
+	if request.GetCompetitionId() <= 0 {
+		violations.add("CompetitionId", "Must be greater than zero")
+	}
+
+	rules := request.GetRules()
+
+	if rules == nil {
+		violations.add("Rules", "Must be given")
+	}
+
+	if rules.GetWinPoints() <= rules.GetLossPoints() {
+		violations.add("Rules.WinPoints", "Must be more than LossPoints")
+	}
+
+	if rules.GetDrawPoints() < rules.GetLossPoints() || rules.GetDrawPoints() > rules.GetWinPoints() {
+		violations.add("Rules.DrawPoints", "Must be from LossPoints to WinPoints")
+	}
+
+	if rules.GetForfeitPoints() > rules.GetLossPoints() {
+		violations.add("Rules.ForfeitPoints", "Must not be more than LossPoints")
+	}
+
+	seen := make(map[string]bool)
+
+	for i, tiebreaker := range rules.GetTiebreakers() {
+
+		if !standingsTiebreakers[tiebreaker] {
+			violations.add(fmt.Sprintf("Rules.Tiebreakers[%v]", i), "Unrecognised tiebreaker: %q", tiebreaker)
+		} else if seen[tiebreaker] {
+			violations.add(fmt.Sprintf("Rules.Tiebreakers[%v]", i), "Duplicate tiebreaker %q", tiebreaker)
+		}
+
+		seen[tiebreaker] = true
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	/* no tiebreakers orders level teams by name */
+	tiebreakers := rules.GetTiebreakers()
+
+	if tiebreakers == nil {
+		tiebreakers = []string{}
+	}
+
+	result, err := database.db.ExecContext(ctx, `
+		UPDATE
+			Competitions
+		SET
+			WinPoints = $2,
+			DrawPoints = $3,
+			LossPoints = $4,
+			ForfeitPoints = $5,
+			Tiebreakers = $6
+		WHERE
+			CompetitionId = $1`,
+		request.GetCompetitionId(),
+		rules.GetWinPoints(),
+		rules.GetDrawPoints(),
+		rules.GetLossPoints(),
+		rules.GetForfeitPoints(),
+		pq.Array(tiebreakers))
+
+	if err != nil {
+		return nil, writeError("Error updating standings rules", err)
+	}
+
+	updated, err := result.RowsAffected()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting updated competition count: %w", err)
+	}
+
+	if updated == 0 {
+		return nil, notFound("competitionId", request.GetCompetitionId())
+	}
+
+	log.Printf("Competition %v standings rules are now %+v", request.GetCompetitionId(), rules)
+
+	return &pb.UpdateStandingsRulesResponse{
+		CompetitionId: request.GetCompetitionId(),
+	}, nil
+}
@@ -0,0 +1,187 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+func standingsTestTeams(names ...string) []*pb.Team {
+
+	teams := make([]*pb.Team, 0)
+
+	for i, name := range names {
+		teams = append(teams, &pb.Team{TeamId: int32(i + 1), Name: name})
+	}
+
+	return teams
+}
+
+func standingsTestRules(tiebreakers ...string) *pb.StandingsRules {
+	return &pb.StandingsRules{
+		WinPoints:     2,
+		DrawPoints:    1,
+		LossPoints:    0,
+		ForfeitPoints: -1,
+		Tiebreakers:   tiebreakers,
+	}
+}
+
+func standingsOrder(standings []*pb.CompetitionTeam) []string {
+
+	names := make([]string, 0)
+
+	for _, standing := range standings {
+		names = append(names, standing.GetTeam().GetName())
+	}
+
+	return names
+}
+
+/*
+ * A, B and C are level on 4 points. C has the most points in games between
+ * the three so goes top, which leaves A and B level on head-to-head among the
+ * three. They start again from head-to-head, now only their game counts and A
+ * won it. B's far better points differential would put it above A if the
+ * tiebreakers carried on instead
+ */
+func threeWayTieGames() []standingsGame {
+
+	const a, b, c, d = 1, 2, 3, 4
+
+	return []standingsGame{
+		{homeTeamId: a, awayTeamId: b, homePoints: 60, awayPoints: 58},
+		{homeTeamId: c, awayTeamId: a, homePoints: 70, awayPoints: 60},
+		{homeTeamId: b, awayTeamId: c, homePoints: 65, awayPoints: 60},
+		{homeTeamId: c, awayTeamId: b, homePoints: 62, awayPoints: 60},
+		{homeTeamId: a, awayTeamId: d, homePoints: 51, awayPoints: 50},
+		{homeTeamId: d, awayTeamId: b, homePoints: 40, awayPoints: 90},
+	}
+}
+
+func TestComputeStandingsTiebreakers(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		rules *pb.StandingsRules
+		order []string
+	}{
+		{
+			name:  "head-to-head starts over for the remaining two",
+			rules: standingsTestRules("head-to-head", "points-differential", "points-for"),
+			order: []string{"C", "A", "B", "D"},
+		},
+		{
+			name:  "points differential first",
+			rules: standingsTestRules("points-differential", "head-to-head"),
+			order: []string{"B", "C", "A", "D"},
+		},
+		{
+			name:  "points for",
+			rules: standingsTestRules("points-for", "head-to-head"),
+			order: []string{"B", "C", "A", "D"},
+		},
+		{
+			name:  "no tiebreakers, by name",
+			rules: standingsTestRules(),
+			order: []string{"A", "B", "C", "D"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			standings := computeStandings(standingsTestTeams("A", "B", "C", "D"), threeWayTieGames(), test.rules)
+
+			if order := standingsOrder(standings); !reflect.DeepEqual(order, test.order) {
+				t.Errorf("Got %v, want %v", order, test.order)
+			}
+
+			for _, standing := range standings[:3] {
+				if standing.GetLadderPoints() != 4 {
+					t.Errorf("%v has %v ladder points, want 4", standing.GetTeam().GetName(), standing.GetLadderPoints())
+				}
+			}
+		})
+	}
+}
+
+/* level on everything, including their games against each other */
+func TestComputeStandingsLevelOnEverything(t *testing.T) {
+
+	games := []standingsGame{
+		{homeTeamId: 1, awayTeamId: 2, homePoints: 50, awayPoints: 40},
+		{homeTeamId: 2, awayTeamId: 1, homePoints: 50, awayPoints: 40},
+	}
+
+	standings := computeStandings(standingsTestTeams("Zebras", "Ants"), games, standingsTestRules("head-to-head", "points-differential", "points-for", "points-percentage"))
+
+	if order := standingsOrder(standings); !reflect.DeepEqual(order, []string{"Ants", "Zebras"}) {
+		t.Errorf("Got %v, want them by name", order)
+	}
+}
+
+func TestComputeStandingsRecord(t *testing.T) {
+
+	const team, other = 1, 2
+
+	/* oldest first: W W L(forfeit) D W W W */
+	games := []standingsGame{
+		{homeTeamId: team, awayTeamId: other, homePoints: 50, awayPoints: 40},
+		{homeTeamId: other, awayTeamId: team, homePoints: 40, awayPoints: 50},
+		{homeTeamId: team, awayTeamId: other, homePoints: 0, awayPoints: 20, forfeitTeamId: team},
+		{homeTeamId: other, awayTeamId: team, homePoints: 45, awayPoints: 45},
+		{homeTeamId: team, awayTeamId: other, homePoints: 50, awayPoints: 40},
+		{homeTeamId: team, awayTeamId: other, homePoints: 50, awayPoints: 40},
+		{homeTeamId: other, awayTeamId: team, homePoints: 40, awayPoints: 50},
+	}
+
+	standings := computeStandings(standingsTestTeams("Team", "Other", "Idle"), games, standingsTestRules())
+
+	byName := make(map[string]*pb.CompetitionTeam)
+
+	for _, standing := range standings {
+		byName[standing.GetTeam().GetName()] = standing
+	}
+
+	tests := []struct {
+		name      string
+		played    int32
+		record    [4]int32
+		ladder    int32
+		streak    string
+		form      string
+		winPct    float64
+		pointsFor int32
+	}{
+		/* the forfeit costs a point rather than scoring none */
+		{"Team", 7, [4]int32{5, 1, 1, 1}, 10 + 1 - 1, "W3", "LDWWW", 5.5 / 7, 295},
+		{"Other", 7, [4]int32{1, 1, 5, 0}, 2 + 1, "L3", "WDLLL", 1.5 / 7, 265},
+		{"Idle", 0, [4]int32{0, 0, 0, 0}, 0, "", "", 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			standing := byName[test.name]
+			record := [4]int32{standing.GetWon(), standing.GetDrawn(), standing.GetLost(), standing.GetForfeited()}
+
+			if standing.GetPlayed() != test.played || record != test.record {
+				t.Errorf("Played %v with won, drawn, lost and forfeited %v, want %v and %v", standing.GetPlayed(), record, test.played, test.record)
+			}
+
+			if standing.GetLadderPoints() != test.ladder {
+				t.Errorf("Got %v ladder points, want %v", standing.GetLadderPoints(), test.ladder)
+			}
+
+			if standing.GetStreak() != test.streak || standing.GetForm() != test.form {
+				t.Errorf("Got streak %q and form %q, want %q and %q", standing.GetStreak(), standing.GetForm(), test.streak, test.form)
+			}
+
+			if standing.GetWinPercentage() != test.winPct || standing.GetPointsFor() != test.pointsFor {
+				t.Errorf("Got win percentage %v and points for %v, want %v and %v", standing.GetWinPercentage(), standing.GetPointsFor(), test.winPct, test.pointsFor)
+			}
+		})
+	}
+}
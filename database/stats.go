@@ -0,0 +1,261 @@
+package database
+
+import (
+	"fmt"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+const (
+	per36Minutes = 36.0
+	/* weight of a free throw attempt in a true shooting attempt */
+	trueShootingFreeThrowWeight = 0.44
+)
+
+/* maps an ordering key onto an ORDER BY over the PlayerGameStats aggregate */
+func getStatsOrdering(ordering string) (string, error) {
+
+	switch ordering {
+	case "":
+		return "", nil
+	case "PPG":
+		return `
+			ORDER BY
+				(COALESCE(SUM(PlayerGameStats.ThreePointFGM)*3, 0)::float +
+				COALESCE(SUM(PlayerGameStats.TwoPointFGM)*2, 0)::float +
+				COALESCE(SUM(PlayerGameStats.FreeThrowsMade), 0))::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "RPG":
+		return `
+			ORDER BY
+				(COALESCE(SUM(PlayerGameStats.OffensiveRebounds), 0)::float +
+				COALESCE(SUM(PlayerGameStats.DefensiveRebounds), 0))::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "ORPG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.OffensiveRebounds), 0)::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "DRPG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.DefensiveRebounds), 0)::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "APG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.Assists), 0)::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "BPG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.Blocks), 0)::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "SPG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.Steals), 0)::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "2PFG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.TwoPointFGM), 0)::float / COALESCE(NULLIF(SUM(PlayerGameStats.TwoPointFGA), 0), 1)::float
+			DESC`, nil
+	case "3PFG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.ThreePointFGM), 0)::float / COALESCE(NULLIF(SUM(PlayerGameStats.ThreePointFGA), 0), 1)::float
+			DESC`, nil
+	case "MPG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.MinutesPlayed), 0)::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "TPG":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.Turnovers), 0)::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			DESC`, nil
+	case "FT":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.FreeThrowsMade), 0)::float / COALESCE(NULLIF(SUM(PlayerGameStats.FreeThrowsAttempted), 0), 1)::float
+			DESC`, nil
+	case "EFG":
+		return `
+			ORDER BY
+				(COALESCE(SUM(PlayerGameStats.TwoPointFGM), 0)::float +
+				COALESCE(SUM(PlayerGameStats.ThreePointFGM), 0)::float * 1.5) /
+				COALESCE(NULLIF(SUM(PlayerGameStats.TwoPointFGA) + SUM(PlayerGameStats.ThreePointFGA), 0), 1)::float
+			DESC`, nil
+	case "TS":
+		return fmt.Sprintf(`
+			ORDER BY
+				(COALESCE(SUM(PlayerGameStats.ThreePointFGM)*3, 0)::float +
+				COALESCE(SUM(PlayerGameStats.TwoPointFGM)*2, 0)::float +
+				COALESCE(SUM(PlayerGameStats.FreeThrowsMade), 0))::float /
+				COALESCE(NULLIF(2 * (SUM(PlayerGameStats.TwoPointFGA) + SUM(PlayerGameStats.ThreePointFGA) + %v * SUM(PlayerGameStats.FreeThrowsAttempted)), 0), 1)::float
+			DESC`, trueShootingFreeThrowWeight), nil
+	case "ASTTO":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.Assists), 0)::float / COALESCE(NULLIF(SUM(PlayerGameStats.Turnovers), 0), 1)::float
+			DESC`, nil
+	case "PPS":
+		return `
+			ORDER BY
+				(COALESCE(SUM(PlayerGameStats.ThreePointFGM)*3, 0)::float +
+				COALESCE(SUM(PlayerGameStats.TwoPointFGM)*2, 0)::float +
+				COALESCE(SUM(PlayerGameStats.FreeThrowsMade), 0))::float /
+				COALESCE(NULLIF(SUM(PlayerGameStats.TwoPointFGA) + SUM(PlayerGameStats.ThreePointFGA), 0), 1)::float
+			DESC`, nil
+	case "FTR":
+		return `
+			ORDER BY
+				COALESCE(SUM(PlayerGameStats.FreeThrowsAttempted), 0)::float /
+				COALESCE(NULLIF(SUM(PlayerGameStats.TwoPointFGA) + SUM(PlayerGameStats.ThreePointFGA), 0), 1)::float
+			DESC`, nil
+	case "PTS36":
+		return getPer36Ordering(`
+				COALESCE(SUM(PlayerGameStats.ThreePointFGM)*3, 0)::float +
+				COALESCE(SUM(PlayerGameStats.TwoPointFGM)*2, 0)::float +
+				COALESCE(SUM(PlayerGameStats.FreeThrowsMade), 0)::float`), nil
+	case "REB36":
+		return getPer36Ordering(`
+				COALESCE(SUM(PlayerGameStats.OffensiveRebounds), 0)::float +
+				COALESCE(SUM(PlayerGameStats.DefensiveRebounds), 0)::float`), nil
+	case "AST36":
+		return getPer36Ordering(`COALESCE(SUM(PlayerGameStats.Assists), 0)::float`), nil
+	case "STL36":
+		return getPer36Ordering(`COALESCE(SUM(PlayerGameStats.Steals), 0)::float`), nil
+	case "BLK36":
+		return getPer36Ordering(`COALESCE(SUM(PlayerGameStats.Blocks), 0)::float`), nil
+	case "TOV36":
+		return getPer36Ordering(`COALESCE(SUM(PlayerGameStats.Turnovers), 0)::float`), nil
+	}
+
+	return "", invalidArgument("Ordering", "Unrecognised ordering: %v", ordering)
+}
+
+/* the team on the other side of the game from a PlayerGameStats line */
+const opponentTeamKey = "CASE WHEN PlayerGameStats.TeamId = Games.HomeTeamId THEN Games.AwayTeamId ELSE Games.HomeTeamId END"
+
+/*
+ * filters the lines of teamKey's games, the args are the competitionIds,
+ * the against teamIds, the for teamIds and the for playerIds. teamKey is the team
+ * being aggregated, opponentKey is who they were playing
+ */
+func getTeamStatsCondition(teamKey string, opponentKey string) string {
+	return fmt.Sprintf(`(cardinality($1::int[]) IS NULL OR Games.CompetitionId = ANY($1)) AND
+		(cardinality($2::int[]) IS NULL OR %[2]v = ANY($2)) AND
+		(cardinality($3::int[]) IS NULL OR %[1]v = ANY($3)) AND
+		(cardinality($4::int[]) IS NULL OR EXISTS (
+			SELECT 1 FROM PlayerGameStats AS Featured
+			WHERE Featured.GameId = PlayerGameStats.GameId AND Featured.TeamId = %[1]v AND Featured.PlayerId = ANY($4)))`,
+		teamKey, opponentKey)
+}
+
+/* limits a stats query to regular season or playoff games, empty for both */
+func getPhaseCondition(phase string) (string, error) {
+
+	switch phase {
+	case "":
+		return "", nil
+	case "regular-season":
+		return " AND Games.SeriesId IS NULL", nil
+	case "playoffs":
+		return " AND Games.SeriesId IS NOT NULL", nil
+	}
+
+	return "", invalidArgument("Phase", "Unrecognised phase: %v", phase)
+}
+
+/* the team orderings that rank on what was allowed, these apply to the opponent aggregate */
+func getTeamOpponentOrdering(ordering string) (string, bool) {
+
+	switch ordering {
+	case "PAPG":
+		return `
+			ORDER BY
+				(COALESCE(SUM(PlayerGameStats.ThreePointFGM)*3, 0)::float +
+				COALESCE(SUM(PlayerGameStats.TwoPointFGM)*2, 0)::float +
+				COALESCE(SUM(PlayerGameStats.FreeThrowsMade), 0))::float / COUNT(DISTINCT PlayerGameStats.GameId)::float
+			ASC`, true
+	case "OPPFG":
+		return `
+			ORDER BY
+				(COALESCE(SUM(PlayerGameStats.TwoPointFGM), 0)::float +
+				COALESCE(SUM(PlayerGameStats.ThreePointFGM), 0)::float) /
+				NULLIF(SUM(PlayerGameStats.TwoPointFGA) + SUM(PlayerGameStats.ThreePointFGA), 0)::float
+			ASC NULLS LAST`, true
+	}
+
+	return "", false
+}
+
+/* those who have not played any minutes go last */
+func getPer36Ordering(total string) string {
+	return fmt.Sprintf(`
+			ORDER BY
+				(%v) * %v / NULLIF(SUM(PlayerGameStats.MinutesPlayed), 0)::float
+			DESC NULLS LAST`, total, per36Minutes)
+}
+
+/* fills out the derived metrics from the counting stats */
+func setAdvancedStats(stats *pb.Stats) {
+
+	points := stats.ThreePointFGM*3 + stats.TwoPointFGM*2 + stats.FreeThrowsMade
+	fieldGoalsMade := stats.TwoPointFGM + stats.ThreePointFGM
+	fieldGoalsAttempted := stats.TwoPointFGA + stats.ThreePointFGA
+	rebounds := stats.OffensiveRebounds + stats.DefensiveRebounds
+
+	advanced := &pb.AdvancedStats{
+		Points:                       points,
+		FieldGoalsMade:               fieldGoalsMade,
+		FieldGoalsAttempted:          fieldGoalsAttempted,
+		FieldGoalPercentage:          ratio(float64(fieldGoalsMade), float64(fieldGoalsAttempted)),
+		EffectiveFieldGoalPercentage: ratio(float64(fieldGoalsMade)+0.5*float64(stats.ThreePointFGM), float64(fieldGoalsAttempted)),
+		TrueShootingPercentage:       ratio(float64(points), 2*(float64(fieldGoalsAttempted)+trueShootingFreeThrowWeight*float64(stats.FreeThrowsAttempted))),
+		AssistToTurnoverRatio:        float64(stats.Assists),
+		TotalRebounds:                rebounds,
+		OffensiveReboundShare:        ratio(float64(stats.OffensiveRebounds), float64(rebounds)),
+		DefensiveReboundShare:        ratio(float64(stats.DefensiveRebounds), float64(rebounds)),
+		PointsPerShot:                ratio(float64(points), float64(fieldGoalsAttempted)),
+		FreeThrowRate:                ratio(float64(stats.FreeThrowsAttempted), float64(fieldGoalsAttempted)),
+	}
+
+	if stats.Turnovers > 0 {
+		advanced.AssistToTurnoverRatio = float64(stats.Assists) / float64(stats.Turnovers)
+	}
+
+	if stats.MinutesPlayed > 0 {
+
+		per36 := func(value int32) float64 {
+			return float64(value) * per36Minutes / float64(stats.MinutesPlayed)
+		}
+
+		advanced.Per36 = &pb.Per36Stats{
+			Points:              per36(points),
+			Rebounds:            per36(rebounds),
+			OffensiveRebounds:   per36(stats.OffensiveRebounds),
+			DefensiveRebounds:   per36(stats.DefensiveRebounds),
+			Assists:             per36(stats.Assists),
+			Steals:              per36(stats.Steals),
+			Blocks:              per36(stats.Blocks),
+			Turnovers:           per36(stats.Turnovers),
+			FieldGoalsAttempted: per36(fieldGoalsAttempted),
+			FreeThrowsAttempted: per36(stats.FreeThrowsAttempted),
+		}
+	}
+
+	stats.Advanced = advanced
+}
+
+func ratio(numerator float64, denominator float64) float64 {
+
+	if denominator == 0 {
+		return 0
+	}
+
+	return numerator / denominator
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/mlv9/protobuf"
+)
+
+func TestSetAdvancedStats(t *testing.T) {
+
+	tests := []struct {
+		name              string
+		stats             *pb.Stats
+		effectiveFG       float64
+		trueShooting      float64
+		assistToTurnover  float64
+		per36Points       float64
+		per36Rebounds     float64
+		wantPer36         bool
+		freeThrowRate     float64
+		offensiveRebShare float64
+	}{
+		{
+			name:  "no attempts, turnovers or minutes",
+			stats: &pb.Stats{Assists: 3, OffensiveRebounds: 1, DefensiveRebounds: 3},
+			/* nothing to divide by gives 0 rather than NaN, and assists stand alone */
+			assistToTurnover:  3,
+			offensiveRebShare: 0.25,
+		},
+		{
+			name:         "free throws only",
+			stats:        &pb.Stats{FreeThrowsAttempted: 4, FreeThrowsMade: 3, MinutesPlayed: 12},
+			trueShooting: 3 / (2 * 0.44 * 4),
+			per36Points:  9,
+			wantPer36:    true,
+		},
+		{
+			name: "a full line",
+			stats: &pb.Stats{
+				TwoPointFGA:         10,
+				TwoPointFGM:         5,
+				ThreePointFGA:       6,
+				ThreePointFGM:       2,
+				FreeThrowsAttempted: 5,
+				FreeThrowsMade:      4,
+				OffensiveRebounds:   2,
+				DefensiveRebounds:   6,
+				Assists:             6,
+				Turnovers:           4,
+				MinutesPlayed:       24,
+			},
+			effectiveFG:       (7 + 0.5*2) / 16.0,
+			trueShooting:      20 / (2 * (16 + 0.44*5)),
+			assistToTurnover:  1.5,
+			per36Points:       30,
+			per36Rebounds:     12,
+			wantPer36:         true,
+			freeThrowRate:     5 / 16.0,
+			offensiveRebShare: 0.25,
+		},
+	}
+
+	near := func(got float64, want float64) bool {
+		return math.Abs(got-want) < 1e-9
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			setAdvancedStats(test.stats)
+
+			advanced := test.stats.GetAdvanced()
+
+			if !near(advanced.GetEffectiveFieldGoalPercentage(), test.effectiveFG) {
+				t.Errorf("Got eFG%% %v, want %v", advanced.GetEffectiveFieldGoalPercentage(), test.effectiveFG)
+			}
+
+			if !near(advanced.GetTrueShootingPercentage(), test.trueShooting) {
+				t.Errorf("Got TS%% %v, want %v", advanced.GetTrueShootingPercentage(), test.trueShooting)
+			}
+
+			if !near(advanced.GetAssistToTurnoverRatio(), test.assistToTurnover) {
+				t.Errorf("Got AST/TO %v, want %v", advanced.GetAssistToTurnoverRatio(), test.assistToTurnover)
+			}
+
+			if !near(advanced.GetFreeThrowRate(), test.freeThrowRate) {
+				t.Errorf("Got free throw rate %v, want %v", advanced.GetFreeThrowRate(), test.freeThrowRate)
+			}
+
+			if !near(advanced.GetOffensiveReboundShare(), test.offensiveRebShare) {
+				t.Errorf("Got offensive rebound share %v, want %v", advanced.GetOffensiveReboundShare(), test.offensiveRebShare)
+			}
+
+			/* per 36 is left out rather than divided by zero minutes */
+			if (advanced.GetPer36() != nil) != test.wantPer36 {
+				t.Fatalf("Got per 36 %v, want it set %v", advanced.GetPer36(), test.wantPer36)
+			}
+
+			if !near(advanced.GetPer36().GetPoints(), test.per36Points) || !near(advanced.GetPer36().GetRebounds(), test.per36Rebounds) {
+				t.Errorf("Got per 36 %v points and %v rebounds, want %v and %v", advanced.GetPer36().GetPoints(), advanced.GetPer36().GetRebounds(), test.per36Points, test.per36Rebounds)
+			}
+
+			for _, value := range []float64{advanced.GetFieldGoalPercentage(), advanced.GetPointsPerShot(), advanced.GetDefensiveReboundShare()} {
+				if math.IsNaN(value) || math.IsInf(value, 0) {
+					t.Errorf("Got a non-finite metric in %v", advanced)
+				}
+			}
+		})
+	}
+}
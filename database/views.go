@@ -0,0 +1,192 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	dataChangedChannel = "heroball_data_changed"
+
+	DefaultRefreshDebounce = 2 * time.Second
+	DefaultRefreshMaxDelay = 30 * time.Second
+
+	listenerPingInterval = 90 * time.Second
+)
+
+/* in dependency order */
+var materializedViews = []string{
+	"GameScoresView",
+}
+
+/* keeps the materialized views fresh by refreshing them after a burst of changes settles */
+type viewRefresher struct {
+	db       *sql.DB
+	listener *pq.Listener
+	/* closed when run returns */
+	done chan struct{}
+
+	/* wait this long after the last change before refreshing */
+	debounce time.Duration
+	/* but never hold off longer than this after the first change */
+	maxDelay time.Duration
+
+	mutex               sync.Mutex
+	lastRefreshTime     time.Time
+	lastRefreshDuration time.Duration
+}
+
+func newViewRefresher(db *sql.DB) *viewRefresher {
+	return &viewRefresher{
+		db: db,
+	}
+}
+
+/* starts listening for changes, refreshing once they have settled */
+func (refresher *viewRefresher) start(connStr string, debounce time.Duration, maxDelay time.Duration) error {
+
+	if debounce <= 0 {
+		debounce = DefaultRefreshDebounce
+	}
+
+	if maxDelay < debounce {
+		maxDelay = debounce
+	}
+
+	refresher.debounce = debounce
+	refresher.maxDelay = maxDelay
+
+	refresher.listener = pq.NewListener(connStr, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("View refresh listener event %v: %v", event, err)
+		}
+	})
+
+	err := refresher.listener.Listen(dataChangedChannel)
+
+	if err != nil {
+		refresher.listener.Close()
+		refresher.listener = nil
+		return fmt.Errorf("Error listening for data changes: %w", err)
+	}
+
+	refresher.done = make(chan struct{})
+
+	go refresher.run()
+
+	return nil
+}
+
+/* closing the listener ends run, waiting for a refresh in progress to finish */
+func (refresher *viewRefresher) stop() error {
+
+	if refresher.listener == nil {
+		return nil
+	}
+
+	err := refresher.listener.Close()
+
+	<-refresher.done
+
+	return err
+}
+
+func (refresher *viewRefresher) run() {
+
+	defer close(refresher.done)
+
+	var pending <-chan time.Time
+	var deadline time.Time
+
+	ping := time.NewTicker(listenerPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case notification, ok := <-refresher.listener.Notify:
+
+			if !ok {
+				return
+			}
+
+			/* a nil notification means we reconnected and may have missed changes */
+			if notification == nil {
+				log.Printf("View refresh listener reconnected, scheduling refresh")
+			}
+
+			now := time.Now()
+
+			if deadline.IsZero() {
+				deadline = now.Add(refresher.maxDelay)
+			}
+
+			wait := refresher.debounce
+
+			if now.Add(wait).After(deadline) {
+				wait = deadline.Sub(now)
+			}
+
+			pending = time.After(wait)
+
+		case <-pending:
+
+			pending = nil
+			deadline = time.Time{}
+
+			err := refresher.refresh(context.Background())
+
+			if err != nil {
+				log.Printf("Error refreshing views: %v", err)
+			}
+
+		case <-ping.C:
+			go refresher.listener.Ping()
+		}
+	}
+}
+
+/* refreshes all views now, readers are not blocked while this runs */
+func (refresher *viewRefresher) refresh(ctx context.Context) error {
+
+	refresher.mutex.Lock()
+	defer refresher.mutex.Unlock()
+
+	start := time.Now()
+
+	for _, view := range materializedViews {
+
+		_, err := refresher.db.ExecContext(ctx, fmt.Sprintf("REFRESH MATERIALIZED VIEW CONCURRENTLY %v", view))
+
+		if err != nil {
+			return fmt.Errorf("Error refreshing %v: %w", view, err)
+		}
+	}
+
+	/* the ratings are worked out from the refreshed scores */
+	err := rebuildRatings(ctx, refresher.db)
+
+	if err != nil {
+		return err
+	}
+
+	refresher.lastRefreshTime = start
+	refresher.lastRefreshDuration = time.Since(start)
+
+	log.Printf("Refreshed views in %v", refresher.lastRefreshDuration)
+
+	return nil
+}
+
+func (refresher *viewRefresher) lastRefresh() (time.Time, time.Duration) {
+
+	refresher.mutex.Lock()
+	defer refresher.mutex.Unlock()
+
+	return refresher.lastRefreshTime, refresher.lastRefreshDuration
+}
@@ -7,6 +7,42 @@ CREATE TYPE playerposition AS ENUM(
     'power-forward', 
     'center');
 
+CREATE TYPE gamestatus AS ENUM(
+    'scheduled',
+    'in-progress',
+    'final',
+    'postponed',
+    'cancelled',
+    'forfeit');
+
+CREATE TYPE gameeventtype AS ENUM(
+    'two-point-made',
+    'two-point-missed',
+    'three-point-made',
+    'three-point-missed',
+    'free-throw-made',
+    'free-throw-missed',
+    'offensive-rebound',
+    'defensive-rebound',
+    'assist',
+    'steal',
+    'block',
+    'turnover',
+    'foul',
+    'technical-foul',
+    'foul-drawn');
+
+CREATE TYPE shotzone AS ENUM(
+    'restricted-area',
+    'paint',
+    'mid-range',
+    'corner-three',
+    'above-the-break-three');
+
+CREATE TYPE awardvoterrole AS ENUM(
+    'coach',
+    'referee');
+
 CREATE TABLE Leagues (
     LeagueId SERIAL PRIMARY KEY,
     Name text NOT NULL,
@@ -16,7 +52,16 @@ CREATE TABLE Leagues (
 CREATE TABLE Competitions (
     CompetitionId SERIAL PRIMARY KEY,
     LeagueId SERIAL NOT NULL REFERENCES Leagues(LeagueId),
-    Name text NOT NULL
+    Name text NOT NULL,
+    /* regulation is 4 quarters or 2 halves, anything after is overtime */
+    PeriodCount int NOT NULL DEFAULT 4 CHECK (PeriodCount IN (2, 4)),
+    /* ladder points for each result, a forfeiting team gets ForfeitPoints rather than LossPoints */
+    WinPoints int NOT NULL DEFAULT 2,
+    DrawPoints int NOT NULL DEFAULT 1,
+    LossPoints int NOT NULL DEFAULT 0,
+    ForfeitPoints int NOT NULL DEFAULT 0,
+    /* applied in order to teams level on ladder points */
+    Tiebreakers text[] NOT NULL DEFAULT '{head-to-head,points-differential,points-for}'
 );
 
 CREATE TABLE Teams (
@@ -38,13 +83,55 @@ CREATE TABLE Players (
     Description text
 );
 
+/* the teams entered in each competition */
+CREATE TABLE CompetitionTeams (
+    CompetitionId int NOT NULL REFERENCES Competitions(CompetitionId),
+    TeamId int NOT NULL REFERENCES Teams(TeamId),
+    PRIMARY KEY (CompetitionId, TeamId)
+);
+
+/* the players registered to each team in a competition */
+CREATE TABLE CompetitionRosters (
+    CompetitionId int NOT NULL,
+    TeamId int NOT NULL,
+    PlayerId int NOT NULL REFERENCES Players(PlayerId),
+    JerseyNumber int NOT NULL CHECK (JerseyNumber >= 0),
+    PRIMARY KEY (CompetitionId, TeamId, PlayerId),
+    FOREIGN KEY (CompetitionId, TeamId) REFERENCES CompetitionTeams(CompetitionId, TeamId) ON DELETE CASCADE
+);
+
+/*
+ * a competition's finals, each round halves the field. Only the first round
+ * has its teams, later rounds are decided by who wins the series before them
+ */
+CREATE TABLE BracketSeries (
+    SeriesId SERIAL PRIMARY KEY,
+    CompetitionId int NOT NULL REFERENCES Competitions(CompetitionId),
+    Round int NOT NULL CHECK (Round >= 1),
+    Position int NOT NULL CHECK (Position >= 0),
+    BestOf int NOT NULL CHECK (BestOf >= 1 AND BestOf % 2 = 1),
+    HigherSeed int,
+    HigherSeedTeamId int REFERENCES Teams(TeamId),
+    LowerSeed int,
+    LowerSeedTeamId int REFERENCES Teams(TeamId),
+    CONSTRAINT bracket_series_unique UNIQUE (CompetitionId, Round, Position)
+);
+
 CREATE TABLE Games (
     GameId SERIAL PRIMARY KEY,
     CompetitionId SERIAL NOT NULL REFERENCES Competitions(CompetitionId),
     LocationId SERIAL NOT NULL REFERENCES Locations(LocationId),
     HomeTeamId SERIAL NOT NULL REFERENCES Teams(TeamId) CHECK (HomeTeamId != AwayTeamId),
     AwayTeamId SERIAL NOT NULL REFERENCES Teams(TeamId),
-    GameTime TIMESTAMP NOT NULL
+    GameTime TIMESTAMP NOT NULL,
+    Status gamestatus NOT NULL DEFAULT 'scheduled',
+    /* the team that forfeited, only for forfeits */
+    ForfeitTeamId int REFERENCES Teams(TeamId),
+    /* playoff games belong to a series, the rest are regular season */
+    SeriesId int REFERENCES BracketSeries(SeriesId),
+    CONSTRAINT game_forfeit_validation CHECK (
+        (Status = 'forfeit') = (ForfeitTeamId IS NOT NULL) AND
+        (ForfeitTeamId IS NULL OR ForfeitTeamId IN (HomeTeamId, AwayTeamId)))
 );
 
 CREATE TABLE PlayerGameStats (
@@ -68,7 +155,89 @@ CREATE TABLE PlayerGameStats (
     RegularFoulsForced int DEFAULT 0,
     RegularFoulsCommitted int DEFAULT 0 CONSTRAINT regular_fouls_committed_validation CHECK (5 >= RegularFoulsCommitted),
     TechnicalFoulsCommitted int DEFAULT 0 CONSTRAINT technical_fouls_committed_validation CHECK (2 >= TechnicalFoulsCommitted),
-    MinutesPlayed int DEFAULT 0
+    MinutesPlayed int DEFAULT 0,
+    CONSTRAINT player_game_unique UNIQUE (GameId, PlayerId)
+);
+
+/* play-by-play, the PlayerGameStats of players with events are derived from them */
+CREATE TABLE GameEvents (
+    EventId SERIAL PRIMARY KEY,
+    GameId int NOT NULL REFERENCES Games(GameId),
+    PlayerId int NOT NULL REFERENCES Players(PlayerId),
+    TeamId int NOT NULL REFERENCES Teams(TeamId),
+    EventType gameeventtype NOT NULL,
+    Period int NOT NULL CHECK (Period >= 1),
+    ClockSeconds int NOT NULL CHECK (ClockSeconds >= 0),
+    RecordedAt TIMESTAMP NOT NULL DEFAULT now(),
+    /* where a field goal attempt was taken from, see GameEvent in heroball.proto */
+    ShotX real,
+    ShotY real,
+    ShotZone shotzone,
+    CONSTRAINT shot_location_validation CHECK (
+        ShotZone IS NULL OR EventType IN ('two-point-made', 'two-point-missed', 'three-point-made', 'three-point-missed'))
+);
+
+CREATE INDEX GameEventsGamePlayer ON GameEvents (GameId, PlayerId);
+
+/* who came on and off the court, from which lineups and plus/minus are worked out with GameEvents */
+CREATE TABLE GameSubstitutions (
+    SubstitutionId SERIAL PRIMARY KEY,
+    GameId int NOT NULL REFERENCES Games(GameId),
+    TeamId int NOT NULL REFERENCES Teams(TeamId),
+    PlayerInId int REFERENCES Players(PlayerId),
+    PlayerOutId int REFERENCES Players(PlayerId),
+    Period int NOT NULL CHECK (Period >= 1),
+    ClockSeconds int NOT NULL CHECK (ClockSeconds >= 0),
+    RecordedAt TIMESTAMP NOT NULL DEFAULT now(),
+    CONSTRAINT substitution_players_validation CHECK (
+        (PlayerInId IS NOT NULL OR PlayerOutId IS NOT NULL) AND
+        PlayerInId IS DISTINCT FROM PlayerOutId)
+);
+
+CREATE INDEX GameSubstitutionsGame ON GameSubstitutions (GameId);
+
+/* each team's Elo rating after every final game, rebuilt by the grpc-server with the views below */
+CREATE TABLE TeamRatings (
+    TeamId int NOT NULL REFERENCES Teams(TeamId),
+    GameId int NOT NULL REFERENCES Games(GameId),
+    RatingBefore double precision NOT NULL,
+    RatingAfter double precision NOT NULL,
+    PRIMARY KEY (TeamId, GameId)
+);
+
+CREATE INDEX TeamRatingsGame ON TeamRatings (GameId);
+
+/* voted on in every game of the competition, VotePoints are the points for each place on a ballot */
+CREATE TABLE Awards (
+    AwardId SERIAL PRIMARY KEY,
+    CompetitionId int NOT NULL REFERENCES Competitions(CompetitionId),
+    Name text NOT NULL,
+    VotePoints int[] NOT NULL DEFAULT '{3,2,1}',
+    CONSTRAINT award_name_unique UNIQUE (CompetitionId, Name)
+);
+
+/* a voter's ballot for a game is their rows for it, Place 1 is their best player */
+CREATE TABLE AwardVotes (
+    AwardId int NOT NULL REFERENCES Awards(AwardId),
+    GameId int NOT NULL REFERENCES Games(GameId),
+    Voter text NOT NULL,
+    VoterRole awardvoterrole NOT NULL,
+    Place int NOT NULL CHECK (Place >= 1),
+    PlayerId int NOT NULL REFERENCES Players(PlayerId),
+    CastAt TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (AwardId, GameId, Voter, Place),
+    CONSTRAINT award_vote_player_unique UNIQUE (AwardId, GameId, Voter, PlayerId)
+);
+
+CREATE INDEX AwardVotesGame ON AwardVotes (GameId);
+
+/* points per period for games scored without play-by-play events */
+CREATE TABLE GamePeriodScores (
+    GameId int NOT NULL REFERENCES Games(GameId),
+    Period int NOT NULL CHECK (Period >= 1),
+    HomeTeamPoints int NOT NULL CHECK (HomeTeamPoints >= 0),
+    AwayTeamPoints int NOT NULL CHECK (AwayTeamPoints >= 0),
+    PRIMARY KEY (GameId, Period)
 );
 
 DROP FUNCTION IF EXISTS TotalPoints;
@@ -79,56 +248,73 @@ AS $$ SELECT
     COALESCE(freeThrows, 0) $$
 LANGUAGE SQL;
 
+/* a forfeit is recorded as this many points to nil, whatever stats were entered */
+DROP FUNCTION IF EXISTS ForfeitScore;
+CREATE FUNCTION ForfeitScore(out forfeitScore int)
+AS $$ SELECT 20 $$
+LANGUAGE SQL IMMUTABLE;
+
 DROP MATERIALIZED VIEW IF EXISTS GameScoresView;
 
 CREATE MATERIALIZED VIEW GameScoresView AS
     SELECT
         GameId,
         CompetitionId,
-        (SELECT TotalPoints(SUM(PlayerGameStats.ThreePointFGM), SUM(PlayerGameStats.TwoPointFGM), SUM(PlayerGameStats.FreeThrowsMade)) FROM PlayerGameStats WHERE TeamId = HomeTeamId AND PlayerGameStats.GameId = Games.GameId) As HomeTeamPoints,
-        (SELECT TotalPoints(SUM(PlayerGameStats.ThreePointFGM), SUM(PlayerGameStats.TwoPointFGM), SUM(PlayerGameStats.FreeThrowsMade)) FROM PlayerGameStats WHERE TeamId = AwayTeamId AND PlayerGameStats.GameId = Games.GameId) As AwayTeamPoints
+        Status,
+        SeriesId,
+        CASE Status
+            WHEN 'forfeit' THEN CASE WHEN ForfeitTeamId = HomeTeamId THEN 0 ELSE ForfeitScore() END
+            ELSE (SELECT TotalPoints(SUM(PlayerGameStats.ThreePointFGM), SUM(PlayerGameStats.TwoPointFGM), SUM(PlayerGameStats.FreeThrowsMade)) FROM PlayerGameStats WHERE TeamId = HomeTeamId AND PlayerGameStats.GameId = Games.GameId)
+        END As HomeTeamPoints,
+        CASE Status
+            WHEN 'forfeit' THEN CASE WHEN ForfeitTeamId = AwayTeamId THEN 0 ELSE ForfeitScore() END
+            ELSE (SELECT TotalPoints(SUM(PlayerGameStats.ThreePointFGM), SUM(PlayerGameStats.TwoPointFGM), SUM(PlayerGameStats.FreeThrowsMade)) FROM PlayerGameStats WHERE TeamId = AwayTeamId AND PlayerGameStats.GameId = Games.GameId)
+        END As AwayTeamPoints
     FROM
         Games;
 
-DROP MATERIALIZED VIEW IF EXISTS CompetitionStandingsView;
+/* needed to REFRESH MATERIALIZED VIEW CONCURRENTLY */
+CREATE UNIQUE INDEX GameScoresViewGameId ON GameScoresView (GameId);
 
-CREATE MATERIALIZED VIEW CompetitionStandingsView AS
-    SELECT
-        CompetitionTeams.CompetitionId,
-        CompetitionTeams.TeamId,
-        (SELECT Name FROM Teams WHERE Teams.TeamId = CompetitionTeams.TeamId) As TeamName,
-        (
-            SELECT
-                COUNT(GameScoresView.GameId)
-            FROM
-                Games LEFT JOIN 
-                GameScoresView ON Games.GameId = GameScoresView.GameId
-            WHERE
-                (Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints > GameScoresView.HomeTeamPoints) OR 
-                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints > GameScoresView.AwayTeamPoints)
-        ) AS GamesWon,
-        (
-            SELECT
-                COUNT(GameScoresView.GameId)
-            FROM
-                Games LEFT JOIN 
-                GameScoresView ON Games.GameId = GameScoresView.GameId
-            WHERE
-                (Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints = GameScoresView.HomeTeamPoints) OR 
-                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints = GameScoresView.AwayTeamPoints)
-        ) AS GamesDrawn,
-        (
-            SELECT
-                COUNT(GameScoresView.GameId)
-            FROM
-                Games LEFT JOIN 
-                GameScoresView ON Games.GameId = GameScoresView.GameId
-            WHERE
-                (Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints < GameScoresView.HomeTeamPoints) OR 
-                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints < GameScoresView.AwayTeamPoints)
-        ) AS GamesLost
-        FROM
-            (SELECT CompetitionId, HomeTeamId As TeamId FROM Games UNION SELECT CompetitionId, AwayTeamId As TeamId FROM Games) As CompetitionTeams;
-
-REFRESH MATERIALIZED VIEW CompetitionStandingsView;
+/* the grpc-server listens on this channel and refreshes the views above */
+DROP FUNCTION IF EXISTS NotifyDataChanged CASCADE;
+CREATE FUNCTION NotifyDataChanged() RETURNS trigger
+AS $$ BEGIN
+    PERFORM pg_notify('heroball_data_changed', TG_TABLE_NAME);
+    RETURN NULL;
+END; $$
+LANGUAGE plpgsql;
+
+CREATE TRIGGER GamesChanged
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON Games
+    FOR EACH STATEMENT EXECUTE FUNCTION NotifyDataChanged();
+
+CREATE TRIGGER PlayerGameStatsChanged
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON PlayerGameStats
+    FOR EACH STATEMENT EXECUTE FUNCTION NotifyDataChanged();
+
+CREATE TRIGGER CompetitionTeamsChanged
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON CompetitionTeams
+    FOR EACH STATEMENT EXECUTE FUNCTION NotifyDataChanged();
+
+/* per game changes for the grpc-server's StreamGame, postgres folds repeats within a transaction */
+DROP FUNCTION IF EXISTS NotifyGameChanged CASCADE;
+CREATE FUNCTION NotifyGameChanged() RETURNS trigger
+AS $$ BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM pg_notify('heroball_game_changed', OLD.GameId::text);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM pg_notify('heroball_game_changed', NEW.GameId::text);
+    END IF;
+    RETURN NULL;
+END; $$
+LANGUAGE plpgsql;
+
+CREATE TRIGGER GameRowChanged
+    AFTER UPDATE OR DELETE ON Games
+    FOR EACH ROW EXECUTE FUNCTION NotifyGameChanged();
 
+CREATE TRIGGER PlayerGameStatsRowChanged
+    AFTER INSERT OR UPDATE OR DELETE ON PlayerGameStats
+    FOR EACH ROW EXECUTE FUNCTION NotifyGameChanged();
@@ -0,0 +1,26 @@
+/* adds awards voting to a database created before it */
+CREATE TYPE awardvoterrole AS ENUM(
+    'coach',
+    'referee');
+
+CREATE TABLE IF NOT EXISTS Awards (
+    AwardId SERIAL PRIMARY KEY,
+    CompetitionId int NOT NULL REFERENCES Competitions(CompetitionId),
+    Name text NOT NULL,
+    VotePoints int[] NOT NULL DEFAULT '{3,2,1}',
+    CONSTRAINT award_name_unique UNIQUE (CompetitionId, Name)
+);
+
+CREATE TABLE IF NOT EXISTS AwardVotes (
+    AwardId int NOT NULL REFERENCES Awards(AwardId),
+    GameId int NOT NULL REFERENCES Games(GameId),
+    Voter text NOT NULL,
+    VoterRole awardvoterrole NOT NULL,
+    Place int NOT NULL CHECK (Place >= 1),
+    PlayerId int NOT NULL REFERENCES Players(PlayerId),
+    CastAt TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (AwardId, GameId, Voter, Place),
+    CONSTRAINT award_vote_player_unique UNIQUE (AwardId, GameId, Voter, PlayerId)
+);
+
+CREATE INDEX IF NOT EXISTS AwardVotesGame ON AwardVotes (GameId);
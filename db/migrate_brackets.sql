@@ -0,0 +1,87 @@
+/* adds playoff brackets to a database created before them, regular season standings leave out playoff games */
+CREATE TABLE IF NOT EXISTS BracketSeries (
+    SeriesId SERIAL PRIMARY KEY,
+    CompetitionId int NOT NULL REFERENCES Competitions(CompetitionId),
+    Round int NOT NULL CHECK (Round >= 1),
+    Position int NOT NULL CHECK (Position >= 0),
+    BestOf int NOT NULL CHECK (BestOf >= 1 AND BestOf % 2 = 1),
+    HigherSeed int,
+    HigherSeedTeamId int REFERENCES Teams(TeamId),
+    LowerSeed int,
+    LowerSeedTeamId int REFERENCES Teams(TeamId),
+    CONSTRAINT bracket_series_unique UNIQUE (CompetitionId, Round, Position)
+);
+
+ALTER TABLE Games ADD COLUMN IF NOT EXISTS SeriesId int REFERENCES BracketSeries(SeriesId);
+
+DROP MATERIALIZED VIEW IF EXISTS GameScoresView CASCADE;
+
+CREATE MATERIALIZED VIEW GameScoresView AS
+    SELECT
+        GameId,
+        CompetitionId,
+        Status,
+        SeriesId,
+        CASE Status
+            WHEN 'forfeit' THEN CASE WHEN ForfeitTeamId = HomeTeamId THEN 0 ELSE ForfeitScore() END
+            ELSE (SELECT TotalPoints(SUM(PlayerGameStats.ThreePointFGM), SUM(PlayerGameStats.TwoPointFGM), SUM(PlayerGameStats.FreeThrowsMade)) FROM PlayerGameStats WHERE TeamId = HomeTeamId AND PlayerGameStats.GameId = Games.GameId)
+        END As HomeTeamPoints,
+        CASE Status
+            WHEN 'forfeit' THEN CASE WHEN ForfeitTeamId = AwayTeamId THEN 0 ELSE ForfeitScore() END
+            ELSE (SELECT TotalPoints(SUM(PlayerGameStats.ThreePointFGM), SUM(PlayerGameStats.TwoPointFGM), SUM(PlayerGameStats.FreeThrowsMade)) FROM PlayerGameStats WHERE TeamId = AwayTeamId AND PlayerGameStats.GameId = Games.GameId)
+        END As AwayTeamPoints
+    FROM
+        Games;
+
+/* needed to REFRESH MATERIALIZED VIEW CONCURRENTLY */
+CREATE UNIQUE INDEX GameScoresViewGameId ON GameScoresView (GameId);
+
+DROP MATERIALIZED VIEW IF EXISTS CompetitionStandingsView;
+
+CREATE MATERIALIZED VIEW CompetitionStandingsView AS
+    SELECT
+        CompetitionTeams.CompetitionId,
+        CompetitionTeams.TeamId,
+        (SELECT Name FROM Teams WHERE Teams.TeamId = CompetitionTeams.TeamId) As TeamName,
+        (
+            SELECT
+                COUNT(GameScoresView.GameId)
+            FROM
+                Games LEFT JOIN 
+                GameScoresView ON Games.GameId = GameScoresView.GameId
+            WHERE
+                GameScoresView.Status IN ('final', 'forfeit') AND
+                GameScoresView.SeriesId IS NULL AND
+                ((Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints > GameScoresView.HomeTeamPoints) OR
+                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints > GameScoresView.AwayTeamPoints))
+        ) AS GamesWon,
+        (
+            SELECT
+                COUNT(GameScoresView.GameId)
+            FROM
+                Games LEFT JOIN 
+                GameScoresView ON Games.GameId = GameScoresView.GameId
+            WHERE
+                GameScoresView.Status IN ('final', 'forfeit') AND
+                GameScoresView.SeriesId IS NULL AND
+                ((Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints = GameScoresView.HomeTeamPoints) OR
+                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints = GameScoresView.AwayTeamPoints))
+        ) AS GamesDrawn,
+        (
+            SELECT
+                COUNT(GameScoresView.GameId)
+            FROM
+                Games LEFT JOIN 
+                GameScoresView ON Games.GameId = GameScoresView.GameId
+            WHERE
+                GameScoresView.Status IN ('final', 'forfeit') AND
+                GameScoresView.SeriesId IS NULL AND
+                ((Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints < GameScoresView.HomeTeamPoints) OR
+                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints < GameScoresView.AwayTeamPoints))
+        ) AS GamesLost
+        FROM
+            CompetitionTeams;
+
+CREATE UNIQUE INDEX CompetitionStandingsViewTeam ON CompetitionStandingsView (CompetitionId, TeamId);
+
+REFRESH MATERIALIZED VIEW CompetitionStandingsView;
@@ -0,0 +1,40 @@
+/* brings a database created before CompetitionTeams and CompetitionRosters up to date */
+CREATE TABLE IF NOT EXISTS CompetitionTeams (
+    CompetitionId int NOT NULL REFERENCES Competitions(CompetitionId),
+    TeamId int NOT NULL REFERENCES Teams(TeamId),
+    PRIMARY KEY (CompetitionId, TeamId)
+);
+
+CREATE TABLE IF NOT EXISTS CompetitionRosters (
+    CompetitionId int NOT NULL,
+    TeamId int NOT NULL,
+    PlayerId int NOT NULL REFERENCES Players(PlayerId),
+    JerseyNumber int NOT NULL CHECK (JerseyNumber >= 0),
+    PRIMARY KEY (CompetitionId, TeamId, PlayerId),
+    FOREIGN KEY (CompetitionId, TeamId) REFERENCES CompetitionTeams(CompetitionId, TeamId) ON DELETE CASCADE
+);
+
+INSERT INTO CompetitionTeams (CompetitionId, TeamId)
+    SELECT CompetitionId, HomeTeamId FROM Games
+    UNION SELECT CompetitionId, AwayTeamId FROM Games
+ON CONFLICT DO NOTHING;
+
+/* the most recent jersey number each player wore for the team */
+INSERT INTO CompetitionRosters (CompetitionId, TeamId, PlayerId, JerseyNumber)
+    SELECT DISTINCT ON (Games.CompetitionId, PlayerGameStats.TeamId, PlayerGameStats.PlayerId)
+        Games.CompetitionId,
+        PlayerGameStats.TeamId,
+        PlayerGameStats.PlayerId,
+        PlayerGameStats.JerseyNumber
+    FROM
+        PlayerGameStats
+    LEFT JOIN
+        Games ON Games.GameId = PlayerGameStats.GameId
+    ORDER BY
+        Games.CompetitionId, PlayerGameStats.TeamId, PlayerGameStats.PlayerId, Games.GameTime DESC
+ON CONFLICT DO NOTHING;
+
+DROP TRIGGER IF EXISTS CompetitionTeamsChanged ON CompetitionTeams;
+CREATE TRIGGER CompetitionTeamsChanged
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON CompetitionTeams
+    FOR EACH STATEMENT EXECUTE FUNCTION NotifyDataChanged();
@@ -0,0 +1,30 @@
+/* adds the play-by-play event log to a database created before it */
+CREATE TYPE gameeventtype AS ENUM(
+    'two-point-made',
+    'two-point-missed',
+    'three-point-made',
+    'three-point-missed',
+    'free-throw-made',
+    'free-throw-missed',
+    'offensive-rebound',
+    'defensive-rebound',
+    'assist',
+    'steal',
+    'block',
+    'turnover',
+    'foul',
+    'technical-foul',
+    'foul-drawn');
+
+CREATE TABLE IF NOT EXISTS GameEvents (
+    EventId SERIAL PRIMARY KEY,
+    GameId int NOT NULL REFERENCES Games(GameId),
+    PlayerId int NOT NULL REFERENCES Players(PlayerId),
+    TeamId int NOT NULL REFERENCES Teams(TeamId),
+    EventType gameeventtype NOT NULL,
+    Period int NOT NULL CHECK (Period >= 1),
+    ClockSeconds int NOT NULL CHECK (ClockSeconds >= 0),
+    RecordedAt TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS GameEventsGamePlayer ON GameEvents (GameId, PlayerId);
@@ -0,0 +1,22 @@
+/* adds the per game notifications StreamGame needs to a database created before them */
+CREATE OR REPLACE FUNCTION NotifyGameChanged() RETURNS trigger
+AS $$ BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM pg_notify('heroball_game_changed', OLD.GameId::text);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM pg_notify('heroball_game_changed', NEW.GameId::text);
+    END IF;
+    RETURN NULL;
+END; $$
+LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS GameRowChanged ON Games;
+CREATE TRIGGER GameRowChanged
+    AFTER UPDATE OR DELETE ON Games
+    FOR EACH ROW EXECUTE FUNCTION NotifyGameChanged();
+
+DROP TRIGGER IF EXISTS PlayerGameStatsRowChanged ON PlayerGameStats;
+CREATE TRIGGER PlayerGameStatsRowChanged
+    AFTER INSERT OR UPDATE OR DELETE ON PlayerGameStats
+    FOR EACH ROW EXECUTE FUNCTION NotifyGameChanged();
@@ -0,0 +1,91 @@
+/* adds game statuses and forfeits to a database created before them */
+CREATE TYPE gamestatus AS ENUM(
+    'scheduled',
+    'in-progress',
+    'final',
+    'postponed',
+    'cancelled',
+    'forfeit');
+
+ALTER TABLE Games ADD COLUMN IF NOT EXISTS Status gamestatus NOT NULL DEFAULT 'scheduled';
+ALTER TABLE Games ADD COLUMN IF NOT EXISTS ForfeitTeamId int REFERENCES Teams(TeamId);
+ALTER TABLE Games ADD CONSTRAINT game_forfeit_validation CHECK (
+    (Status = 'forfeit') = (ForfeitTeamId IS NOT NULL) AND
+    (ForfeitTeamId IS NULL OR ForfeitTeamId IN (HomeTeamId, AwayTeamId)));
+
+/* games already played have their stats in, the rest are fixtures */
+UPDATE Games SET Status = 'final' WHERE EXISTS (SELECT 1 FROM PlayerGameStats WHERE PlayerGameStats.GameId = Games.GameId);
+
+/* a forfeit is recorded as this many points to nil, whatever stats were entered */
+DROP FUNCTION IF EXISTS ForfeitScore;
+CREATE FUNCTION ForfeitScore(out forfeitScore int)
+AS $$ SELECT 20 $$
+LANGUAGE SQL IMMUTABLE;
+
+DROP MATERIALIZED VIEW IF EXISTS GameScoresView CASCADE;
+
+CREATE MATERIALIZED VIEW GameScoresView AS
+    SELECT
+        GameId,
+        CompetitionId,
+        Status,
+        CASE Status
+            WHEN 'forfeit' THEN CASE WHEN ForfeitTeamId = HomeTeamId THEN 0 ELSE ForfeitScore() END
+            ELSE (SELECT TotalPoints(SUM(PlayerGameStats.ThreePointFGM), SUM(PlayerGameStats.TwoPointFGM), SUM(PlayerGameStats.FreeThrowsMade)) FROM PlayerGameStats WHERE TeamId = HomeTeamId AND PlayerGameStats.GameId = Games.GameId)
+        END As HomeTeamPoints,
+        CASE Status
+            WHEN 'forfeit' THEN CASE WHEN ForfeitTeamId = AwayTeamId THEN 0 ELSE ForfeitScore() END
+            ELSE (SELECT TotalPoints(SUM(PlayerGameStats.ThreePointFGM), SUM(PlayerGameStats.TwoPointFGM), SUM(PlayerGameStats.FreeThrowsMade)) FROM PlayerGameStats WHERE TeamId = AwayTeamId AND PlayerGameStats.GameId = Games.GameId)
+        END As AwayTeamPoints
+    FROM
+        Games;
+
+/* needed to REFRESH MATERIALIZED VIEW CONCURRENTLY */
+CREATE UNIQUE INDEX GameScoresViewGameId ON GameScoresView (GameId);
+
+DROP MATERIALIZED VIEW IF EXISTS CompetitionStandingsView;
+
+CREATE MATERIALIZED VIEW CompetitionStandingsView AS
+    SELECT
+        CompetitionTeams.CompetitionId,
+        CompetitionTeams.TeamId,
+        (SELECT Name FROM Teams WHERE Teams.TeamId = CompetitionTeams.TeamId) As TeamName,
+        (
+            SELECT
+                COUNT(GameScoresView.GameId)
+            FROM
+                Games LEFT JOIN 
+                GameScoresView ON Games.GameId = GameScoresView.GameId
+            WHERE
+                GameScoresView.Status IN ('final', 'forfeit') AND
+                ((Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints > GameScoresView.HomeTeamPoints) OR
+                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints > GameScoresView.AwayTeamPoints))
+        ) AS GamesWon,
+        (
+            SELECT
+                COUNT(GameScoresView.GameId)
+            FROM
+                Games LEFT JOIN 
+                GameScoresView ON Games.GameId = GameScoresView.GameId
+            WHERE
+                GameScoresView.Status IN ('final', 'forfeit') AND
+                ((Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints = GameScoresView.HomeTeamPoints) OR
+                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints = GameScoresView.AwayTeamPoints))
+        ) AS GamesDrawn,
+        (
+            SELECT
+                COUNT(GameScoresView.GameId)
+            FROM
+                Games LEFT JOIN 
+                GameScoresView ON Games.GameId = GameScoresView.GameId
+            WHERE
+                GameScoresView.Status IN ('final', 'forfeit') AND
+                ((Games.AwayTeamId = CompetitionTeams.TeamId AND GameScoresView.AwayTeamPoints < GameScoresView.HomeTeamPoints) OR
+                (Games.HomeTeamId = CompetitionTeams.TeamId AND GameScoresView.HomeTeamPoints < GameScoresView.AwayTeamPoints))
+        ) AS GamesLost
+        FROM
+            CompetitionTeams;
+
+CREATE UNIQUE INDEX CompetitionStandingsViewTeam ON CompetitionStandingsView (CompetitionId, TeamId);
+
+REFRESH MATERIALIZED VIEW CompetitionStandingsView;
@@ -0,0 +1,16 @@
+/* adds substitutions to a database created before them */
+CREATE TABLE IF NOT EXISTS GameSubstitutions (
+    SubstitutionId SERIAL PRIMARY KEY,
+    GameId int NOT NULL REFERENCES Games(GameId),
+    TeamId int NOT NULL REFERENCES Teams(TeamId),
+    PlayerInId int REFERENCES Players(PlayerId),
+    PlayerOutId int REFERENCES Players(PlayerId),
+    Period int NOT NULL CHECK (Period >= 1),
+    ClockSeconds int NOT NULL CHECK (ClockSeconds >= 0),
+    RecordedAt TIMESTAMP NOT NULL DEFAULT now(),
+    CONSTRAINT substitution_players_validation CHECK (
+        (PlayerInId IS NOT NULL OR PlayerOutId IS NOT NULL) AND
+        PlayerInId IS DISTINCT FROM PlayerOutId)
+);
+
+CREATE INDEX IF NOT EXISTS GameSubstitutionsGame ON GameSubstitutions (GameId);
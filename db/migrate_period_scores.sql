@@ -0,0 +1,10 @@
+/* adds competition period formats and per period scores to a database created before them */
+ALTER TABLE Competitions ADD COLUMN IF NOT EXISTS PeriodCount int NOT NULL DEFAULT 4 CHECK (PeriodCount IN (2, 4));
+
+CREATE TABLE IF NOT EXISTS GamePeriodScores (
+    GameId int NOT NULL REFERENCES Games(GameId),
+    Period int NOT NULL CHECK (Period >= 1),
+    HomeTeamPoints int NOT NULL CHECK (HomeTeamPoints >= 0),
+    AwayTeamPoints int NOT NULL CHECK (AwayTeamPoints >= 0),
+    PRIMARY KEY (GameId, Period)
+);
@@ -0,0 +1,19 @@
+/* gives a database created before it the one stat line per player per game constraint that upserts rely on */
+
+/* where a player has more than one line in a game, the last one written is kept */
+DELETE FROM PlayerGameStats
+WHERE StatsId IN (
+    SELECT
+        StatsId
+    FROM (
+        SELECT
+            StatsId,
+            ROW_NUMBER() OVER (PARTITION BY GameId, PlayerId ORDER BY StatsId DESC) AS LineNumber
+        FROM
+            PlayerGameStats
+    ) AS Lines
+    WHERE
+        LineNumber > 1);
+
+ALTER TABLE PlayerGameStats DROP CONSTRAINT IF EXISTS player_game_unique;
+ALTER TABLE PlayerGameStats ADD CONSTRAINT player_game_unique UNIQUE (GameId, PlayerId);
@@ -0,0 +1,14 @@
+/* adds shot locations to the play-by-play of a database created before them */
+CREATE TYPE shotzone AS ENUM(
+    'restricted-area',
+    'paint',
+    'mid-range',
+    'corner-three',
+    'above-the-break-three');
+
+ALTER TABLE GameEvents
+    ADD COLUMN IF NOT EXISTS ShotX real,
+    ADD COLUMN IF NOT EXISTS ShotY real,
+    ADD COLUMN IF NOT EXISTS ShotZone shotzone,
+    ADD CONSTRAINT shot_location_validation CHECK (
+        ShotZone IS NULL OR EventType IN ('two-point-made', 'two-point-missed', 'three-point-made', 'three-point-missed'));
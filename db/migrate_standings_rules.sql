@@ -0,0 +1,8 @@
+/* adds standings rules to a database created before them, standings are now worked out from GameScoresView by the server */
+ALTER TABLE Competitions ADD COLUMN IF NOT EXISTS WinPoints int NOT NULL DEFAULT 2;
+ALTER TABLE Competitions ADD COLUMN IF NOT EXISTS DrawPoints int NOT NULL DEFAULT 1;
+ALTER TABLE Competitions ADD COLUMN IF NOT EXISTS LossPoints int NOT NULL DEFAULT 0;
+ALTER TABLE Competitions ADD COLUMN IF NOT EXISTS ForfeitPoints int NOT NULL DEFAULT 0;
+ALTER TABLE Competitions ADD COLUMN IF NOT EXISTS Tiebreakers text[] NOT NULL DEFAULT '{head-to-head,points-differential,points-for}';
+
+DROP MATERIALIZED VIEW IF EXISTS CompetitionStandingsView;
@@ -0,0 +1,10 @@
+/* adds team ratings to a database created before them, they fill in on the next view refresh */
+CREATE TABLE IF NOT EXISTS TeamRatings (
+    TeamId int NOT NULL REFERENCES Teams(TeamId),
+    GameId int NOT NULL REFERENCES Games(GameId),
+    RatingBefore double precision NOT NULL,
+    RatingAfter double precision NOT NULL,
+    PRIMARY KEY (TeamId, GameId)
+);
+
+CREATE INDEX IF NOT EXISTS TeamRatingsGame ON TeamRatings (GameId);
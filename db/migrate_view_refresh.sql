@@ -0,0 +1,20 @@
+/* adds the notifications the grpc-server refreshes its views on to a database created before them */
+CREATE OR REPLACE FUNCTION NotifyDataChanged() RETURNS trigger
+AS $$ BEGIN
+    PERFORM pg_notify('heroball_data_changed', TG_TABLE_NAME);
+    RETURN NULL;
+END; $$
+LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS GamesChanged ON Games;
+CREATE TRIGGER GamesChanged
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON Games
+    FOR EACH STATEMENT EXECUTE FUNCTION NotifyDataChanged();
+
+DROP TRIGGER IF EXISTS PlayerGameStatsChanged ON PlayerGameStats;
+CREATE TRIGGER PlayerGameStatsChanged
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON PlayerGameStats
+    FOR EACH STATEMENT EXECUTE FUNCTION NotifyDataChanged();
+
+/* REFRESH MATERIALIZED VIEW CONCURRENTLY needs a unique index */
+CREATE UNIQUE INDEX IF NOT EXISTS GameScoresViewGameId ON GameScoresView (GameId);
@@ -3,8 +3,12 @@ module github.com/mlv9/heroball-server
 go 1.16
 
 require (
-	github.com/grpc-ecosystem/grpc-gateway v1.16.0 // indirect
+	github.com/grpc-ecosystem/grpc-gateway v1.16.0
 	github.com/lib/pq v1.10.0
-	github.com/mlv9/protobuf v0.0.0-20210410021441-1599b3b032b0 // indirect
+	github.com/mlv9/protobuf v0.0.0-20210410021441-1599b3b032b0
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
 	google.golang.org/grpc v1.37.0
+	google.golang.org/protobuf v1.25.0
 )
+
+replace github.com/mlv9/protobuf => ./protobuf
@@ -18,7 +18,8 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}))
+	marshaler := &runtime.JSONPb{OrigName: true, EmitDefaults: true}
+	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, marshaler))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 
 	serverLocation, exists := os.LookupEnv("GRPC_SERVER")
@@ -42,12 +43,26 @@ func main() {
 		return
 	}
 
-	err := pb.RegisterHeroBallServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("%v:%v", serverLocation, serverPort), opts)
+	endpoint := fmt.Sprintf("%v:%v", serverLocation, serverPort)
+
+	err := pb.RegisterHeroBallServiceHandlerFromEndpoint(ctx, mux, endpoint, opts)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	/* the events bridge needs its own client, the gateway's is internal to mux */
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer conn.Close()
+
+	httpMux := http.NewServeMux()
+	httpMux.Handle(gameEventsPath, gameEventsHandler(pb.NewHeroBallServiceClient(conn), marshaler))
+	httpMux.Handle("/", mux)
+
 	log.Printf("Binding GRPC to %v\n", gatewayBind)
 
-	log.Fatal(http.ListenAndServe(gatewayBind, mux))
+	log.Fatal(http.ListenAndServe(gatewayBind, httpMux))
 }
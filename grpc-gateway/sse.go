@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/mlv9/protobuf"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	/* GET gameEventsPath + gameId */
+	gameEventsPath = "/v1/events/game/"
+
+	/* keeps idle proxies from closing a quiet stream */
+	sseKeepAliveInterval = 15 * time.Second
+)
+
+/* bridges StreamGame onto server-sent events, for browsers that can use EventSource */
+func gameEventsHandler(client pb.HeroBallServiceClient, marshaler runtime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		gameId, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, gameEventsPath), 10, 32)
+
+		if err != nil || gameId <= 0 {
+			http.Error(w, "Invalid gameId", http.StatusBadRequest)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+
+		if !ok {
+			http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		stream, err := client.StreamGame(withAuthorization(r), &pb.StreamGameRequest{
+			GameId: int32(gameId),
+		})
+
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		/* Recv blocks, so it gets its own goroutine and we can keep alive in between */
+		updates := make(chan *pb.GameUpdate)
+		failed := make(chan error, 1)
+
+		go func() {
+			for {
+				update, err := stream.Recv()
+
+				if err != nil {
+					failed <- err
+					return
+				}
+
+				select {
+				case updates <- update:
+				case <-r.Context().Done():
+					return
+				}
+			}
+		}()
+
+		keepAlive := time.NewTicker(sseKeepAliveInterval)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case update := <-updates:
+
+				data, err := marshaler.Marshal(update)
+
+				if err != nil {
+					log.Printf("Error marshalling game update: %v", err)
+					return
+				}
+
+				fmt.Fprintf(w, "event: update\ndata: %s\n\n", data)
+
+			case err := <-failed:
+
+				if err != io.EOF && r.Context().Err() == nil {
+					st := status.Convert(err)
+					data, _ := json.Marshal(map[string]interface{}{
+						"code":    st.Code(),
+						"message": st.Message(),
+					})
+					fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+					flusher.Flush()
+				}
+
+				return
+
+			case <-keepAlive.C:
+				fmt.Fprintf(w, ": keep-alive\n\n")
+
+			case <-r.Context().Done():
+				return
+			}
+
+			flusher.Flush()
+		}
+	}
+}
+
+/*
+ * mux forwards Authorization itself, but this bridge calls the client directly.
+ * EventSource can't set headers, so an access_token query parameter works too
+ */
+func withAuthorization(r *http.Request) context.Context {
+
+	authorization := r.Header.Get("Authorization")
+
+	if authorization == "" && r.URL.Query().Get("access_token") != "" {
+		authorization = "Bearer " + r.URL.Query().Get("access_token")
+	}
+
+	if authorization == "" {
+		return r.Context()
+	}
+
+	return metadata.AppendToOutgoingContext(r.Context(), "authorization", authorization)
+}
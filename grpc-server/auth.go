@@ -0,0 +1,328 @@
+package main
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+)
+
+/* allowed drift between our clock and the token issuer's */
+const tokenClockSkew = time.Minute
+
+/* the roles a token can grant, scoped by leagueId, competitionId or teamId, or everywhere without one */
+const (
+	roleViewer      = "viewer"
+	roleScorer      = "scorer"
+	roleTeamManager = "team-manager"
+	roleLeagueAdmin = "league-admin"
+)
+
+var knownRoles = map[string]bool{
+	roleViewer:      true,
+	roleScorer:      true,
+	roleTeamManager: true,
+	roleLeagueAdmin: true,
+}
+
+/* a key tokens are verified against, HS256 keys have a secret and RS256 or ES256 keys a public key */
+type tokenKey struct {
+	alg       string
+	secret    []byte
+	publicKey crypto.PublicKey
+}
+
+/* the keys file, each secret is base64 and each publicKey PEM */
+type tokenKeysFile struct {
+	Keys []struct {
+		Kid       string `json:"kid"`
+		Alg       string `json:"alg"`
+		Secret    string `json:"secret"`
+		PublicKey string `json:"publicKey"`
+	} `json:"keys"`
+}
+
+type roleGrant struct {
+	Role          string `json:"role"`
+	LeagueId      int32  `json:"leagueId"`
+	CompetitionId int32  `json:"competitionId"`
+	TeamId        int32  `json:"teamId"`
+}
+
+/* aud may be a string or a list */
+type tokenAudience []string
+
+func (audience *tokenAudience) UnmarshalJSON(data []byte) error {
+
+	var single string
+
+	if json.Unmarshal(data, &single) == nil {
+		*audience = tokenAudience{single}
+		return nil
+	}
+
+	var list []string
+
+	err := json.Unmarshal(data, &list)
+
+	if err != nil {
+		return fmt.Errorf("Invalid aud: %v", err)
+	}
+
+	*audience = list
+
+	return nil
+}
+
+type tokenClaims struct {
+	Subject   string        `json:"sub"`
+	Issuer    string        `json:"iss"`
+	Audience  tokenAudience `json:"aud"`
+	ExpiresAt int64         `json:"exp"`
+	NotBefore int64         `json:"nbf"`
+	Roles     []roleGrant   `json:"roles"`
+}
+
+/* checks bearer tokens, signed JWTs whose roles claim lists the caller's grants */
+type tokenVerifier struct {
+	/* by kid, a token without a kid needs there to be a single key */
+	keys map[string]tokenKey
+	/* checked when set */
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+func loadTokenKeys(path string) (map[string]tokenKey, error) {
+
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error reading keys file: %v", err)
+	}
+
+	file := tokenKeysFile{}
+
+	err = json.Unmarshal(data, &file)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing keys file: %v", err)
+	}
+
+	if len(file.Keys) == 0 {
+		return nil, fmt.Errorf("No keys in %v", path)
+	}
+
+	keys := make(map[string]tokenKey)
+
+	for i, entry := range file.Keys {
+
+		if _, exists := keys[entry.Kid]; exists {
+			return nil, fmt.Errorf("Key %v reuses kid %q", i, entry.Kid)
+		}
+
+		key := tokenKey{alg: entry.Alg}
+
+		switch entry.Alg {
+		case "HS256":
+
+			key.secret, err = base64.StdEncoding.DecodeString(entry.Secret)
+
+			if err != nil {
+				return nil, fmt.Errorf("Key %q has an invalid secret: %v", entry.Kid, err)
+			}
+
+			if len(key.secret) < sha256.Size {
+				return nil, fmt.Errorf("Key %q needs a secret of at least %v bytes", entry.Kid, sha256.Size)
+			}
+
+		case "RS256", "ES256":
+
+			block, _ := pem.Decode([]byte(entry.PublicKey))
+
+			if block == nil {
+				return nil, fmt.Errorf("Key %q has no PEM public key", entry.Kid)
+			}
+
+			key.publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+
+			if err != nil {
+				return nil, fmt.Errorf("Key %q has an invalid public key: %v", entry.Kid, err)
+			}
+
+			_, isRSA := key.publicKey.(*rsa.PublicKey)
+			_, isECDSA := key.publicKey.(*ecdsa.PublicKey)
+
+			if (entry.Alg == "RS256" && !isRSA) || (entry.Alg == "ES256" && !isECDSA) {
+				return nil, fmt.Errorf("Key %q is not an %v key", entry.Kid, entry.Alg)
+			}
+
+		default:
+			return nil, fmt.Errorf("Key %q has unsupported alg %q, must be HS256, RS256 or ES256", entry.Kid, entry.Alg)
+		}
+
+		keys[entry.Kid] = key
+	}
+
+	return keys, nil
+}
+
+/* the claims of a valid token, the error is safe to return to the caller */
+func (verifier *tokenVerifier) verify(token string) (*tokenClaims, error) {
+
+	parts := strings.Split(token, ".")
+
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Token is not a JWT")
+	}
+
+	header := struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}{}
+
+	err := decodeTokenPart(parts[0], &header)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, found := verifier.keys[header.Kid]
+
+	if !found && header.Kid == "" && len(verifier.keys) == 1 {
+		for _, only := range verifier.keys {
+			key, found = only, true
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("Token is signed with an unknown key")
+	}
+
+	/* the key decides the algorithm, so a token can't downgrade to none or pass a public key off as a secret */
+	if header.Alg != key.alg {
+		return nil, fmt.Errorf("Token is not signed with %v", key.alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+
+	if err != nil {
+		return nil, fmt.Errorf("Token signature is not base64url")
+	}
+
+	if !key.verifySignature([]byte(parts[0]+"."+parts[1]), signature) {
+		return nil, fmt.Errorf("Token signature is invalid")
+	}
+
+	claims := &tokenClaims{}
+
+	err = decodeTokenPart(parts[1], claims)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := verifier.now()
+
+	if claims.ExpiresAt == 0 {
+		return nil, fmt.Errorf("Token has no expiry")
+	}
+
+	if now.After(time.Unix(claims.ExpiresAt, 0).Add(tokenClockSkew)) {
+		return nil, fmt.Errorf("Token has expired")
+	}
+
+	if claims.NotBefore != 0 && now.Add(tokenClockSkew).Before(time.Unix(claims.NotBefore, 0)) {
+		return nil, fmt.Errorf("Token is not valid yet")
+	}
+
+	if verifier.issuer != "" && claims.Issuer != verifier.issuer {
+		return nil, fmt.Errorf("Token has the wrong issuer")
+	}
+
+	if verifier.audience != "" && !containsString(claims.Audience, verifier.audience) {
+		return nil, fmt.Errorf("Token is not for this audience")
+	}
+
+	for _, grant := range claims.Roles {
+
+		if !knownRoles[grant.Role] {
+			return nil, fmt.Errorf("Token has unrecognised role %q", grant.Role)
+		}
+
+		scopes := 0
+
+		for _, id := range []int32{grant.LeagueId, grant.CompetitionId, grant.TeamId} {
+			if id != 0 {
+				scopes++
+			}
+		}
+
+		if scopes > 1 {
+			return nil, fmt.Errorf("Token grants %v to more than one of a league, competition and team", grant.Role)
+		}
+	}
+
+	return claims, nil
+}
+
+func (key tokenKey) verifySignature(signed []byte, signature []byte) bool {
+
+	digest := sha256.Sum256(signed)
+
+	switch key.alg {
+	case "HS256":
+		mac := hmac.New(sha256.New, key.secret)
+		mac.Write(signed)
+		return hmac.Equal(mac.Sum(nil), signature)
+	case "RS256":
+		return rsa.VerifyPKCS1v15(key.publicKey.(*rsa.PublicKey), crypto.SHA256, digest[:], signature) == nil
+	case "ES256":
+		/* JWS puts r and s side by side rather than ASN.1 */
+		if len(signature) != 64 {
+			return false
+		}
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(key.publicKey.(*ecdsa.PublicKey), digest[:], r, s)
+	}
+
+	return false
+}
+
+func decodeTokenPart(part string, into interface{}) error {
+
+	data, err := base64.RawURLEncoding.DecodeString(part)
+
+	if err != nil {
+		return fmt.Errorf("Token is not base64url")
+	}
+
+	err = json.Unmarshal(data, into)
+
+	if err != nil {
+		return fmt.Errorf("Token is not valid JSON")
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+var tokenTestNow = time.Date(2021, 9, 4, 10, 0, 0, 0, time.UTC)
+
+func tokenTestSecret(fill byte) []byte {
+	return []byte(strings.Repeat(string(fill), sha256.Size))
+}
+
+/* a JWT of the header and claims signed with HS256 under secret, or unsigned without one */
+func signTestToken(t testing.TB, secret []byte, header map[string]interface{}, claims map[string]interface{}) string {
+
+	parts := make([]string, 0, 3)
+
+	for _, part := range []interface{}{header, claims} {
+
+		data, err := json.Marshal(part)
+
+		if err != nil {
+			t.Fatalf("Error encoding token: %v", err)
+		}
+
+		parts = append(parts, base64.RawURLEncoding.EncodeToString(data))
+	}
+
+	signed := strings.Join(parts, ".")
+
+	if secret == nil {
+		return signed + "."
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+/* the token with its claims swapped for others, keeping the signature */
+func withTestClaims(t testing.TB, token string, claims map[string]interface{}) string {
+
+	parts := strings.Split(token, ".")
+	swapped := strings.Split(signTestToken(t, nil, nil, claims), ".")
+
+	return parts[0] + "." + swapped[1] + "." + parts[2]
+}
+
+/* claims that verify, with roles added by the caller */
+func tokenTestClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub": "scorer@example.com",
+		"exp": tokenTestNow.Add(time.Hour).Unix(),
+	}
+}
+
+func TestVerifyToken(t *testing.T) {
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("Error generating key: %v", err)
+	}
+
+	ecPublic, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+
+	if err != nil {
+		t.Fatalf("Error encoding key: %v", err)
+	}
+
+	hsKey := tokenKey{alg: "HS256", secret: tokenTestSecret('a')}
+
+	manyKeys := map[string]tokenKey{
+		"hs":     hsKey,
+		"hs-old": {alg: "HS256", secret: tokenTestSecret('b')},
+		"es":     {alg: "ES256", publicKey: &ecKey.PublicKey},
+	}
+
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+
+		claims := tokenTestClaims()
+
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+
+		return claims
+	}
+
+	tests := []struct {
+		name  string
+		keys  map[string]tokenKey
+		token string
+		/* empty when the token is valid */
+		err string
+	}{
+		{
+			name:  "valid",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "hs"}, tokenTestClaims()),
+		},
+		{
+			name:  "no kid with a single key",
+			keys:  map[string]tokenKey{"hs": hsKey},
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256"}, tokenTestClaims()),
+		},
+		{
+			name:  "no kid with several keys",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256"}, tokenTestClaims()),
+			err:   "Token is signed with an unknown key",
+		},
+		{
+			name:  "unknown kid",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "other"}, tokenTestClaims()),
+			err:   "Token is signed with an unknown key",
+		},
+		{
+			name:  "alg none",
+			keys:  manyKeys,
+			token: signTestToken(t, nil, map[string]interface{}{"alg": "none", "kid": "hs"}, tokenTestClaims()),
+			err:   "Token is not signed with HS256",
+		},
+		{
+			/* the public key passed off as an HMAC secret */
+			name:  "alg not the key's",
+			keys:  manyKeys,
+			token: signTestToken(t, ecPublic, map[string]interface{}{"alg": "HS256", "kid": "es"}, tokenTestClaims()),
+			err:   "Token is not signed with ES256",
+		},
+		{
+			name:  "bad signature",
+			keys:  manyKeys,
+			token: signTestToken(t, tokenTestSecret('b'), map[string]interface{}{"alg": "HS256", "kid": "hs"}, tokenTestClaims()),
+			err:   "Token signature is invalid",
+		},
+		{
+			name:  "claims changed after signing",
+			keys:  manyKeys,
+			token: withTestClaims(t, signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "hs"}, tokenTestClaims()), withClaim("sub", "admin@example.com")),
+			err:   "Token signature is invalid",
+		},
+		{
+			name:  "missing exp",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "hs"}, withClaim("exp", nil)),
+			err:   "Token has no expiry",
+		},
+		{
+			name:  "expired",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "hs"}, withClaim("exp", tokenTestNow.Add(-2*time.Minute).Unix())),
+			err:   "Token has expired",
+		},
+		{
+			name:  "expired within the clock skew",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "hs"}, withClaim("exp", tokenTestNow.Add(-30*time.Second).Unix())),
+		},
+		{
+			name:  "not valid yet",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "hs"}, withClaim("nbf", tokenTestNow.Add(2*time.Minute).Unix())),
+			err:   "Token is not valid yet",
+		},
+		{
+			name:  "unrecognised role",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "hs"}, withClaim("roles", []roleGrant{{Role: "owner"}})),
+			err:   "Token has unrecognised role \"owner\"",
+		},
+		{
+			name:  "role with two scopes",
+			keys:  manyKeys,
+			token: signTestToken(t, hsKey.secret, map[string]interface{}{"alg": "HS256", "kid": "hs"}, withClaim("roles", []roleGrant{{Role: roleScorer, LeagueId: 1, TeamId: 2}})),
+			err:   "Token grants scorer to more than one of a league, competition and team",
+		},
+		{
+			name:  "not a JWT",
+			keys:  manyKeys,
+			token: "token",
+			err:   "Token is not a JWT",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			verifier := &tokenVerifier{
+				keys: test.keys,
+				now:  func() time.Time { return tokenTestNow },
+			}
+
+			claims, err := verifier.verify(test.token)
+
+			if test.err == "" {
+
+				if err != nil {
+					t.Fatalf("Error verifying token: %v", err)
+				}
+
+				if claims.Subject != "scorer@example.com" {
+					t.Errorf("Got subject %q, want scorer@example.com", claims.Subject)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != test.err {
+				t.Errorf("Got %v, want %q", err, test.err)
+			}
+		})
+	}
+}
@@ -2,26 +2,49 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/mlv9/heroball-server/database"
 	pb "github.com/mlv9/protobuf"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 type HeroBall struct {
-	db *HeroBallDatabase
+	db *database.HeroBallDatabase
 }
 
-func NewHeroBallService(dbstring string) (*HeroBall, error) {
+func NewHeroBallService(dbstring string, refreshDebounce time.Duration, refreshMaxDelay time.Duration) (*HeroBall, error) {
 
-	db, err := NewHeroBallDatabase(dbstring)
+	db, err := database.NewHeroBallDatabase(dbstring)
 
 	if err != nil {
 		return nil, err
 	}
 
+	err = db.StartViewRefresher(refreshDebounce, refreshMaxDelay)
+
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	err = db.StartGameFeed()
+
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	service := &HeroBall{
 		db: db,
 	}
@@ -29,27 +52,160 @@ func NewHeroBallService(dbstring string) (*HeroBall, error) {
 	return service, nil
 }
 
-func (hb *HeroBall) Serve(address string) error {
+type ServeOptions struct {
+	/* register the reflection service, for grpcurl and the like */
+	Reflection bool
+	/* how long in-flight calls get to finish after SIGINT or SIGTERM */
+	DrainTimeout time.Duration
+	/* how often the database is pinged for the health service */
+	HealthCheckInterval time.Duration
+	/* deadline for calls that arrive without one, zero for none */
+	StatementTimeout time.Duration
+	/* checks each call's bearer token, nil leaves every call open */
+	Tokens *tokenVerifier
+	/* let reads through without a token */
+	PublicReads bool
+}
+
+/* serves until SIGINT or SIGTERM, then drains and closes the database */
+func (hb *HeroBall) Serve(address string, options ServeOptions) error {
+
+	lis, err := net.Listen("tcp", address)
+
+	if err != nil {
+		hb.db.Close()
+		return fmt.Errorf("Failed to listen on %v: %v", address, err)
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{statusInterceptor(options.StatementTimeout)}
+	streamInterceptors := []grpc.StreamServerInterceptor{streamStatusInterceptor}
+
+	/* inside the status interceptor, so looking up what a call touches has its deadline */
+	if options.Tokens != nil {
 
-	grpcServer := grpc.NewServer()
+		auth := &authorizer{
+			verifier:    options.Tokens,
+			db:          hb.db,
+			publicReads: options.PublicReads,
+		}
+
+		unaryInterceptors = append(unaryInterceptors, auth.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, auth.streamInterceptor)
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...))
 
 	pb.RegisterHeroBallServiceServer(grpcServer, hb)
 
-	lis, err := net.Listen("tcp", address)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	if options.Reflection {
+		reflection.Register(grpcServer)
+	}
+
+	watchContext, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+
+	go hb.watchHealth(watchContext, healthServer, options.HealthCheckInterval)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	served := make(chan error, 1)
+
+	go func() {
+		served <- grpcServer.Serve(lis)
+	}()
+
+	select {
+	case err = <-served:
+		/* serving failed by itself, nothing to drain */
+
+	case sig := <-signals:
+
+		log.Printf("Received %v, draining for up to %v", sig, options.DrainTimeout)
+
+		/* stop new work being routed to us while we drain */
+		healthServer.Shutdown()
+
+		stopped := make(chan struct{})
+
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(options.DrainTimeout):
+			log.Printf("Drain timed out, stopping")
+			grpcServer.Stop()
+		}
+
+		err = <-served
+	}
+
+	stopWatching()
+
+	closeErr := hb.db.Close()
 
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		return err
+	}
+
+	if closeErr != nil {
+		return fmt.Errorf("Error closing db: %v", closeErr)
 	}
 
-	grpcServer.Serve(lis)
+	log.Printf("Stopped")
 
 	return nil
 }
 
-func (hb *HeroBall) GetPlayerInfo(context context.Context, request *pb.GetPlayerInfoRequest) (*pb.PlayerInfo, error) {
+/*
+ * bounds calls without a deadline by statementTimeout, so an abandoned request
+ * cannot leave its SQL running. Handlers return database errors as is, only their
+ * status reaches the client
+ */
+func statusInterceptor(statementTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+		if _, hasDeadline := ctx.Deadline(); !hasDeadline && statementTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, statementTimeout)
+			defer cancel()
+		}
+
+		response, err := handler(ctx, request)
+
+		if err != nil {
+			return nil, database.ContextStatusError(ctx, err)
+		}
+
+		return response, nil
+	}
+}
+
+/* streams are long lived so get no timeout, but their errors are mapped the same way */
+func streamStatusInterceptor(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	err := handler(server, stream)
+
+	if err != nil {
+		return database.ContextStatusError(stream.Context(), err)
+	}
+
+	return nil
+}
+
+func (hb *HeroBall) GetPlayerInfo(ctx context.Context, request *pb.GetPlayerInfoRequest) (*pb.PlayerInfo, error) {
 
 	/* pass to database layer */
-	info, err := hb.db.GetPlayerInfo(request.GetPlayerId())
+	info, err := hb.db.GetPlayerInfo(ctx, request.GetPlayerId())
 
 	if err != nil {
 		log.Printf("Error getting player info: %v", err)
@@ -59,10 +215,10 @@ func (hb *HeroBall) GetPlayerInfo(context context.Context, request *pb.GetPlayer
 	return info, nil
 }
 
-func (hb *HeroBall) GetGames(context context.Context, request *pb.GetGamesRequest) (*pb.GamesCursor, error) {
+func (hb *HeroBall) GetGames(ctx context.Context, request *pb.GetGamesRequest) (*pb.GamesCursor, error) {
 
 	/* pass to database layer */
-	games, err := hb.db.GetGamesCursor(request.GetOffset(), request.GetCount(), request.GetFilter())
+	games, err := hb.db.GetGamesCursor(ctx, request.GetOffset(), request.GetCount(), request.GetFilter())
 
 	if err != nil {
 		log.Printf("Error getting games cursor: %v", err)
@@ -72,10 +228,10 @@ func (hb *HeroBall) GetGames(context context.Context, request *pb.GetGamesReques
 	return games, nil
 }
 
-func (hb *HeroBall) GetPlayers(context context.Context, request *pb.GetPlayersRequest) (*pb.PlayersCursor, error) {
+func (hb *HeroBall) GetPlayers(ctx context.Context, request *pb.GetPlayersRequest) (*pb.PlayersCursor, error) {
 
 	/* pass to database layer */
-	players, err := hb.db.GetPlayersCursor(request.GetOffset(), request.GetCount(), request.GetFilter())
+	players, err := hb.db.GetPlayersCursor(ctx, request.GetOffset(), request.GetCount(), request.GetFilter())
 
 	if err != nil {
 		log.Printf("Error getting players cursor: %v", err)
@@ -85,10 +241,10 @@ func (hb *HeroBall) GetPlayers(context context.Context, request *pb.GetPlayersRe
 	return players, nil
 }
 
-func (hb *HeroBall) GetCompetitionInfo(context context.Context, request *pb.GetCompetitionInfoRequest) (*pb.CompetitionInfo, error) {
+func (hb *HeroBall) GetCompetitionInfo(ctx context.Context, request *pb.GetCompetitionInfoRequest) (*pb.CompetitionInfo, error) {
 
 	/* pass to database layer */
-	info, err := hb.db.GetCompetitionInfo(request.GetCompetitionId())
+	info, err := hb.db.GetCompetitionInfo(ctx, request.GetCompetitionId())
 
 	if err != nil {
 		log.Printf("Error getting competition info: %v", err)
@@ -98,10 +254,10 @@ func (hb *HeroBall) GetCompetitionInfo(context context.Context, request *pb.GetC
 	return info, nil
 }
 
-func (hb *HeroBall) GetGameInfo(context context.Context, request *pb.GetGameInfoRequest) (*pb.GameInfo, error) {
+func (hb *HeroBall) GetGameInfo(ctx context.Context, request *pb.GetGameInfoRequest) (*pb.GameInfo, error) {
 
 	/* pass to database layer */
-	info, err := hb.db.GetGameInfo(request.GetGameId())
+	info, err := hb.db.GetGameInfo(ctx, request.GetGameId())
 
 	if err != nil {
 		log.Printf("Error getting game info: %v", err)
@@ -111,10 +267,10 @@ func (hb *HeroBall) GetGameInfo(context context.Context, request *pb.GetGameInfo
 	return info, nil
 }
 
-func (hb *HeroBall) GetTeamInfo(context context.Context, request *pb.GetTeamInfoRequest) (*pb.TeamInfo, error) {
+func (hb *HeroBall) GetTeamInfo(ctx context.Context, request *pb.GetTeamInfoRequest) (*pb.TeamInfo, error) {
 
 	/* pass to database layer */
-	info, err := hb.db.GetTeamInfo(request.GetTeamId())
+	info, err := hb.db.GetTeamInfo(ctx, request.GetTeamId(), request.GetCompetitionId())
 
 	if err != nil {
 		log.Printf("Error getting team info: %v", err)
@@ -124,9 +280,45 @@ func (hb *HeroBall) GetTeamInfo(context context.Context, request *pb.GetTeamInfo
 	return info, nil
 }
 
-func (hb *HeroBall) GetHeroBallMetadata(context context.Context, request *pb.GetHeroBallMetadataRequest) (*pb.HeroBallMetadata, error) {
+func (hb *HeroBall) GetPlayerSplits(ctx context.Context, request *pb.GetPlayerSplitsRequest) (*pb.PlayerSplits, error) {
+
+	splits, err := hb.db.GetPlayerSplits(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting player splits: %v", err)
+		return nil, err
+	}
+
+	return splits, nil
+}
+
+func (hb *HeroBall) GetRecordsBook(ctx context.Context, request *pb.GetRecordsBookRequest) (*pb.RecordsBook, error) {
+
+	book, err := hb.db.GetRecordsBook(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting records book: %v", err)
+		return nil, err
+	}
+
+	return book, nil
+}
+
+func (hb *HeroBall) GetHeadToHead(ctx context.Context, request *pb.GetHeadToHeadRequest) (*pb.HeadToHead, error) {
 
-	values, err := hb.db.GetHeroBallMetadata(request)
+	headToHead, err := hb.db.GetHeadToHead(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting head to head: %v", err)
+		return nil, err
+	}
+
+	return headToHead, nil
+}
+
+func (hb *HeroBall) GetHeroBallMetadata(ctx context.Context, request *pb.GetHeroBallMetadataRequest) (*pb.HeroBallMetadata, error) {
+
+	values, err := hb.db.GetHeroBallMetadata(ctx, request)
 
 	if err != nil {
 		log.Printf("Error getting heroball metadata: %v", err)
@@ -136,9 +328,9 @@ func (hb *HeroBall) GetHeroBallMetadata(context context.Context, request *pb.Get
 	return values, nil
 }
 
-func (hb *HeroBall) GetPlayerAverageStats(context context.Context, request *pb.GetPlayerAverageStatsRequest) (*pb.GetPlayerAverageStatsResponse, error) {
+func (hb *HeroBall) GetPlayerAverageStats(ctx context.Context, request *pb.GetPlayerAverageStatsRequest) (*pb.GetPlayerAverageStatsResponse, error) {
 
-	values, err := hb.db.GetPlayerAverageStats(request)
+	values, err := hb.db.GetPlayerAverageStats(ctx, request)
 
 	if err != nil {
 		log.Printf("Error getting stats: %v", err)
@@ -148,9 +340,21 @@ func (hb *HeroBall) GetPlayerAverageStats(context context.Context, request *pb.G
 	return values, nil
 }
 
-func (hb *HeroBall) GetPlayerGamesStats(context context.Context, request *pb.GetPlayerGamesStatsRequest) (*pb.GetPlayerGamesStatsResponse, error) {
+func (hb *HeroBall) GetTeamAverageStats(ctx context.Context, request *pb.GetTeamAverageStatsRequest) (*pb.GetTeamAverageStatsResponse, error) {
 
-	values, err := hb.db.GetPlayerGamesStats(request)
+	values, err := hb.db.GetTeamAverageStats(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting team stats: %v", err)
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func (hb *HeroBall) GetPlayerGamesStats(ctx context.Context, request *pb.GetPlayerGamesStatsRequest) (*pb.GetPlayerGamesStatsResponse, error) {
+
+	values, err := hb.db.GetPlayerGamesStats(ctx, request)
 
 	if err != nil {
 		log.Printf("Error getting stats: %v", err)
@@ -159,3 +363,311 @@ func (hb *HeroBall) GetPlayerGamesStats(context context.Context, request *pb.Get
 
 	return values, nil
 }
+
+func (hb *HeroBall) CreateGame(ctx context.Context, request *pb.CreateGameRequest) (*pb.CreateGameResponse, error) {
+
+	response, err := hb.db.CreateGame(ctx, request)
+
+	if err != nil {
+		log.Printf("Error creating game: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) UpdateGame(ctx context.Context, request *pb.UpdateGameRequest) (*pb.UpdateGameResponse, error) {
+
+	response, err := hb.db.UpdateGame(ctx, request)
+
+	if err != nil {
+		log.Printf("Error updating game: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) DeleteGame(ctx context.Context, request *pb.DeleteGameRequest) (*pb.DeleteGameResponse, error) {
+
+	response, err := hb.db.DeleteGame(ctx, request)
+
+	if err != nil {
+		log.Printf("Error deleting game: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) UpsertPlayerGameStats(ctx context.Context, request *pb.UpsertPlayerGameStatsRequest) (*pb.UpsertPlayerGameStatsResponse, error) {
+
+	response, err := hb.db.UpsertPlayerGameStats(ctx, request)
+
+	if err != nil {
+		log.Printf("Error upserting player game stats: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) UpdateCompetitionRoster(ctx context.Context, request *pb.UpdateCompetitionRosterRequest) (*pb.UpdateCompetitionRosterResponse, error) {
+
+	response, err := hb.db.UpdateCompetitionRoster(ctx, request)
+
+	if err != nil {
+		log.Printf("Error updating roster: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) RefreshViews(ctx context.Context, request *pb.RefreshViewsRequest) (*pb.RefreshViewsResponse, error) {
+
+	response, err := hb.db.RefreshViews(ctx, request)
+
+	if err != nil {
+		log.Printf("Error refreshing views: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) GenerateSchedule(ctx context.Context, request *pb.GenerateScheduleRequest) (*pb.GenerateScheduleResponse, error) {
+
+	response, err := hb.db.GenerateSchedule(ctx, request)
+
+	if err != nil {
+		log.Printf("Error generating schedule: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) CreateBracket(ctx context.Context, request *pb.CreateBracketRequest) (*pb.Bracket, error) {
+
+	bracket, err := hb.db.CreateBracket(ctx, request)
+
+	if err != nil {
+		log.Printf("Error creating bracket: %v", err)
+		return nil, err
+	}
+
+	return bracket, nil
+}
+
+func (hb *HeroBall) GetBracket(ctx context.Context, request *pb.GetBracketRequest) (*pb.Bracket, error) {
+
+	bracket, err := hb.db.GetBracket(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting bracket: %v", err)
+		return nil, err
+	}
+
+	return bracket, nil
+}
+
+func (hb *HeroBall) UpdateGamePeriodScores(ctx context.Context, request *pb.UpdateGamePeriodScoresRequest) (*pb.UpdateGamePeriodScoresResponse, error) {
+
+	response, err := hb.db.UpdateGamePeriodScores(ctx, request)
+
+	if err != nil {
+		log.Printf("Error updating game period scores: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) UpdateCompetitionPeriods(ctx context.Context, request *pb.UpdateCompetitionPeriodsRequest) (*pb.UpdateCompetitionPeriodsResponse, error) {
+
+	response, err := hb.db.UpdateCompetitionPeriods(ctx, request)
+
+	if err != nil {
+		log.Printf("Error updating competition periods: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) UpdateStandingsRules(ctx context.Context, request *pb.UpdateStandingsRulesRequest) (*pb.UpdateStandingsRulesResponse, error) {
+
+	response, err := hb.db.UpdateStandingsRules(ctx, request)
+
+	if err != nil {
+		log.Printf("Error updating standings rules: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) RecordEvent(ctx context.Context, request *pb.RecordEventRequest) (*pb.RecordEventResponse, error) {
+
+	response, err := hb.db.RecordEvent(ctx, request)
+
+	if err != nil {
+		log.Printf("Error recording event: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) UndoEvent(ctx context.Context, request *pb.UndoEventRequest) (*pb.UndoEventResponse, error) {
+
+	response, err := hb.db.UndoEvent(ctx, request)
+
+	if err != nil {
+		log.Printf("Error undoing event: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) ListEvents(ctx context.Context, request *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+
+	response, err := hb.db.ListEvents(ctx, request)
+
+	if err != nil {
+		log.Printf("Error listing events: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) StreamGame(request *pb.StreamGameRequest, stream pb.HeroBallService_StreamGameServer) error {
+
+	log.Printf("Streaming game %v", request.GetGameId())
+
+	err := hb.db.StreamGame(stream.Context(), request.GetGameId(), stream.Send)
+
+	/* the client going away is the normal end of a stream */
+	if err != nil && stream.Context().Err() == nil {
+		log.Printf("Error streaming game: %v", err)
+	}
+
+	return err
+}
+
+func (hb *HeroBall) RecordSubstitution(ctx context.Context, request *pb.RecordSubstitutionRequest) (*pb.RecordSubstitutionResponse, error) {
+
+	response, err := hb.db.RecordSubstitution(ctx, request)
+
+	if err != nil {
+		log.Printf("Error recording substitution: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) UndoSubstitution(ctx context.Context, request *pb.UndoSubstitutionRequest) (*pb.UndoSubstitutionResponse, error) {
+
+	response, err := hb.db.UndoSubstitution(ctx, request)
+
+	if err != nil {
+		log.Printf("Error undoing substitution: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) GetLineupStats(ctx context.Context, request *pb.GetLineupStatsRequest) (*pb.GetLineupStatsResponse, error) {
+
+	response, err := hb.db.GetLineupStats(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting lineup stats: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) GetShotChart(ctx context.Context, request *pb.GetShotChartRequest) (*pb.ShotChart, error) {
+
+	response, err := hb.db.GetShotChart(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting shot chart: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) GetTeamRatings(ctx context.Context, request *pb.GetTeamRatingsRequest) (*pb.GetTeamRatingsResponse, error) {
+
+	response, err := hb.db.GetTeamRatings(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting team ratings: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) PredictGame(ctx context.Context, request *pb.PredictGameRequest) (*pb.GamePrediction, error) {
+
+	response, err := hb.db.PredictGame(ctx, request)
+
+	if err != nil {
+		log.Printf("Error predicting game: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) CreateAward(ctx context.Context, request *pb.CreateAwardRequest) (*pb.CreateAwardResponse, error) {
+
+	response, err := hb.db.CreateAward(ctx, request)
+
+	if err != nil {
+		log.Printf("Error creating award: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) CastAwardVotes(ctx context.Context, request *pb.CastAwardVotesRequest) (*pb.CastAwardVotesResponse, error) {
+
+	err := bindAwardVoter(ctx, request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := hb.db.CastAwardVotes(ctx, request)
+
+	if err != nil {
+		log.Printf("Error casting award votes: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (hb *HeroBall) GetAwardLeaderboard(ctx context.Context, request *pb.GetAwardLeaderboardRequest) (*pb.AwardLeaderboard, error) {
+
+	response, err := hb.db.GetAwardLeaderboard(ctx, request)
+
+	if err != nil {
+		log.Printf("Error getting award leaderboard: %v", err)
+		return nil, err
+	}
+
+	return response, nil
+}
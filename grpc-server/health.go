@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	/* the name clients check for the HeroBall API itself, "" covers the whole server */
+	heroBallServiceName = "pb.HeroBallService"
+
+	DefaultHealthCheckInterval = 5 * time.Second
+	healthCheckTimeout         = 2 * time.Second
+)
+
+/* reports NOT_SERVING whenever postgres cannot be pinged */
+func (hb *HeroBall) watchHealth(ctx context.Context, healthServer *health.Server, interval time.Duration) {
+
+	if interval <= 0 {
+		interval = DefaultHealthCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last healthpb.HealthCheckResponse_ServingStatus
+
+	for {
+		pingContext, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := hb.db.Ping(pingContext)
+		cancel()
+
+		status := healthpb.HealthCheckResponse_SERVING
+
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+
+		if status != last {
+
+			if err != nil {
+				log.Printf("Health check failed: %v", err)
+			} else {
+				log.Printf("Health check passed")
+			}
+
+			healthServer.SetServingStatus("", status)
+			healthServer.SetServingStatus(heroBallServiceName, status)
+			last = status
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+/* asks the server on address for its health, for use as a container health check */
+func checkHealth(address string) error {
+
+	host, port, err := net.SplitHostPort(address)
+
+	if err != nil {
+		return fmt.Errorf("Invalid address %v: %v", address, err)
+	}
+
+	/* we bind to all interfaces but need a host to dial */
+	if host == "" {
+		host = "localhost"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, net.JoinHostPort(host, port), grpc.WithInsecure(), grpc.WithBlock())
+
+	if err != nil {
+		return fmt.Errorf("Error connecting to %v: %v", address, err)
+	}
+
+	defer conn.Close()
+
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{
+		Service: heroBallServiceName,
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error checking health: %v", err)
+	}
+
+	if response.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("Server is %v", response.GetStatus())
+	}
+
+	return nil
+}
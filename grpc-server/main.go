@@ -1,18 +1,91 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"time"
+
+	"github.com/mlv9/heroball-server/database"
+)
+
+const (
+	defaultDrainTimeout     = 10 * time.Second
+	defaultStatementTimeout = 10 * time.Second
 )
 
 func main() {
 
+	healthCheck := flag.Bool("health-check", false, "check the health of the server on GRPC_BIND_ADDR and exit")
+	flag.Parse()
+
+	if *healthCheck {
+
+		err := checkHealth(os.Getenv("GRPC_BIND_ADDR"))
+
+		if err != nil {
+			log.Fatalf("Unhealthy: %v", err)
+		}
+
+		return
+	}
+
 	log.Printf("Connecting to DB at %v\n", os.Getenv("POSTGRES_HOST"))
-	connStr := fmt.Sprintf("user=%v password=%v host=%v dbname=%v sslmode=disable", os.Getenv("POSTGRES_USER"), os.Getenv("POSTGRES_PASSWORD"), os.Getenv("POSTGRES_HOST"), os.Getenv("POSTGRES_DBNAME"))
+	connStr := database.ConnectionStringFromEnv()
+
+	refreshDebounce, err := durationFromEnv("VIEW_REFRESH_DEBOUNCE", database.DefaultRefreshDebounce)
+
+	if err != nil {
+		log.Printf("Error reading config: %v\n", err)
+		return
+	}
+
+	refreshMaxDelay, err := durationFromEnv("VIEW_REFRESH_MAX_DELAY", database.DefaultRefreshMaxDelay)
+
+	if err != nil {
+		log.Printf("Error reading config: %v\n", err)
+		return
+	}
+
+	drainTimeout, err := durationFromEnv("SHUTDOWN_DRAIN_TIMEOUT", defaultDrainTimeout)
+
+	if err != nil {
+		log.Printf("Error reading config: %v\n", err)
+		return
+	}
+
+	healthCheckInterval, err := durationFromEnv("HEALTH_CHECK_INTERVAL", DefaultHealthCheckInterval)
+
+	if err != nil {
+		log.Printf("Error reading config: %v\n", err)
+		return
+	}
+
+	statementTimeout, err := durationFromEnv("STATEMENT_TIMEOUT", defaultStatementTimeout)
+
+	if err != nil {
+		log.Printf("Error reading config: %v\n", err)
+		return
+	}
+
+	reflection, err := boolFromEnv("GRPC_REFLECTION")
+
+	if err != nil {
+		log.Printf("Error reading config: %v\n", err)
+		return
+	}
+
+	tokens, publicReads, err := tokenVerifierFromEnv()
+
+	if err != nil {
+		log.Printf("Error reading config: %v\n", err)
+		return
+	}
 
 	/* create the GRPC server */
-	server, err := NewHeroBallService(connStr)
+	server, err := NewHeroBallService(connStr, refreshDebounce, refreshMaxDelay)
 
 	if err != nil {
 		log.Printf("Error creating service: %v\n", err)
@@ -21,8 +94,97 @@ func main() {
 
 	log.Printf("Binding GRPC to %v\n", os.Getenv("GRPC_BIND_ADDR"))
 
-	if err := server.Serve(os.Getenv("GRPC_BIND_ADDR")); err != nil {
+	err = server.Serve(os.Getenv("GRPC_BIND_ADDR"), ServeOptions{
+		Reflection:          reflection,
+		DrainTimeout:        drainTimeout,
+		HealthCheckInterval: healthCheckInterval,
+		StatementTimeout:    statementTimeout,
+		Tokens:              tokens,
+		PublicReads:         publicReads,
+	})
+
+	if err != nil {
 		log.Fatal(err)
 		return
 	}
 }
+
+/* reads a duration such as "2s" from the environment, falling back when unset */
+func durationFromEnv(name string, fallback time.Duration) (time.Duration, error) {
+
+	value, exists := os.LookupEnv(name)
+
+	if !exists || value == "" {
+		return fallback, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+
+	if err != nil {
+		return 0, fmt.Errorf("Invalid %v: %v", name, err)
+	}
+
+	return duration, nil
+}
+
+/* nil when AUTH_DISABLED, which has to be said outright rather than implied by a missing keys file */
+func tokenVerifierFromEnv() (*tokenVerifier, bool, error) {
+
+	disabled, err := boolFromEnv("AUTH_DISABLED")
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if disabled {
+		log.Printf("Authentication is disabled, every call is allowed\n")
+		return nil, false, nil
+	}
+
+	publicReads, err := boolFromEnv("AUTH_PUBLIC_READS")
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	keysFile := os.Getenv("AUTH_KEYS_FILE")
+
+	if keysFile == "" {
+		return nil, false, fmt.Errorf("AUTH_KEYS_FILE is required unless AUTH_DISABLED is true")
+	}
+
+	keys, err := loadTokenKeys(keysFile)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	log.Printf("Loaded %v token keys from %v\n", len(keys), keysFile)
+
+	verifier := &tokenVerifier{
+		keys:     keys,
+		issuer:   os.Getenv("AUTH_ISSUER"),
+		audience: os.Getenv("AUTH_AUDIENCE"),
+		now:      time.Now,
+	}
+
+	return verifier, publicReads, nil
+}
+
+/* reads a flag such as "true" from the environment, false when unset */
+func boolFromEnv(name string) (bool, error) {
+
+	value, exists := os.LookupEnv(name)
+
+	if !exists || value == "" {
+		return false, nil
+	}
+
+	enabled, err := strconv.ParseBool(value)
+
+	if err != nil {
+		return false, fmt.Errorf("Invalid %v: %v", name, err)
+	}
+
+	return enabled, nil
+}
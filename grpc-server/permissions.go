@@ -0,0 +1,435 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/mlv9/heroball-server/database"
+	pb "github.com/mlv9/protobuf"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+/* only HeroBall calls are checked, health and reflection stay open */
+const heroBallMethodPrefix = "/" + heroBallServiceName + "/"
+
+/* any role can make these, or anyone with publicReads */
+var readMethods = map[string]bool{
+	"GetBracket":            true,
+	"ListEvents":            true,
+	"GetLineupStats":        true,
+	"GetShotChart":          true,
+	"GetTeamRatings":        true,
+	"PredictGame":           true,
+	"GetAwardLeaderboard":   true,
+	"GetPlayerGamesStats":   true,
+	"GetPlayerAverageStats": true,
+	"GetTeamAverageStats":   true,
+	"GetPlayerSplits":       true,
+	"GetRecordsBook":        true,
+	"GetHeadToHead":         true,
+	"GetHeroBallMetadata":   true,
+	"GetGames":              true,
+	"GetPlayers":            true,
+	"GetPlayerInfo":         true,
+	"GetTeamInfo":           true,
+	"GetGameInfo":           true,
+	"GetCompetitionInfo":    true,
+	"StreamGame":            true,
+}
+
+/* where the scopes of a write are looked up, the HeroBall database */
+type scopeSource interface {
+	CompetitionScope(ctx context.Context, competitionId int32) (database.Scope, error)
+	GameScope(ctx context.Context, gameId int32) (database.Scope, error)
+	EventScope(ctx context.Context, eventId int32) (database.Scope, error)
+	SubstitutionScope(ctx context.Context, substitutionId int32) (database.Scope, error)
+}
+
+/*
+ * what a write touches. No scopes means the request is too invalid to say, and
+ * the handler rejects it, so only holding one of the roles somewhere is checked
+ */
+type scopeFunc func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error)
+
+/* league-admin can make every write in its scope, roles are who else can */
+type methodPermission struct {
+	roles  []string
+	scopes scopeFunc
+}
+
+/* a method missing here and from readMethods is left to a league-admin without a scope */
+var writePermissions = map[string]methodPermission{
+	"RefreshViews": {
+		scopes: globalScopes,
+	},
+	"CreateGame": {
+		roles: []string{roleScorer},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			game := request.(*pb.CreateGameRequest)
+			return competitionScopes(ctx, db, game.GetCompetitionId(), game.GetHomeTeamId(), game.GetAwayTeamId())
+		},
+	},
+	"UpdateGame": {
+		roles: []string{roleScorer},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+
+			game := request.(*pb.UpdateGameRequest)
+
+			/* where the game is now, and where it's being moved to */
+			current, err := gameScopes(ctx, db, game.GetGameId())
+
+			if err != nil {
+				return nil, err
+			}
+
+			updated, err := competitionScopes(ctx, db, game.GetCompetitionId(), game.GetHomeTeamId(), game.GetAwayTeamId())
+
+			if err != nil {
+				return nil, err
+			}
+
+			return append(current, updated...), nil
+		},
+	},
+	"DeleteGame": {
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return gameScopes(ctx, db, request.(*pb.DeleteGameRequest).GetGameId())
+		},
+	},
+	"UpsertPlayerGameStats": {
+		roles: []string{roleScorer},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return gameScopes(ctx, db, request.(*pb.UpsertPlayerGameStatsRequest).GetGameId())
+		},
+	},
+	"UpdateCompetitionRoster": {
+		roles: []string{roleTeamManager},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			roster := request.(*pb.UpdateCompetitionRosterRequest)
+			return competitionScopes(ctx, db, roster.GetCompetitionId(), roster.GetTeamId())
+		},
+	},
+	"GenerateSchedule": {
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return competitionScopes(ctx, db, request.(*pb.GenerateScheduleRequest).GetCompetitionId())
+		},
+	},
+	"CreateBracket": {
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return competitionScopes(ctx, db, request.(*pb.CreateBracketRequest).GetCompetitionId())
+		},
+	},
+	"UpdateGamePeriodScores": {
+		roles: []string{roleScorer},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return gameScopes(ctx, db, request.(*pb.UpdateGamePeriodScoresRequest).GetGameId())
+		},
+	},
+	"UpdateCompetitionPeriods": {
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return competitionScopes(ctx, db, request.(*pb.UpdateCompetitionPeriodsRequest).GetCompetitionId())
+		},
+	},
+	"UpdateStandingsRules": {
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return competitionScopes(ctx, db, request.(*pb.UpdateStandingsRulesRequest).GetCompetitionId())
+		},
+	},
+	"RecordEvent": {
+		roles: []string{roleScorer},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return gameScopes(ctx, db, request.(*pb.RecordEventRequest).GetEvent().GetGameId())
+		},
+	},
+	"UndoEvent": {
+		roles: []string{roleScorer},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+
+			eventId := request.(*pb.UndoEventRequest).GetEventId()
+
+			if eventId <= 0 {
+				return nil, nil
+			}
+
+			scope, err := db.EventScope(ctx, eventId)
+
+			return []database.Scope{scope}, err
+		},
+	},
+	"RecordSubstitution": {
+		roles: []string{roleScorer, roleTeamManager},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+
+			sub := request.(*pb.RecordSubstitutionRequest).GetSubstitution()
+
+			scopes, err := gameScopes(ctx, db, sub.GetGameId())
+
+			if err != nil || len(scopes) == 0 {
+				return scopes, err
+			}
+
+			/* a team manager can only change their own team's lineup */
+			teamIds := scopes[0].TeamIds
+			scopes[0].TeamIds = nil
+
+			for _, teamId := range teamIds {
+				if teamId == sub.GetTeamId() {
+					scopes[0].TeamIds = []int32{teamId}
+				}
+			}
+
+			return scopes, nil
+		},
+	},
+	"UndoSubstitution": {
+		roles: []string{roleScorer, roleTeamManager},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+
+			substitutionId := request.(*pb.UndoSubstitutionRequest).GetSubstitutionId()
+
+			if substitutionId <= 0 {
+				return nil, nil
+			}
+
+			scope, err := db.SubstitutionScope(ctx, substitutionId)
+
+			return []database.Scope{scope}, err
+		},
+	},
+	"CreateAward": {
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return competitionScopes(ctx, db, request.(*pb.CreateAwardRequest).GetAward().GetCompetitionId())
+		},
+	},
+	"CastAwardVotes": {
+		roles: []string{roleScorer, roleTeamManager},
+		scopes: func(ctx context.Context, db scopeSource, request interface{}) ([]database.Scope, error) {
+			return gameScopes(ctx, db, request.(*pb.CastAwardVotesRequest).GetGameId())
+		},
+	},
+}
+
+/* only covered by a grant without a scope */
+func globalScopes(context.Context, scopeSource, interface{}) ([]database.Scope, error) {
+	return []database.Scope{{}}, nil
+}
+
+func gameScopes(ctx context.Context, db scopeSource, gameId int32) ([]database.Scope, error) {
+
+	if gameId <= 0 {
+		return nil, nil
+	}
+
+	scope, err := db.GameScope(ctx, gameId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []database.Scope{scope}, nil
+}
+
+/* the teams are those in the competition the request touches */
+func competitionScopes(ctx context.Context, db scopeSource, competitionId int32, teamIds ...int32) ([]database.Scope, error) {
+
+	if competitionId <= 0 {
+		return nil, nil
+	}
+
+	scope, err := db.CompetitionScope(ctx, competitionId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, teamId := range teamIds {
+		if teamId > 0 {
+			scope.TeamIds = append(scope.TeamIds, teamId)
+		}
+	}
+
+	return []database.Scope{scope}, nil
+}
+
+/* an unscoped grant covers everything, a team grant covers a scope with any of its teams */
+func (grant roleGrant) covers(scope database.Scope) bool {
+
+	switch {
+	case grant.LeagueId != 0:
+		return grant.LeagueId == scope.LeagueId
+	case grant.CompetitionId != 0:
+		return grant.CompetitionId == scope.CompetitionId
+	case grant.TeamId != 0:
+		for _, teamId := range scope.TeamIds {
+			if teamId == grant.TeamId {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+func (grant roleGrant) hasRole(roles []string) bool {
+	return grant.Role == roleLeagueAdmin || containsString(roles, grant.Role)
+}
+
+/* checks each call's bearer token against what the method needs */
+type authorizer struct {
+	verifier *tokenVerifier
+	db       scopeSource
+	/* reads need no token */
+	publicReads bool
+}
+
+/* the context handed on carries the token's subject, when the call had to have one */
+func (auth *authorizer) authorize(ctx context.Context, fullMethod string, request interface{}) (context.Context, error) {
+
+	if !strings.HasPrefix(fullMethod, heroBallMethodPrefix) {
+		return ctx, nil
+	}
+
+	method := strings.TrimPrefix(fullMethod, heroBallMethodPrefix)
+
+	if readMethods[method] && auth.publicReads {
+		return ctx, nil
+	}
+
+	claims, err := auth.authenticate(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, tokenSubjectKey{}, claims.Subject)
+
+	if readMethods[method] {
+
+		if len(claims.Roles) == 0 {
+			return nil, status.Errorf(codes.PermissionDenied, "%v needs a role", method)
+		}
+
+		return ctx, nil
+	}
+
+	permission, found := writePermissions[method]
+
+	if !found {
+		permission = methodPermission{
+			scopes: globalScopes,
+		}
+	}
+
+	scopes, err := permission.scopes(ctx, auth.db, request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := false
+
+	for _, grant := range claims.Roles {
+		if grant.hasRole(permission.roles) {
+			allowed = true
+		}
+	}
+
+	for _, scope := range scopes {
+
+		covered := false
+
+		for _, grant := range claims.Roles {
+			if grant.hasRole(permission.roles) && grant.covers(scope) {
+				covered = true
+			}
+		}
+
+		allowed = allowed && covered
+	}
+
+	if !allowed {
+		log.Printf("Denied %v to %q", method, claims.Subject)
+		return nil, status.Errorf(codes.PermissionDenied, "%v needs one of %v in its league, competition or team", method, append(permission.roles, roleLeagueAdmin))
+	}
+
+	return ctx, nil
+}
+
+type tokenSubjectKey struct{}
+
+/*
+ * with tokens on, a ballot is cast by the token's subject, so one caller can't
+ * stuff the vote under names they make up. Without them it is who the request says
+ */
+func bindAwardVoter(ctx context.Context, request *pb.CastAwardVotesRequest) error {
+
+	subject, found := ctx.Value(tokenSubjectKey{}).(string)
+
+	if !found {
+		return nil
+	}
+
+	voter := strings.TrimSpace(request.GetVoter())
+
+	if voter != "" && voter != subject {
+		return status.Errorf(codes.PermissionDenied, "Votes can only be cast as %q", subject)
+	}
+
+	request.Voter = subject
+
+	return nil
+}
+
+/* the verified claims of the call's bearer token */
+func (auth *authorizer) authenticate(ctx context.Context) (*tokenClaims, error) {
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "Missing bearer token")
+	}
+
+	token := strings.TrimSpace(values[0])
+
+	if len(token) < len("Bearer ") || !strings.EqualFold(token[:len("Bearer ")], "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "Authorization is not a bearer token")
+	}
+
+	claims, err := auth.verifier.verify(strings.TrimSpace(token[len("Bearer "):]))
+
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return claims, nil
+}
+
+func (auth *authorizer) unaryInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	ctx, err := auth.authorize(ctx, info.FullMethod, request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, request)
+}
+
+/* StreamGame is the only stream and a read, so there is no request to scope */
+func (auth *authorizer) streamInterceptor(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	_, err := auth.authorize(stream.Context(), info.FullMethod, nil)
+
+	if err != nil {
+		return err
+	}
+
+	return handler(server, stream)
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mlv9/heroball-server/database"
+	pb "github.com/mlv9/protobuf"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+/* scopes by id, competitions 1 and 2 are in league 1 and competition 3 in league 2 */
+type fakeScopeSource struct {
+	games map[int32]database.Scope
+}
+
+func (source *fakeScopeSource) CompetitionScope(ctx context.Context, competitionId int32) (database.Scope, error) {
+
+	leagues := map[int32]int32{1: 1, 2: 1, 3: 2}
+
+	if leagues[competitionId] == 0 {
+		return database.Scope{}, status.Errorf(codes.NotFound, "No competition %v", competitionId)
+	}
+
+	return database.Scope{LeagueId: leagues[competitionId], CompetitionId: competitionId}, nil
+}
+
+func (source *fakeScopeSource) GameScope(ctx context.Context, gameId int32) (database.Scope, error) {
+
+	scope, found := source.games[gameId]
+
+	if !found {
+		return scope, status.Errorf(codes.NotFound, "No game %v", gameId)
+	}
+
+	return scope, nil
+}
+
+func (source *fakeScopeSource) EventScope(ctx context.Context, eventId int32) (database.Scope, error) {
+	return database.Scope{}, fmt.Errorf("No events")
+}
+
+func (source *fakeScopeSource) SubstitutionScope(ctx context.Context, substitutionId int32) (database.Scope, error) {
+	return database.Scope{}, fmt.Errorf("No substitutions")
+}
+
+/* an incoming call carrying a token with the grants */
+func grantsContext(t testing.TB, grants ...roleGrant) context.Context {
+
+	claims := tokenTestClaims()
+	claims["roles"] = grants
+
+	token := signTestToken(t, tokenTestSecret('a'), map[string]interface{}{"alg": "HS256"}, claims)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthorize(t *testing.T) {
+
+	auth := &authorizer{
+		verifier: &tokenVerifier{
+			keys: map[string]tokenKey{"": {alg: "HS256", secret: tokenTestSecret('a')}},
+			now:  func() time.Time { return tokenTestNow },
+		},
+		db: &fakeScopeSource{
+			games: map[int32]database.Scope{
+				1: {LeagueId: 1, CompetitionId: 1, TeamIds: []int32{10, 20}},
+			},
+		},
+	}
+
+	/* game 1 is team 10 against team 20 in competition 1 */
+	updateGame := func(competitionId int32, homeTeamId int32, awayTeamId int32) *pb.UpdateGameRequest {
+		return &pb.UpdateGameRequest{GameId: 1, CompetitionId: competitionId, HomeTeamId: homeTeamId, AwayTeamId: awayTeamId}
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		request interface{}
+		grants  []roleGrant
+		code    codes.Code
+	}{
+		{
+			name:    "team scorer keeping the game",
+			method:  "UpdateGame",
+			request: updateGame(1, 10, 20),
+			grants:  []roleGrant{{Role: roleScorer, TeamId: 10}},
+			code:    codes.OK,
+		},
+		{
+			name:    "team scorer moving the game to other teams",
+			method:  "UpdateGame",
+			request: updateGame(1, 30, 40),
+			grants:  []roleGrant{{Role: roleScorer, TeamId: 10}},
+			code:    codes.PermissionDenied,
+		},
+		{
+			/* the game as it is now has neither of the new teams */
+			name:    "team scorer moving someone else's game to their team",
+			method:  "UpdateGame",
+			request: updateGame(1, 30, 20),
+			grants:  []roleGrant{{Role: roleScorer, TeamId: 30}},
+			code:    codes.PermissionDenied,
+		},
+		{
+			name:    "scorer for both teams",
+			method:  "UpdateGame",
+			request: updateGame(1, 30, 20),
+			grants:  []roleGrant{{Role: roleScorer, TeamId: 10}, {Role: roleScorer, TeamId: 30}},
+			code:    codes.OK,
+		},
+		{
+			name:    "competition scorer moving the game to another competition",
+			method:  "UpdateGame",
+			request: updateGame(2, 10, 20),
+			grants:  []roleGrant{{Role: roleScorer, CompetitionId: 1}},
+			code:    codes.PermissionDenied,
+		},
+		{
+			name:    "league admin moving the game within the league",
+			method:  "UpdateGame",
+			request: updateGame(2, 10, 20),
+			grants:  []roleGrant{{Role: roleLeagueAdmin, LeagueId: 1}},
+			code:    codes.OK,
+		},
+		{
+			name:    "league admin moving the game to another league",
+			method:  "UpdateGame",
+			request: updateGame(3, 10, 20),
+			grants:  []roleGrant{{Role: roleLeagueAdmin, LeagueId: 1}},
+			code:    codes.PermissionDenied,
+		},
+		{
+			name:    "team manager",
+			method:  "UpdateGame",
+			request: updateGame(1, 10, 20),
+			grants:  []roleGrant{{Role: roleTeamManager, TeamId: 10}},
+			code:    codes.PermissionDenied,
+		},
+		{
+			name:   "unlisted method for an unscoped league admin",
+			method: "SomethingNew",
+			grants: []roleGrant{{Role: roleLeagueAdmin}},
+			code:   codes.OK,
+		},
+		{
+			name:   "unlisted method for a league's admin",
+			method: "SomethingNew",
+			grants: []roleGrant{{Role: roleLeagueAdmin, LeagueId: 1}},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "unlisted method for an unscoped scorer",
+			method: "SomethingNew",
+			grants: []roleGrant{{Role: roleScorer}},
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "read with a role",
+			method: "GetGames",
+			grants: []roleGrant{{Role: roleViewer, TeamId: 10}},
+			code:   codes.OK,
+		},
+		{
+			name:   "read without a role",
+			method: "GetGames",
+			code:   codes.PermissionDenied,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			_, err := auth.authorize(grantsContext(t, test.grants...), heroBallMethodPrefix+test.method, test.request)
+
+			if status.Code(err) != test.code {
+				t.Errorf("Got %v, want %v", err, test.code)
+			}
+		})
+	}
+}
+
+func TestAuthorizeWithoutToken(t *testing.T) {
+
+	auth := &authorizer{verifier: &tokenVerifier{now: time.Now}, publicReads: true}
+
+	tests := []struct {
+		method string
+		code   codes.Code
+	}{
+		{heroBallMethodPrefix + "GetGames", codes.OK},
+		{heroBallMethodPrefix + "UpdateGame", codes.Unauthenticated},
+		{heroBallMethodPrefix + "SomethingNew", codes.Unauthenticated},
+		{"/grpc.health.v1.Health/Check", codes.OK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.method, func(t *testing.T) {
+
+			_, err := auth.authorize(context.Background(), test.method, nil)
+
+			if status.Code(err) != test.code {
+				t.Errorf("Got %v, want %v", err, test.code)
+			}
+		})
+	}
+}
+
+/* a scorer's ballots go in under their token's subject, so a second one replaces the first */
+func TestCastAwardVotesVoter(t *testing.T) {
+
+	auth := &authorizer{
+		verifier: &tokenVerifier{
+			keys: map[string]tokenKey{"": {alg: "HS256", secret: tokenTestSecret('a')}},
+			now:  func() time.Time { return tokenTestNow },
+		},
+		db: &fakeScopeSource{
+			games: map[int32]database.Scope{
+				1: {LeagueId: 1, CompetitionId: 1, TeamIds: []int32{10, 20}},
+			},
+		},
+	}
+
+	/* like AwardVotes, one ballot per award, game and voter */
+	ballots := make(map[string][]int32)
+
+	castVotes := func(ctx context.Context, request interface{}) (interface{}, error) {
+
+		votes := request.(*pb.CastAwardVotesRequest)
+
+		err := bindAwardVoter(ctx, votes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ballots[fmt.Sprintf("%v %v %v", votes.GetAwardId(), votes.GetGameId(), votes.GetVoter())] = votes.GetPlayerIds()
+
+		return &pb.CastAwardVotesResponse{}, nil
+	}
+
+	ctx := grantsContext(t, roleGrant{Role: roleScorer, TeamId: 10})
+	info := &grpc.UnaryServerInfo{FullMethod: heroBallMethodPrefix + "CastAwardVotes"}
+
+	tests := []struct {
+		name      string
+		voter     string
+		playerIds []int32
+		code      codes.Code
+	}{
+		{"first ballot", "", []int32{1, 2, 3}, codes.OK},
+		{"second ballot", "", []int32{4, 5, 6}, codes.OK},
+		{"as themselves", "scorer@example.com", []int32{7, 8, 9}, codes.OK},
+		{"as someone else", "coach@example.com", []int32{1, 2, 3}, codes.PermissionDenied},
+	}
+
+	for _, test := range tests {
+
+		request := &pb.CastAwardVotesRequest{AwardId: 1, GameId: 1, Voter: test.voter, VoterRole: "coach", PlayerIds: test.playerIds}
+
+		_, err := auth.unaryInterceptor(ctx, request, info, castVotes)
+
+		if status.Code(err) != test.code {
+			t.Errorf("%v: got %v, want %v", test.name, err, test.code)
+		}
+	}
+
+	want := map[string][]int32{"1 1 scorer@example.com": {7, 8, 9}}
+
+	if !reflect.DeepEqual(ballots, want) {
+		t.Errorf("Got ballots %v, want %v", ballots, want)
+	}
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlv9/heroball-server/database"
+	pb "github.com/mlv9/protobuf"
+)
+
+/* playerBoxScore positions onto the playerposition enum */
+var boxScorePositions = map[string]string{
+	"F":  "forward",
+	"G":  "guard",
+	"SG": "shooting-guard",
+	"C":  "center",
+	"PF": "power-forward",
+	"PG": "point-guard",
+	"SF": "small-forward",
+}
+
+type csvImporter struct {
+	clampFouls   bool
+	clampedFouls int
+}
+
+/* a header indexed csv row */
+type csvRow struct {
+	number  int
+	headers map[string]int
+	values  []string
+}
+
+func (row *csvRow) has(column string) bool {
+	_, found := row.headers[column]
+	return found
+}
+
+func (row *csvRow) str(column string) (string, error) {
+
+	index, found := row.headers[column]
+
+	if !found {
+		return "", fmt.Errorf("Row %v: missing column %v", row.number, column)
+	}
+
+	return strings.TrimSpace(row.values[index]), nil
+}
+
+func (row *csvRow) int(column string) (int32, error) {
+
+	value, err := row.str(column)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 32)
+
+	if err != nil {
+		return 0, fmt.Errorf("Row %v: invalid %v %q", row.number, column, value)
+	}
+
+	return int32(parsed), nil
+}
+
+func readCsv(reader io.Reader, parseRow func(*csvRow) (*database.ImportStatLine, error)) ([]*database.ImportStatLine, error) {
+
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	headerLine, err := csvReader.Read()
+
+	if err != nil {
+		return nil, fmt.Errorf("Error reading header: %v", err)
+	}
+
+	headers := make(map[string]int)
+
+	for i, header := range headerLine {
+		headers[strings.TrimSpace(header)] = i
+	}
+
+	lines := make([]*database.ImportStatLine, 0)
+
+	/* the header is row 1 */
+	for rowNumber := 2; ; rowNumber++ {
+
+		values, err := csvReader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("Row %v: %v", rowNumber, err)
+		}
+
+		line, err := parseRow(&csvRow{
+			number:  rowNumber,
+			headers: headers,
+			values:  values,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		line.Row = rowNumber
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+/* the 2017-18_playerBoxScore.csv format that read_nba_stats.py consumed */
+func (importer *csvImporter) readPlayerBoxScore(reader io.Reader) ([]*database.ImportStatLine, error) {
+	return readCsv(reader, func(row *csvRow) (*database.ImportStatLine, error) {
+
+		line := &database.ImportStatLine{
+			JerseyNumber: -1,
+			Stats:        &pb.Stats{},
+		}
+
+		fields := make(map[string]string)
+
+		for _, column := range []string{"gmDate", "gmTime", "teamAbbr", "opptAbbr", "opptLoc", "playDispNm", "playPos"} {
+
+			value, err := row.str(column)
+
+			if err != nil {
+				return nil, err
+			}
+
+			fields[column] = value
+		}
+
+		gameTime, err := time.Parse("2006-01-02 15:04", fields["gmDate"]+" "+fields["gmTime"])
+
+		if err != nil {
+			return nil, fmt.Errorf("Row %v: invalid game time: %v", row.number, err)
+		}
+
+		line.GameTime = gameTime
+		line.Team = fields["teamAbbr"]
+		line.Player = fields["playDispNm"]
+
+		/* opptLoc is where the opponent is playing */
+		switch fields["opptLoc"] {
+		case "Home":
+			line.HomeTeam = fields["opptAbbr"]
+			line.AwayTeam = fields["teamAbbr"]
+		case "Away":
+			line.HomeTeam = fields["teamAbbr"]
+			line.AwayTeam = fields["opptAbbr"]
+		default:
+			return nil, fmt.Errorf("Row %v: unknown opptLoc %q", row.number, fields["opptLoc"])
+		}
+
+		position, found := boxScorePositions[fields["playPos"]]
+
+		if !found {
+			return nil, fmt.Errorf("Row %v: unknown playPos %q", row.number, fields["playPos"])
+		}
+
+		line.Position = position
+
+		columns := []struct {
+			name  string
+			value *int32
+		}{
+			{"play2PA", &line.Stats.TwoPointFGA},
+			{"play2PM", &line.Stats.TwoPointFGM},
+			{"play3PA", &line.Stats.ThreePointFGA},
+			{"play3PM", &line.Stats.ThreePointFGM},
+			{"playFTA", &line.Stats.FreeThrowsAttempted},
+			{"playFTM", &line.Stats.FreeThrowsMade},
+			{"playORB", &line.Stats.OffensiveRebounds},
+			{"playDRB", &line.Stats.DefensiveRebounds},
+			{"playAST", &line.Stats.Assists},
+			{"playBLK", &line.Stats.Blocks},
+			{"playSTL", &line.Stats.Steals},
+			{"playTO", &line.Stats.Turnovers},
+			{"playPF", &line.Stats.RegularFoulsCommitted},
+			{"playMin", &line.Stats.MinutesPlayed},
+		}
+
+		for _, column := range columns {
+
+			value, err := row.int(column.name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			*column.value = value
+		}
+
+		importer.clamp(line)
+
+		return line, nil
+	})
+}
+
+/* the documented generic format, see the README */
+func (importer *csvImporter) readGeneric(reader io.Reader) ([]*database.ImportStatLine, error) {
+	return readCsv(reader, func(row *csvRow) (*database.ImportStatLine, error) {
+
+		line := &database.ImportStatLine{
+			JerseyNumber: -1,
+			Stats:        &pb.Stats{},
+		}
+
+		required := []struct {
+			name  string
+			value *string
+		}{
+			{"HomeTeam", &line.HomeTeam},
+			{"AwayTeam", &line.AwayTeam},
+			{"Team", &line.Team},
+			{"Player", &line.Player},
+			{"Position", &line.Position},
+		}
+
+		for _, column := range required {
+
+			value, err := row.str(column.name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			*column.value = value
+		}
+
+		gameTime, err := row.str("GameTime")
+
+		if err != nil {
+			return nil, err
+		}
+
+		line.GameTime, err = database.ParseGameTime(gameTime)
+
+		if err != nil {
+			return nil, fmt.Errorf("Row %v: %v", row.number, err)
+		}
+
+		if row.has("JerseyNumber") {
+
+			jersey, err := row.str("JerseyNumber")
+
+			if err != nil {
+				return nil, err
+			}
+
+			if jersey != "" {
+
+				line.JerseyNumber, err = row.int("JerseyNumber")
+
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		columns := []struct {
+			name  string
+			value *int32
+		}{
+			{"TwoPointFGA", &line.Stats.TwoPointFGA},
+			{"TwoPointFGM", &line.Stats.TwoPointFGM},
+			{"ThreePointFGA", &line.Stats.ThreePointFGA},
+			{"ThreePointFGM", &line.Stats.ThreePointFGM},
+			{"FreeThrowsAttempted", &line.Stats.FreeThrowsAttempted},
+			{"FreeThrowsMade", &line.Stats.FreeThrowsMade},
+			{"OffensiveRebounds", &line.Stats.OffensiveRebounds},
+			{"DefensiveRebounds", &line.Stats.DefensiveRebounds},
+			{"Assists", &line.Stats.Assists},
+			{"Blocks", &line.Stats.Blocks},
+			{"Steals", &line.Stats.Steals},
+			{"Turnovers", &line.Stats.Turnovers},
+			{"RegularFoulsForced", &line.Stats.RegularFoulsForced},
+			{"RegularFoulsCommitted", &line.Stats.RegularFoulsCommitted},
+			{"TechnicalFoulsCommitted", &line.Stats.TechnicalFoulsCommitted},
+			{"MinutesPlayed", &line.Stats.MinutesPlayed},
+		}
+
+		/* missing stat columns are taken as zero */
+		for _, column := range columns {
+
+			if !row.has(column.name) {
+				continue
+			}
+
+			value, err := row.int(column.name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			*column.value = value
+		}
+
+		importer.clamp(line)
+
+		return line, nil
+	})
+}
+
+/* only when asked, otherwise rows over the limit are reported as invalid */
+func (importer *csvImporter) clamp(line *database.ImportStatLine) {
+
+	if !importer.clampFouls {
+		return
+	}
+
+	if line.Stats.RegularFoulsCommitted > database.MaxRegularFouls {
+		line.Stats.RegularFoulsCommitted = database.MaxRegularFouls
+		importer.clampedFouls++
+	}
+
+	if line.Stats.TechnicalFoulsCommitted > database.MaxTechnicalFouls {
+		line.Stats.TechnicalFoulsCommitted = database.MaxTechnicalFouls
+		importer.clampedFouls++
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mlv9/heroball-server/database"
+)
+
+const boxScoreCsv = `gmDate,gmTime,teamAbbr,opptAbbr,opptLoc,playDispNm,playPos,play2PA,play2PM,play3PA,play3PM,playFTA,playFTM,playORB,playDRB,playAST,playBLK,playSTL,playTO,playPF,playMin
+2017-10-17,20:00,BOS,CLE,Home,Jayson Tatum,SF,8,4,2,1,4,3,1,9,3,0,1,2,7,36
+2017-10-17,20:00,CLE,BOS,Away,LeBron James,SF,14,10,5,2,4,3,1,3,9,1,0,4,3,41
+`
+
+const genericCsv = `GameTime,HomeTeam,AwayTeam,Team,Player,Position,JerseyNumber,TwoPointFGA,TwoPointFGM,Assists,TechnicalFoulsCommitted
+2021-04-10 19:30,Hawks,Owls,Hawks,Ann,guard,4,10,5,3,0
+2021-04-10T19:30:00Z,Hawks,Owls,Owls,Bea,center,,8,4,1,3
+`
+
+func TestReadPlayerBoxScore(t *testing.T) {
+
+	importer := &csvImporter{}
+
+	lines, err := importer.readPlayerBoxScore(strings.NewReader(boxScoreCsv))
+
+	if err != nil {
+		t.Fatalf("Error reading box score: %v", err)
+	}
+
+	if len(lines) != 2 {
+		t.Fatalf("Got %v lines, want 2", len(lines))
+	}
+
+	tatum := lines[0]
+
+	/* opptLoc is where the opponent plays, so Boston are away */
+	if tatum.HomeTeam != "CLE" || tatum.AwayTeam != "BOS" || tatum.Team != "BOS" {
+		t.Errorf("Got %v at %v for %v, want BOS at CLE for BOS", tatum.AwayTeam, tatum.HomeTeam, tatum.Team)
+	}
+
+	if !tatum.GameTime.Equal(time.Date(2017, 10, 17, 20, 0, 0, 0, time.UTC)) {
+		t.Errorf("Got game time %v", tatum.GameTime)
+	}
+
+	if tatum.Row != 2 || tatum.Position != "small-forward" || tatum.JerseyNumber != -1 {
+		t.Errorf("Got row %v, position %q and jersey %v", tatum.Row, tatum.Position, tatum.JerseyNumber)
+	}
+
+	if tatum.Stats.TwoPointFGA != 8 || tatum.Stats.ThreePointFGM != 1 || tatum.Stats.DefensiveRebounds != 9 || tatum.Stats.MinutesPlayed != 36 {
+		t.Errorf("Got stats %v", tatum.Stats)
+	}
+
+	if lines[1].HomeTeam != "CLE" || lines[1].Team != "CLE" {
+		t.Errorf("Got %v at home for %v, want CLE for CLE", lines[1].HomeTeam, lines[1].Team)
+	}
+}
+
+func TestReadGeneric(t *testing.T) {
+
+	importer := &csvImporter{}
+
+	lines, err := importer.readGeneric(strings.NewReader(genericCsv))
+
+	if err != nil {
+		t.Fatalf("Error reading generic csv: %v", err)
+	}
+
+	if len(lines) != 2 {
+		t.Fatalf("Got %v lines, want 2", len(lines))
+	}
+
+	if !lines[0].GameTime.Equal(lines[1].GameTime) {
+		t.Errorf("Both time formats should give the same game, got %v and %v", lines[0].GameTime, lines[1].GameTime)
+	}
+
+	if lines[0].JerseyNumber != 4 || lines[1].JerseyNumber != -1 {
+		t.Errorf("Got jerseys %v and %v, want 4 and unknown", lines[0].JerseyNumber, lines[1].JerseyNumber)
+	}
+
+	/* columns left out are zero */
+	if lines[0].Stats.TwoPointFGA != 10 || lines[0].Stats.Assists != 3 || lines[0].Stats.Steals != 0 {
+		t.Errorf("Got stats %v", lines[0].Stats)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		read   func(*csvImporter, string) error
+		csv    string
+		errors string
+	}{
+		{
+			name: "boxscore missing column",
+			read: func(importer *csvImporter, csv string) error {
+				_, err := importer.readPlayerBoxScore(strings.NewReader(csv))
+				return err
+			},
+			csv:    "gmDate,gmTime\n2017-10-17,20:00\n",
+			errors: "Row 2: missing column teamAbbr",
+		},
+		{
+			name: "boxscore unknown position",
+			read: func(importer *csvImporter, csv string) error {
+				_, err := importer.readPlayerBoxScore(strings.NewReader(csv))
+				return err
+			},
+			csv:    strings.Replace(boxScoreCsv, "SF,8", "XX,8", 1),
+			errors: `Row 2: unknown playPos "XX"`,
+		},
+		{
+			name: "generic invalid number",
+			read: func(importer *csvImporter, csv string) error {
+				_, err := importer.readGeneric(strings.NewReader(csv))
+				return err
+			},
+			csv:    strings.Replace(genericCsv, ",10,5,", ",ten,5,", 1),
+			errors: `Row 2: invalid TwoPointFGA "ten"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			err := test.read(&csvImporter{}, test.csv)
+
+			if err == nil || !strings.Contains(err.Error(), test.errors) {
+				t.Errorf("Got error %v, want %q", err, test.errors)
+			}
+		})
+	}
+}
+
+func TestClampFouls(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		clamp       bool
+		regular     int32
+		technical   int32
+		clampedRows int
+	}{
+		{"kept for rejection", false, 7, 3, 0},
+		{"clamped", true, database.MaxRegularFouls, database.MaxTechnicalFouls, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			importer := &csvImporter{clampFouls: test.clamp}
+
+			boxScore, err := importer.readPlayerBoxScore(strings.NewReader(boxScoreCsv))
+
+			if err != nil {
+				t.Fatalf("Error reading box score: %v", err)
+			}
+
+			generic, err := importer.readGeneric(strings.NewReader(genericCsv))
+
+			if err != nil {
+				t.Fatalf("Error reading generic csv: %v", err)
+			}
+
+			if boxScore[0].Stats.RegularFoulsCommitted != test.regular {
+				t.Errorf("Got %v regular fouls, want %v", boxScore[0].Stats.RegularFoulsCommitted, test.regular)
+			}
+
+			if generic[1].Stats.TechnicalFoulsCommitted != test.technical {
+				t.Errorf("Got %v technical fouls, want %v", generic[1].Stats.TechnicalFoulsCommitted, test.technical)
+			}
+
+			if importer.clampedFouls != test.clampedRows {
+				t.Errorf("Got %v clamped, want %v", importer.clampedFouls, test.clampedRows)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/mlv9/heroball-server/database"
+	pb "github.com/mlv9/protobuf"
+)
+
+func main() {
+
+	format := flag.String("format", "boxscore", "input format, boxscore (playerBoxScore csv) or generic")
+	competitionId := flag.Int("competition", 0, "competitionId the games belong to")
+	locationId := flag.Int("location", 0, "locationId the games are played at")
+	batchSize := flag.Int("batch-size", database.DefaultImportBatchSize, "games written per transaction")
+	dryRun := flag.Bool("dry-run", false, "report what would change without writing")
+	clampFouls := flag.Bool("clamp-fouls", false, "clamp fouls to the database limits instead of rejecting the row")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %v [flags] file.csv\n\nConnects using the POSTGRES_USER, POSTGRES_PASSWORD, POSTGRES_HOST and POSTGRES_DBNAME env.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	file, err := os.Open(flag.Arg(0))
+
+	if err != nil {
+		log.Fatalf("Error opening input: %v", err)
+	}
+
+	defer file.Close()
+
+	importer := &csvImporter{
+		clampFouls: *clampFouls,
+	}
+
+	var read func(io.Reader) ([]*database.ImportStatLine, error)
+
+	switch *format {
+	case "boxscore":
+		read = importer.readPlayerBoxScore
+	case "generic":
+		read = importer.readGeneric
+	default:
+		log.Fatalf("Unrecognised format: %v", *format)
+	}
+
+	lines, err := read(file)
+
+	if err != nil {
+		log.Fatalf("Error reading %v: %v", flag.Arg(0), err)
+	}
+
+	log.Printf("Read %v stat lines from %v", len(lines), flag.Arg(0))
+
+	if importer.clampedFouls > 0 {
+		log.Printf("Clamped fouls on %v lines", importer.clampedFouls)
+	}
+
+	log.Printf("Connecting to DB at %v\n", os.Getenv("POSTGRES_HOST"))
+
+	db, err := database.NewHeroBallDatabase(database.ConnectionStringFromEnv())
+
+	if err != nil {
+		log.Fatalf("Error connecting to db: %v", err)
+	}
+
+	ctx := context.Background()
+
+	report, err := db.ImportStatLines(ctx, lines, database.ImportOptions{
+		CompetitionId: int32(*competitionId),
+		LocationId:    int32(*locationId),
+		BatchSize:     *batchSize,
+		DryRun:        *dryRun,
+	})
+
+	if err != nil {
+		log.Fatalf("Error importing: %v", err)
+	}
+
+	printReport(os.Stdout, report)
+
+	if *dryRun {
+		return
+	}
+
+	/* the server refreshes these too, but it may not be running */
+	_, err = db.RefreshViews(ctx, &pb.RefreshViewsRequest{})
+
+	if err != nil {
+		log.Fatalf("Error refreshing views: %v", err)
+	}
+}
+
+func printReport(out io.Writer, report *database.ImportReport) {
+
+	if report.DryRun {
+		fmt.Fprintf(out, "Dry run, nothing has been written\n\n")
+	}
+
+	fmt.Fprintf(out, "Teams added: %v\n", len(report.NewTeams))
+
+	for _, team := range report.NewTeams {
+		fmt.Fprintf(out, "  + %v\n", team)
+	}
+
+	fmt.Fprintf(out, "Players added: %v\n", len(report.NewPlayers))
+
+	for _, player := range report.NewPlayers {
+		fmt.Fprintf(out, "  + %v\n", player)
+	}
+
+	fmt.Fprintf(out, "Games added: %v, already present: %v\n", len(report.NewGames), report.ExistingGames)
+
+	for _, game := range report.NewGames {
+		fmt.Fprintf(out, "  + %v\n", game)
+	}
+
+	fmt.Fprintf(out, "Stat lines added: %v, changed: %v, unchanged: %v\n", report.NewStats, len(report.ChangedStats), report.UnchangedStats)
+
+	for _, change := range report.ChangedStats {
+		fmt.Fprintf(out, "  ~ %v, %v:\n", change.Game, change.Player)
+
+		for _, field := range change.Changes {
+			fmt.Fprintf(out, "      %v\n", field)
+		}
+	}
+
+	if report.MissingJerseys > 0 {
+		fmt.Fprintf(out, "Stat lines without a known jersey number (recorded as 0): %v\n", report.MissingJerseys)
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mlv9/heroball-server/database"
+	pb "github.com/mlv9/protobuf"
+)
+
+var weekdays = map[string]int32{
+	"sun": 0,
+	"mon": 1,
+	"tue": 2,
+	"wed": 3,
+	"thu": 4,
+	"fri": 5,
+	"sat": 6,
+}
+
+func main() {
+
+	competitionId := flag.Int("competition", 0, "competitionId to schedule")
+	teams := flag.String("teams", "", "comma separated teamIds, defaults to the teams entered in the competition")
+	locations := flag.String("locations", "", "comma separated locationIds games can be played at")
+	start := flag.String("start", "", "first day games can be played, 2006-01-02")
+	slots := flag.String("slots", "", "comma separated weekly slots, e.g. \"sat 10:00,sat 12:00,sun 14:30\" (UTC)")
+	blackouts := flag.String("blackouts", "", "comma separated days without games, 2006-01-02")
+	double := flag.Bool("double", false, "double round robin, every pairing home and away")
+	preview := flag.Bool("preview", false, "print the fixtures without creating any games")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %v [flags]\n\nConnects using the POSTGRES_USER, POSTGRES_PASSWORD, POSTGRES_HOST and POSTGRES_DBNAME env.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	request := &pb.GenerateScheduleRequest{
+		CompetitionId:    int32(*competitionId),
+		StartDate:        *start,
+		BlackoutDates:    splitList(*blackouts),
+		DoubleRoundRobin: *double,
+		Preview:          *preview,
+	}
+
+	var err error
+
+	request.TeamIds, err = parseIds(*teams)
+
+	if err != nil {
+		log.Fatalf("Invalid -teams: %v", err)
+	}
+
+	request.LocationIds, err = parseIds(*locations)
+
+	if err != nil {
+		log.Fatalf("Invalid -locations: %v", err)
+	}
+
+	request.Slots, err = parseSlots(*slots)
+
+	if err != nil {
+		log.Fatalf("Invalid -slots: %v", err)
+	}
+
+	log.Printf("Connecting to DB at %v\n", os.Getenv("POSTGRES_HOST"))
+
+	db, err := database.NewHeroBallDatabase(database.ConnectionStringFromEnv())
+
+	if err != nil {
+		log.Fatalf("Error connecting to db: %v", err)
+	}
+
+	defer db.Close()
+
+	schedule, err := db.GenerateSchedule(context.Background(), request)
+
+	if err != nil {
+		log.Fatalf("Error generating schedule: %v", err)
+	}
+
+	printSchedule(os.Stdout, schedule, *preview)
+}
+
+func printSchedule(out io.Writer, schedule *pb.GenerateScheduleResponse, preview bool) {
+
+	if preview {
+		fmt.Fprintf(out, "Preview, no games have been created\n\n")
+	}
+
+	byes := make(map[int32][]int32)
+
+	for _, bye := range schedule.GetByes() {
+		byes[bye.Round] = append(byes[bye.Round], bye.TeamId)
+	}
+
+	round := int32(0)
+
+	for _, game := range schedule.GetGames() {
+
+		if game.Round != round {
+
+			round = game.Round
+			fmt.Fprintf(out, "Round %v\n", round)
+
+			for _, teamId := range byes[round] {
+				fmt.Fprintf(out, "  bye: team %v\n", teamId)
+			}
+		}
+
+		fmt.Fprintf(out, "  %v  team %v v team %v at location %v", game.GameTime, game.HomeTeamId, game.AwayTeamId, game.LocationId)
+
+		if game.GameId != 0 {
+			fmt.Fprintf(out, " (game %v)", game.GameId)
+		}
+
+		fmt.Fprintf(out, "\n")
+	}
+
+	fmt.Fprintf(out, "\n%v games over %v rounds\n", len(schedule.GetGames()), round)
+}
+
+func splitList(list string) []string {
+
+	items := make([]string, 0)
+
+	for _, item := range strings.Split(list, ",") {
+
+		item = strings.TrimSpace(item)
+
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func parseIds(list string) ([]int32, error) {
+
+	ids := make([]int32, 0)
+
+	for _, item := range splitList(list) {
+
+		id, err := strconv.ParseInt(item, 10, 32)
+
+		if err != nil {
+			return nil, fmt.Errorf("Expecting an id, got %q", item)
+		}
+
+		ids = append(ids, int32(id))
+	}
+
+	return ids, nil
+}
+
+/* "sat 10:00" is Saturday at 10am */
+func parseSlots(list string) ([]*pb.ScheduleSlot, error) {
+
+	slots := make([]*pb.ScheduleSlot, 0)
+
+	for _, item := range splitList(list) {
+
+		fields := strings.Fields(item)
+
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("Expecting a day and a time, got %q", item)
+		}
+
+		day := strings.ToLower(fields[0])
+		weekday, found := weekdays[day]
+
+		/* full names work too */
+		if !found && len(day) > 3 {
+			weekday, found = weekdays[day[:3]]
+		}
+
+		if !found {
+			return nil, fmt.Errorf("Unrecognised day %q", fields[0])
+		}
+
+		slots = append(slots, &pb.ScheduleSlot{
+			Weekday: weekday,
+			Time:    fields[1],
+		})
+	}
+
+	return slots, nil
+}
@@ -0,0 +1,3 @@
+module github.com/mlv9/protobuf
+
+go 1.16
@@ -0,0 +1,13777 @@
+// HeroBall Protobuf
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        (unknown)
+// source: heroball.proto
+
+package pb
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId int32  `protobuf:"varint,1,opt,name=PlayerId,proto3" json:"PlayerId"`
+	Name     string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name"`
+	Position string `protobuf:"bytes,3,opt,name=Position,proto3" json:"Position"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Player) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type League struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeagueId int32  `protobuf:"varint,1,opt,name=LeagueId,proto3" json:"LeagueId"`
+	Name     string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name"`
+	Division string `protobuf:"bytes,3,opt,name=Division,proto3" json:"Division"`
+}
+
+func (x *League) Reset() {
+	*x = League{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *League) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*League) ProtoMessage() {}
+
+func (x *League) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use League.ProtoReflect.Descriptor instead.
+func (*League) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *League) GetLeagueId() int32 {
+	if x != nil {
+		return x.LeagueId
+	}
+	return 0
+}
+
+func (x *League) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *League) GetDivision() string {
+	if x != nil {
+		return x.Division
+	}
+	return ""
+}
+
+type Competition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	League        *League `protobuf:"bytes,1,opt,name=League,proto3" json:"League"`
+	CompetitionId int32   `protobuf:"varint,2,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	Name          string  `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name"`
+	PeriodCount   int32   `protobuf:"varint,4,opt,name=PeriodCount,proto3" json:"PeriodCount"` // periods in regulation, 4 quarters or 2 halves
+}
+
+func (x *Competition) Reset() {
+	*x = Competition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Competition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Competition) ProtoMessage() {}
+
+func (x *Competition) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Competition.ProtoReflect.Descriptor instead.
+func (*Competition) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Competition) GetLeague() *League {
+	if x != nil {
+		return x.League
+	}
+	return nil
+}
+
+func (x *Competition) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *Competition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Competition) GetPeriodCount() int32 {
+	if x != nil {
+		return x.PeriodCount
+	}
+	return 0
+}
+
+type Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId int32  `protobuf:"varint,1,opt,name=TeamId,proto3" json:"TeamId"`
+	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name"`
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Team) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CompetitionTeam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team               *Team   `protobuf:"bytes,1,opt,name=Team,proto3" json:"Team"`
+	Won                int32   `protobuf:"varint,2,opt,name=Won,proto3" json:"Won"`
+	Drawn              int32   `protobuf:"varint,3,opt,name=Drawn,proto3" json:"Drawn"`
+	Lost               int32   `protobuf:"varint,4,opt,name=Lost,proto3" json:"Lost"` // including forfeits
+	Forfeited          int32   `protobuf:"varint,5,opt,name=Forfeited,proto3" json:"Forfeited"`
+	Played             int32   `protobuf:"varint,6,opt,name=Played,proto3" json:"Played"`
+	LadderPoints       int32   `protobuf:"varint,7,opt,name=LadderPoints,proto3" json:"LadderPoints"`
+	PointsFor          int32   `protobuf:"varint,8,opt,name=PointsFor,proto3" json:"PointsFor"`
+	PointsAgainst      int32   `protobuf:"varint,9,opt,name=PointsAgainst,proto3" json:"PointsAgainst"`
+	PointsDifferential int32   `protobuf:"varint,10,opt,name=PointsDifferential,proto3" json:"PointsDifferential"`
+	PointsPercentage   float64 `protobuf:"fixed64,11,opt,name=PointsPercentage,proto3" json:"PointsPercentage"` // PointsFor over PointsAgainst, 0 when nothing has been conceded
+	WinPercentage      float64 `protobuf:"fixed64,12,opt,name=WinPercentage,proto3" json:"WinPercentage"`       // of games played, draws count as half a win
+	Streak             string  `protobuf:"bytes,13,opt,name=Streak,proto3" json:"Streak"`                       // the current run, e.g. W3, L1 or D1, empty before any games
+	Form               string  `protobuf:"bytes,14,opt,name=Form,proto3" json:"Form"`                           // the last 5 results oldest first, e.g. WWLDW
+}
+
+func (x *CompetitionTeam) Reset() {
+	*x = CompetitionTeam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompetitionTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompetitionTeam) ProtoMessage() {}
+
+func (x *CompetitionTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompetitionTeam.ProtoReflect.Descriptor instead.
+func (*CompetitionTeam) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompetitionTeam) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *CompetitionTeam) GetWon() int32 {
+	if x != nil {
+		return x.Won
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetDrawn() int32 {
+	if x != nil {
+		return x.Drawn
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetLost() int32 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetForfeited() int32 {
+	if x != nil {
+		return x.Forfeited
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetPlayed() int32 {
+	if x != nil {
+		return x.Played
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetLadderPoints() int32 {
+	if x != nil {
+		return x.LadderPoints
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetPointsFor() int32 {
+	if x != nil {
+		return x.PointsFor
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetPointsAgainst() int32 {
+	if x != nil {
+		return x.PointsAgainst
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetPointsDifferential() int32 {
+	if x != nil {
+		return x.PointsDifferential
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetPointsPercentage() float64 {
+	if x != nil {
+		return x.PointsPercentage
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetWinPercentage() float64 {
+	if x != nil {
+		return x.WinPercentage
+	}
+	return 0
+}
+
+func (x *CompetitionTeam) GetStreak() string {
+	if x != nil {
+		return x.Streak
+	}
+	return ""
+}
+
+func (x *CompetitionTeam) GetForm() string {
+	if x != nil {
+		return x.Form
+	}
+	return ""
+}
+
+// how a competition's standings are ordered, by ladder points then each tiebreaker in turn
+type StandingsRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WinPoints     int32 `protobuf:"varint,1,opt,name=WinPoints,proto3" json:"WinPoints"`
+	DrawPoints    int32 `protobuf:"varint,2,opt,name=DrawPoints,proto3" json:"DrawPoints"`
+	LossPoints    int32 `protobuf:"varint,3,opt,name=LossPoints,proto3" json:"LossPoints"`
+	ForfeitPoints int32 `protobuf:"varint,4,opt,name=ForfeitPoints,proto3" json:"ForfeitPoints"` // for the team that forfeited, instead of LossPoints
+	// any of head-to-head, points-differential, points-percentage and points-for
+	Tiebreakers []string `protobuf:"bytes,5,rep,name=Tiebreakers,proto3" json:"Tiebreakers"`
+}
+
+func (x *StandingsRules) Reset() {
+	*x = StandingsRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandingsRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandingsRules) ProtoMessage() {}
+
+func (x *StandingsRules) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandingsRules.ProtoReflect.Descriptor instead.
+func (*StandingsRules) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StandingsRules) GetWinPoints() int32 {
+	if x != nil {
+		return x.WinPoints
+	}
+	return 0
+}
+
+func (x *StandingsRules) GetDrawPoints() int32 {
+	if x != nil {
+		return x.DrawPoints
+	}
+	return 0
+}
+
+func (x *StandingsRules) GetLossPoints() int32 {
+	if x != nil {
+		return x.LossPoints
+	}
+	return 0
+}
+
+func (x *StandingsRules) GetForfeitPoints() int32 {
+	if x != nil {
+		return x.ForfeitPoints
+	}
+	return 0
+}
+
+func (x *StandingsRules) GetTiebreakers() []string {
+	if x != nil {
+		return x.Tiebreakers
+	}
+	return nil
+}
+
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId int32  `protobuf:"varint,1,opt,name=LocationId,proto3" json:"LocationId"`
+	Name       string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Location) GetLocationId() int32 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Stats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TwoPointFGM             int32          `protobuf:"varint,1,opt,name=TwoPointFGM,proto3" json:"TwoPointFGM"`
+	TwoPointFGA             int32          `protobuf:"varint,2,opt,name=TwoPointFGA,proto3" json:"TwoPointFGA"`
+	ThreePointFGM           int32          `protobuf:"varint,3,opt,name=ThreePointFGM,proto3" json:"ThreePointFGM"`
+	ThreePointFGA           int32          `protobuf:"varint,4,opt,name=ThreePointFGA,proto3" json:"ThreePointFGA"`
+	FreeThrowsMade          int32          `protobuf:"varint,5,opt,name=FreeThrowsMade,proto3" json:"FreeThrowsMade"`
+	FreeThrowsAttempted     int32          `protobuf:"varint,6,opt,name=FreeThrowsAttempted,proto3" json:"FreeThrowsAttempted"`
+	OffensiveRebounds       int32          `protobuf:"varint,7,opt,name=OffensiveRebounds,proto3" json:"OffensiveRebounds"`
+	DefensiveRebounds       int32          `protobuf:"varint,8,opt,name=DefensiveRebounds,proto3" json:"DefensiveRebounds"`
+	Assists                 int32          `protobuf:"varint,9,opt,name=Assists,proto3" json:"Assists"`
+	Turnovers               int32          `protobuf:"varint,10,opt,name=Turnovers,proto3" json:"Turnovers"`
+	Steals                  int32          `protobuf:"varint,11,opt,name=Steals,proto3" json:"Steals"`
+	Blocks                  int32          `protobuf:"varint,12,opt,name=Blocks,proto3" json:"Blocks"`
+	RegularFoulsForced      int32          `protobuf:"varint,13,opt,name=RegularFoulsForced,proto3" json:"RegularFoulsForced"`
+	RegularFoulsCommitted   int32          `protobuf:"varint,14,opt,name=RegularFoulsCommitted,proto3" json:"RegularFoulsCommitted"`
+	TechnicalFoulsCommitted int32          `protobuf:"varint,15,opt,name=TechnicalFoulsCommitted,proto3" json:"TechnicalFoulsCommitted"`
+	MinutesPlayed           int32          `protobuf:"varint,16,opt,name=MinutesPlayed,proto3" json:"MinutesPlayed"`
+	GameCount               int32          `protobuf:"varint,17,opt,name=GameCount,proto3" json:"GameCount"`
+	Advanced                *AdvancedStats `protobuf:"bytes,18,opt,name=Advanced,proto3" json:"Advanced"` // computed by the server from the totals above
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Stats) GetTwoPointFGM() int32 {
+	if x != nil {
+		return x.TwoPointFGM
+	}
+	return 0
+}
+
+func (x *Stats) GetTwoPointFGA() int32 {
+	if x != nil {
+		return x.TwoPointFGA
+	}
+	return 0
+}
+
+func (x *Stats) GetThreePointFGM() int32 {
+	if x != nil {
+		return x.ThreePointFGM
+	}
+	return 0
+}
+
+func (x *Stats) GetThreePointFGA() int32 {
+	if x != nil {
+		return x.ThreePointFGA
+	}
+	return 0
+}
+
+func (x *Stats) GetFreeThrowsMade() int32 {
+	if x != nil {
+		return x.FreeThrowsMade
+	}
+	return 0
+}
+
+func (x *Stats) GetFreeThrowsAttempted() int32 {
+	if x != nil {
+		return x.FreeThrowsAttempted
+	}
+	return 0
+}
+
+func (x *Stats) GetOffensiveRebounds() int32 {
+	if x != nil {
+		return x.OffensiveRebounds
+	}
+	return 0
+}
+
+func (x *Stats) GetDefensiveRebounds() int32 {
+	if x != nil {
+		return x.DefensiveRebounds
+	}
+	return 0
+}
+
+func (x *Stats) GetAssists() int32 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *Stats) GetTurnovers() int32 {
+	if x != nil {
+		return x.Turnovers
+	}
+	return 0
+}
+
+func (x *Stats) GetSteals() int32 {
+	if x != nil {
+		return x.Steals
+	}
+	return 0
+}
+
+func (x *Stats) GetBlocks() int32 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *Stats) GetRegularFoulsForced() int32 {
+	if x != nil {
+		return x.RegularFoulsForced
+	}
+	return 0
+}
+
+func (x *Stats) GetRegularFoulsCommitted() int32 {
+	if x != nil {
+		return x.RegularFoulsCommitted
+	}
+	return 0
+}
+
+func (x *Stats) GetTechnicalFoulsCommitted() int32 {
+	if x != nil {
+		return x.TechnicalFoulsCommitted
+	}
+	return 0
+}
+
+func (x *Stats) GetMinutesPlayed() int32 {
+	if x != nil {
+		return x.MinutesPlayed
+	}
+	return 0
+}
+
+func (x *Stats) GetGameCount() int32 {
+	if x != nil {
+		return x.GameCount
+	}
+	return 0
+}
+
+func (x *Stats) GetAdvanced() *AdvancedStats {
+	if x != nil {
+		return x.Advanced
+	}
+	return nil
+}
+
+// ratios are zero when their denominator is zero
+type AdvancedStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points                       int32       `protobuf:"varint,1,opt,name=Points,proto3" json:"Points"`
+	FieldGoalsMade               int32       `protobuf:"varint,2,opt,name=FieldGoalsMade,proto3" json:"FieldGoalsMade"`
+	FieldGoalsAttempted          int32       `protobuf:"varint,3,opt,name=FieldGoalsAttempted,proto3" json:"FieldGoalsAttempted"`
+	EffectiveFieldGoalPercentage float64     `protobuf:"fixed64,4,opt,name=EffectiveFieldGoalPercentage,proto3" json:"EffectiveFieldGoalPercentage"`
+	TrueShootingPercentage       float64     `protobuf:"fixed64,5,opt,name=TrueShootingPercentage,proto3" json:"TrueShootingPercentage"`
+	AssistToTurnoverRatio        float64     `protobuf:"fixed64,6,opt,name=AssistToTurnoverRatio,proto3" json:"AssistToTurnoverRatio"` // assists when there are no turnovers
+	TotalRebounds                int32       `protobuf:"varint,7,opt,name=TotalRebounds,proto3" json:"TotalRebounds"`
+	OffensiveReboundShare        float64     `protobuf:"fixed64,8,opt,name=OffensiveReboundShare,proto3" json:"OffensiveReboundShare"` // of TotalRebounds
+	DefensiveReboundShare        float64     `protobuf:"fixed64,9,opt,name=DefensiveReboundShare,proto3" json:"DefensiveReboundShare"` // of TotalRebounds
+	PointsPerShot                float64     `protobuf:"fixed64,10,opt,name=PointsPerShot,proto3" json:"PointsPerShot"`
+	FreeThrowRate                float64     `protobuf:"fixed64,11,opt,name=FreeThrowRate,proto3" json:"FreeThrowRate"` // free throws attempted per field goal attempted
+	Per36                        *Per36Stats `protobuf:"bytes,12,opt,name=Per36,proto3" json:"Per36"`                   // not set when no minutes were played
+	FieldGoalPercentage          float64     `protobuf:"fixed64,13,opt,name=FieldGoalPercentage,proto3" json:"FieldGoalPercentage"`
+}
+
+func (x *AdvancedStats) Reset() {
+	*x = AdvancedStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdvancedStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvancedStats) ProtoMessage() {}
+
+func (x *AdvancedStats) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvancedStats.ProtoReflect.Descriptor instead.
+func (*AdvancedStats) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AdvancedStats) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetFieldGoalsMade() int32 {
+	if x != nil {
+		return x.FieldGoalsMade
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetFieldGoalsAttempted() int32 {
+	if x != nil {
+		return x.FieldGoalsAttempted
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetEffectiveFieldGoalPercentage() float64 {
+	if x != nil {
+		return x.EffectiveFieldGoalPercentage
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetTrueShootingPercentage() float64 {
+	if x != nil {
+		return x.TrueShootingPercentage
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetAssistToTurnoverRatio() float64 {
+	if x != nil {
+		return x.AssistToTurnoverRatio
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetTotalRebounds() int32 {
+	if x != nil {
+		return x.TotalRebounds
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetOffensiveReboundShare() float64 {
+	if x != nil {
+		return x.OffensiveReboundShare
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetDefensiveReboundShare() float64 {
+	if x != nil {
+		return x.DefensiveReboundShare
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetPointsPerShot() float64 {
+	if x != nil {
+		return x.PointsPerShot
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetFreeThrowRate() float64 {
+	if x != nil {
+		return x.FreeThrowRate
+	}
+	return 0
+}
+
+func (x *AdvancedStats) GetPer36() *Per36Stats {
+	if x != nil {
+		return x.Per36
+	}
+	return nil
+}
+
+func (x *AdvancedStats) GetFieldGoalPercentage() float64 {
+	if x != nil {
+		return x.FieldGoalPercentage
+	}
+	return 0
+}
+
+type Per36Stats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points              float64 `protobuf:"fixed64,1,opt,name=Points,proto3" json:"Points"`
+	Rebounds            float64 `protobuf:"fixed64,2,opt,name=Rebounds,proto3" json:"Rebounds"`
+	OffensiveRebounds   float64 `protobuf:"fixed64,3,opt,name=OffensiveRebounds,proto3" json:"OffensiveRebounds"`
+	DefensiveRebounds   float64 `protobuf:"fixed64,4,opt,name=DefensiveRebounds,proto3" json:"DefensiveRebounds"`
+	Assists             float64 `protobuf:"fixed64,5,opt,name=Assists,proto3" json:"Assists"`
+	Steals              float64 `protobuf:"fixed64,6,opt,name=Steals,proto3" json:"Steals"`
+	Blocks              float64 `protobuf:"fixed64,7,opt,name=Blocks,proto3" json:"Blocks"`
+	Turnovers           float64 `protobuf:"fixed64,8,opt,name=Turnovers,proto3" json:"Turnovers"`
+	FieldGoalsAttempted float64 `protobuf:"fixed64,9,opt,name=FieldGoalsAttempted,proto3" json:"FieldGoalsAttempted"`
+	FreeThrowsAttempted float64 `protobuf:"fixed64,10,opt,name=FreeThrowsAttempted,proto3" json:"FreeThrowsAttempted"`
+}
+
+func (x *Per36Stats) Reset() {
+	*x = Per36Stats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Per36Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Per36Stats) ProtoMessage() {}
+
+func (x *Per36Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Per36Stats.ProtoReflect.Descriptor instead.
+func (*Per36Stats) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Per36Stats) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetRebounds() float64 {
+	if x != nil {
+		return x.Rebounds
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetOffensiveRebounds() float64 {
+	if x != nil {
+		return x.OffensiveRebounds
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetDefensiveRebounds() float64 {
+	if x != nil {
+		return x.DefensiveRebounds
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetAssists() float64 {
+	if x != nil {
+		return x.Assists
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetSteals() float64 {
+	if x != nil {
+		return x.Steals
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetBlocks() float64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetTurnovers() float64 {
+	if x != nil {
+		return x.Turnovers
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetFieldGoalsAttempted() float64 {
+	if x != nil {
+		return x.FieldGoalsAttempted
+	}
+	return 0
+}
+
+func (x *Per36Stats) GetFreeThrowsAttempted() float64 {
+	if x != nil {
+		return x.FreeThrowsAttempted
+	}
+	return 0
+}
+
+type PlayerProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name"`
+	YearStarted int32  `protobuf:"varint,2,opt,name=YearStarted,proto3" json:"YearStarted"`
+	Position    string `protobuf:"bytes,3,opt,name=Position,proto3" json:"Position"`
+	Description string `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description"`
+}
+
+func (x *PlayerProfile) Reset() {
+	*x = PlayerProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProfile) ProtoMessage() {}
+
+func (x *PlayerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProfile.ProtoReflect.Descriptor instead.
+func (*PlayerProfile) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlayerProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetYearStarted() int32 {
+	if x != nil {
+		return x.YearStarted
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type PlayerGameStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatsId int32   `protobuf:"varint,1,opt,name=StatsId,proto3" json:"StatsId"`
+	GameId  int32   `protobuf:"varint,2,opt,name=GameId,proto3" json:"GameId"`
+	Team    *Team   `protobuf:"bytes,3,opt,name=Team,proto3" json:"Team"`
+	Player  *Player `protobuf:"bytes,4,opt,name=Player,proto3" json:"Player"`
+	Stats   *Stats  `protobuf:"bytes,5,opt,name=Stats,proto3" json:"Stats"`
+}
+
+func (x *PlayerGameStats) Reset() {
+	*x = PlayerGameStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerGameStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerGameStats) ProtoMessage() {}
+
+func (x *PlayerGameStats) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerGameStats.ProtoReflect.Descriptor instead.
+func (*PlayerGameStats) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PlayerGameStats) GetStatsId() int32 {
+	if x != nil {
+		return x.StatsId
+	}
+	return 0
+}
+
+func (x *PlayerGameStats) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *PlayerGameStats) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *PlayerGameStats) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerGameStats) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type PlayerAggregateStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=Player,proto3" json:"Player"`
+	Stats  *Stats  `protobuf:"bytes,3,opt,name=Stats,proto3" json:"Stats"`
+}
+
+func (x *PlayerAggregateStats) Reset() {
+	*x = PlayerAggregateStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerAggregateStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAggregateStats) ProtoMessage() {}
+
+func (x *PlayerAggregateStats) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAggregateStats.ProtoReflect.Descriptor instead.
+func (*PlayerAggregateStats) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlayerAggregateStats) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerAggregateStats) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type PlayerTeam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Competition    *Competition          `protobuf:"bytes,1,opt,name=Competition,proto3" json:"Competition"`
+	Team           *Team                 `protobuf:"bytes,2,opt,name=Team,proto3" json:"Team"`
+	AggregateStats *PlayerAggregateStats `protobuf:"bytes,3,opt,name=AggregateStats,proto3" json:"AggregateStats"`
+	JerseyNumbers  []int32               `protobuf:"varint,4,rep,packed,name=JerseyNumbers,proto3" json:"JerseyNumbers"`
+}
+
+func (x *PlayerTeam) Reset() {
+	*x = PlayerTeam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerTeam) ProtoMessage() {}
+
+func (x *PlayerTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerTeam.ProtoReflect.Descriptor instead.
+func (*PlayerTeam) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayerTeam) GetCompetition() *Competition {
+	if x != nil {
+		return x.Competition
+	}
+	return nil
+}
+
+func (x *PlayerTeam) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *PlayerTeam) GetAggregateStats() *PlayerAggregateStats {
+	if x != nil {
+		return x.AggregateStats
+	}
+	return nil
+}
+
+func (x *PlayerTeam) GetJerseyNumbers() []int32 {
+	if x != nil {
+		return x.JerseyNumbers
+	}
+	return nil
+}
+
+type Game struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId        int32        `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+	HomeTeam      *Team        `protobuf:"bytes,2,opt,name=HomeTeam,proto3" json:"HomeTeam"`
+	AwayTeam      *Team        `protobuf:"bytes,3,opt,name=AwayTeam,proto3" json:"AwayTeam"`
+	Location      *Location    `protobuf:"bytes,4,opt,name=Location,proto3" json:"Location"`
+	Competition   *Competition `protobuf:"bytes,5,opt,name=Competition,proto3" json:"Competition"`
+	Result        *GameResult  `protobuf:"bytes,6,opt,name=Result,proto3" json:"Result"` // a forfeit is scored by rule, not from the stats
+	GameTime      string       `protobuf:"bytes,7,opt,name=GameTime,proto3" json:"GameTime"`
+	Status        string       `protobuf:"bytes,8,opt,name=Status,proto3" json:"Status"`                // scheduled, in-progress, final, postponed, cancelled or forfeit
+	ForfeitTeamId int32        `protobuf:"varint,9,opt,name=ForfeitTeamId,proto3" json:"ForfeitTeamId"` // the team that forfeited, 0 unless Status is forfeit
+	SeriesId      int32        `protobuf:"varint,10,opt,name=SeriesId,proto3" json:"SeriesId"`          // the playoff series, 0 in the regular season
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Game) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *Game) GetHomeTeam() *Team {
+	if x != nil {
+		return x.HomeTeam
+	}
+	return nil
+}
+
+func (x *Game) GetAwayTeam() *Team {
+	if x != nil {
+		return x.AwayTeam
+	}
+	return nil
+}
+
+func (x *Game) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Game) GetCompetition() *Competition {
+	if x != nil {
+		return x.Competition
+	}
+	return nil
+}
+
+func (x *Game) GetResult() *GameResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Game) GetGameTime() string {
+	if x != nil {
+		return x.GameTime
+	}
+	return ""
+}
+
+func (x *Game) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Game) GetForfeitTeamId() int32 {
+	if x != nil {
+		return x.ForfeitTeamId
+	}
+	return 0
+}
+
+func (x *Game) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+type GameResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeTeamId     int32 `protobuf:"varint,1,opt,name=HomeTeamId,proto3" json:"HomeTeamId"`
+	HomeTeamPoints int32 `protobuf:"varint,2,opt,name=HomeTeamPoints,proto3" json:"HomeTeamPoints"`
+	AwayTeamId     int32 `protobuf:"varint,3,opt,name=AwayTeamId,proto3" json:"AwayTeamId"`
+	AwayTeamPoints int32 `protobuf:"varint,4,opt,name=AwayTeamPoints,proto3" json:"AwayTeamPoints"`
+}
+
+func (x *GameResult) Reset() {
+	*x = GameResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameResult) ProtoMessage() {}
+
+func (x *GameResult) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameResult.ProtoReflect.Descriptor instead.
+func (*GameResult) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GameResult) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *GameResult) GetHomeTeamPoints() int32 {
+	if x != nil {
+		return x.HomeTeamPoints
+	}
+	return 0
+}
+
+func (x *GameResult) GetAwayTeamId() int32 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *GameResult) GetAwayTeamPoints() int32 {
+	if x != nil {
+		return x.AwayTeamPoints
+	}
+	return 0
+}
+
+type PlayerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId       int32                 `protobuf:"varint,1,opt,name=PlayerId,proto3" json:"PlayerId"`
+	Profile        *PlayerProfile        `protobuf:"bytes,2,opt,name=Profile,proto3" json:"Profile"`
+	Teams          []*PlayerTeam         `protobuf:"bytes,3,rep,name=Teams,proto3" json:"Teams"`
+	AggregateStats *PlayerAggregateStats `protobuf:"bytes,4,opt,name=AggregateStats,proto3" json:"AggregateStats"`
+	RecentGames    *GamesCursor          `protobuf:"bytes,5,opt,name=RecentGames,proto3" json:"RecentGames"`
+	RecentStats    []*PlayerGameStats    `protobuf:"bytes,7,rep,name=RecentStats,proto3" json:"RecentStats"`
+	CareerHighs    []*GameRecord         `protobuf:"bytes,8,rep,name=CareerHighs,proto3" json:"CareerHighs"`       // one for each GameRecords category the player has played
+	DoubleDoubles  int32                 `protobuf:"varint,9,opt,name=DoubleDoubles,proto3" json:"DoubleDoubles"`  // games with 10 or more in two of points, rebounds, assists, steals and blocks
+	TripleDoubles  int32                 `protobuf:"varint,10,opt,name=TripleDoubles,proto3" json:"TripleDoubles"` // or in three of them
+	Awards         []*PlayerAward        `protobuf:"bytes,11,rep,name=Awards,proto3" json:"Awards"`                // the awards the player has points in
+}
+
+func (x *PlayerInfo) Reset() {
+	*x = PlayerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerInfo) ProtoMessage() {}
+
+func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
+func (*PlayerInfo) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PlayerInfo) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *PlayerInfo) GetTeams() []*PlayerTeam {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *PlayerInfo) GetAggregateStats() *PlayerAggregateStats {
+	if x != nil {
+		return x.AggregateStats
+	}
+	return nil
+}
+
+func (x *PlayerInfo) GetRecentGames() *GamesCursor {
+	if x != nil {
+		return x.RecentGames
+	}
+	return nil
+}
+
+func (x *PlayerInfo) GetRecentStats() []*PlayerGameStats {
+	if x != nil {
+		return x.RecentStats
+	}
+	return nil
+}
+
+func (x *PlayerInfo) GetCareerHighs() []*GameRecord {
+	if x != nil {
+		return x.CareerHighs
+	}
+	return nil
+}
+
+func (x *PlayerInfo) GetDoubleDoubles() int32 {
+	if x != nil {
+		return x.DoubleDoubles
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetTripleDoubles() int32 {
+	if x != nil {
+		return x.TripleDoubles
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetAwards() []*PlayerAward {
+	if x != nil {
+		return x.Awards
+	}
+	return nil
+}
+
+// a single game total, the first game a value was reached in wins a tie
+type GameRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category string  `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category"` // points, rebounds, assists, steals, blocks or three-pointers-made
+	Value    int32   `protobuf:"varint,2,opt,name=Value,proto3" json:"Value"`
+	GameId   int32   `protobuf:"varint,3,opt,name=GameId,proto3" json:"GameId"`
+	GameTime string  `protobuf:"bytes,4,opt,name=GameTime,proto3" json:"GameTime"`
+	Player   *Player `protobuf:"bytes,5,opt,name=Player,proto3" json:"Player"`
+	Team     *Team   `protobuf:"bytes,6,opt,name=Team,proto3" json:"Team"`
+}
+
+func (x *GameRecord) Reset() {
+	*x = GameRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRecord) ProtoMessage() {}
+
+func (x *GameRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRecord.ProtoReflect.Descriptor instead.
+func (*GameRecord) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GameRecord) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GameRecord) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *GameRecord) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameRecord) GetGameTime() string {
+	if x != nil {
+		return x.GameTime
+	}
+	return ""
+}
+
+func (x *GameRecord) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *GameRecord) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type TeamInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team        *Team           `protobuf:"bytes,1,opt,name=Team,proto3" json:"Team"`
+	Competition *Competition    `protobuf:"bytes,2,opt,name=Competition,proto3" json:"Competition"` // not set when the team has not entered a competition
+	Players     *PlayersCursor  `protobuf:"bytes,3,opt,name=Players,proto3" json:"Players"`
+	RecentGames *GamesCursor    `protobuf:"bytes,4,opt,name=RecentGames,proto3" json:"RecentGames"`
+	Roster      []*RosterPlayer `protobuf:"bytes,5,rep,name=Roster,proto3" json:"Roster"` // registered to the team in Competition
+}
+
+func (x *TeamInfo) Reset() {
+	*x = TeamInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamInfo) ProtoMessage() {}
+
+func (x *TeamInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamInfo.ProtoReflect.Descriptor instead.
+func (*TeamInfo) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TeamInfo) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *TeamInfo) GetCompetition() *Competition {
+	if x != nil {
+		return x.Competition
+	}
+	return nil
+}
+
+func (x *TeamInfo) GetPlayers() *PlayersCursor {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *TeamInfo) GetRecentGames() *GamesCursor {
+	if x != nil {
+		return x.RecentGames
+	}
+	return nil
+}
+
+func (x *TeamInfo) GetRoster() []*RosterPlayer {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+type RosterPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player       *Player `protobuf:"bytes,1,opt,name=Player,proto3" json:"Player"`
+	JerseyNumber int32   `protobuf:"varint,2,opt,name=JerseyNumber,proto3" json:"JerseyNumber"`
+}
+
+func (x *RosterPlayer) Reset() {
+	*x = RosterPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RosterPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterPlayer) ProtoMessage() {}
+
+func (x *RosterPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterPlayer.ProtoReflect.Descriptor instead.
+func (*RosterPlayer) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RosterPlayer) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *RosterPlayer) GetJerseyNumber() int32 {
+	if x != nil {
+		return x.JerseyNumber
+	}
+	return 0
+}
+
+type GameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game         *Game              `protobuf:"bytes,1,opt,name=Game,proto3" json:"Game"`
+	PlayerStats  []*PlayerGameStats `protobuf:"bytes,2,rep,name=PlayerStats,proto3" json:"PlayerStats"`
+	PeriodScores []*PeriodScore     `protobuf:"bytes,3,rep,name=PeriodScores,proto3" json:"PeriodScores"` // empty without period or event data
+	Flow         *GameFlow          `protobuf:"bytes,4,opt,name=Flow,proto3" json:"Flow"`                 // unset without period or event data
+	Awards       []*AwardGameResult `protobuf:"bytes,5,rep,name=Awards,proto3" json:"Awards"`             // one for each of the competition's awards, once the game is final
+}
+
+func (x *GameInfo) Reset() {
+	*x = GameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameInfo) ProtoMessage() {}
+
+func (x *GameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameInfo.ProtoReflect.Descriptor instead.
+func (*GameInfo) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GameInfo) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GameInfo) GetPlayerStats() []*PlayerGameStats {
+	if x != nil {
+		return x.PlayerStats
+	}
+	return nil
+}
+
+func (x *GameInfo) GetPeriodScores() []*PeriodScore {
+	if x != nil {
+		return x.PeriodScores
+	}
+	return nil
+}
+
+func (x *GameInfo) GetFlow() *GameFlow {
+	if x != nil {
+		return x.Flow
+	}
+	return nil
+}
+
+func (x *GameInfo) GetAwards() []*AwardGameResult {
+	if x != nil {
+		return x.Awards
+	}
+	return nil
+}
+
+// points scored in a single period, not cumulative
+type PeriodScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period         int32 `protobuf:"varint,1,opt,name=Period,proto3" json:"Period"`     // from 1
+	Overtime       bool  `protobuf:"varint,2,opt,name=Overtime,proto3" json:"Overtime"` // after the competition's regulation periods
+	HomeTeamPoints int32 `protobuf:"varint,3,opt,name=HomeTeamPoints,proto3" json:"HomeTeamPoints"`
+	AwayTeamPoints int32 `protobuf:"varint,4,opt,name=AwayTeamPoints,proto3" json:"AwayTeamPoints"`
+}
+
+func (x *PeriodScore) Reset() {
+	*x = PeriodScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeriodScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodScore) ProtoMessage() {}
+
+func (x *PeriodScore) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodScore.ProtoReflect.Descriptor instead.
+func (*PeriodScore) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PeriodScore) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *PeriodScore) GetOvertime() bool {
+	if x != nil {
+		return x.Overtime
+	}
+	return false
+}
+
+func (x *PeriodScore) GetHomeTeamPoints() int32 {
+	if x != nil {
+		return x.HomeTeamPoints
+	}
+	return 0
+}
+
+func (x *PeriodScore) GetAwayTeamPoints() int32 {
+	if x != nil {
+		return x.AwayTeamPoints
+	}
+	return 0
+}
+
+type GameFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HomeTeamLargestLead int32 `protobuf:"varint,1,opt,name=HomeTeamLargestLead,proto3" json:"HomeTeamLargestLead"` // 0 if they never led
+	AwayTeamLargestLead int32 `protobuf:"varint,2,opt,name=AwayTeamLargestLead,proto3" json:"AwayTeamLargestLead"`
+	LeadChanges         int32 `protobuf:"varint,3,opt,name=LeadChanges,proto3" json:"LeadChanges"`
+	FromEvents          bool  `protobuf:"varint,4,opt,name=FromEvents,proto3" json:"FromEvents"` // otherwise only the score at the end of each period was known
+}
+
+func (x *GameFlow) Reset() {
+	*x = GameFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameFlow) ProtoMessage() {}
+
+func (x *GameFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameFlow.ProtoReflect.Descriptor instead.
+func (*GameFlow) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GameFlow) GetHomeTeamLargestLead() int32 {
+	if x != nil {
+		return x.HomeTeamLargestLead
+	}
+	return 0
+}
+
+func (x *GameFlow) GetAwayTeamLargestLead() int32 {
+	if x != nil {
+		return x.AwayTeamLargestLead
+	}
+	return 0
+}
+
+func (x *GameFlow) GetLeadChanges() int32 {
+	if x != nil {
+		return x.LeadChanges
+	}
+	return 0
+}
+
+func (x *GameFlow) GetFromEvents() bool {
+	if x != nil {
+		return x.FromEvents
+	}
+	return false
+}
+
+type CompetitionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Competition    *Competition       `protobuf:"bytes,1,opt,name=Competition,proto3" json:"Competition"`
+	RecentGames    *GamesCursor       `protobuf:"bytes,2,opt,name=RecentGames,proto3" json:"RecentGames"`
+	Locations      []*Location        `protobuf:"bytes,3,rep,name=Locations,proto3" json:"Locations"`
+	Teams          []*CompetitionTeam `protobuf:"bytes,4,rep,name=Teams,proto3" json:"Teams"`
+	FirstGameTime  string             `protobuf:"bytes,5,opt,name=FirstGameTime,proto3" json:"FirstGameTime"`
+	LastGameTime   string             `protobuf:"bytes,6,opt,name=LastGameTime,proto3" json:"LastGameTime"`
+	StandingsRules *StandingsRules    `protobuf:"bytes,7,opt,name=StandingsRules,proto3" json:"StandingsRules"` // how Teams are ordered
+	Awards         []*Award           `protobuf:"bytes,8,rep,name=Awards,proto3" json:"Awards"`
+}
+
+func (x *CompetitionInfo) Reset() {
+	*x = CompetitionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompetitionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompetitionInfo) ProtoMessage() {}
+
+func (x *CompetitionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompetitionInfo.ProtoReflect.Descriptor instead.
+func (*CompetitionInfo) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CompetitionInfo) GetCompetition() *Competition {
+	if x != nil {
+		return x.Competition
+	}
+	return nil
+}
+
+func (x *CompetitionInfo) GetRecentGames() *GamesCursor {
+	if x != nil {
+		return x.RecentGames
+	}
+	return nil
+}
+
+func (x *CompetitionInfo) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *CompetitionInfo) GetTeams() []*CompetitionTeam {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *CompetitionInfo) GetFirstGameTime() string {
+	if x != nil {
+		return x.FirstGameTime
+	}
+	return ""
+}
+
+func (x *CompetitionInfo) GetLastGameTime() string {
+	if x != nil {
+		return x.LastGameTime
+	}
+	return ""
+}
+
+func (x *CompetitionInfo) GetStandingsRules() *StandingsRules {
+	if x != nil {
+		return x.StandingsRules
+	}
+	return nil
+}
+
+func (x *CompetitionInfo) GetAwards() []*Award {
+	if x != nil {
+		return x.Awards
+	}
+	return nil
+}
+
+type GetPlayerInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId int32 `protobuf:"varint,1,opt,name=PlayerId,proto3" json:"PlayerId"`
+}
+
+func (x *GetPlayerInfoRequest) Reset() {
+	*x = GetPlayerInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerInfoRequest) ProtoMessage() {}
+
+func (x *GetPlayerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPlayerInfoRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type GetGameInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+}
+
+func (x *GetGameInfoRequest) Reset() {
+	*x = GetGameInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameInfoRequest) ProtoMessage() {}
+
+func (x *GetGameInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetGameInfoRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetGameInfoRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type GetTeamInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId        int32 `protobuf:"varint,1,opt,name=TeamId,proto3" json:"TeamId"`
+	CompetitionId int32 `protobuf:"varint,2,opt,name=CompetitionId,proto3" json:"CompetitionId"` // optional, defaults to the team's latest competition
+}
+
+func (x *GetTeamInfoRequest) Reset() {
+	*x = GetTeamInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTeamInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamInfoRequest) ProtoMessage() {}
+
+func (x *GetTeamInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamInfoRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTeamInfoRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GetTeamInfoRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+type GetCompetitionInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32 `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+}
+
+func (x *GetCompetitionInfoRequest) Reset() {
+	*x = GetCompetitionInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompetitionInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompetitionInfoRequest) ProtoMessage() {}
+
+func (x *GetCompetitionInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompetitionInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetCompetitionInfoRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetCompetitionInfoRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+type GetGamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int32        `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset"` // where offset from results should start
+	Count  int32        `protobuf:"varint,2,opt,name=Count,proto3" json:"Count"`   // number requested
+	Filter *GamesFilter `protobuf:"bytes,3,opt,name=Filter,proto3" json:"Filter"`
+}
+
+func (x *GetGamesRequest) Reset() {
+	*x = GetGamesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesRequest) ProtoMessage() {}
+
+func (x *GetGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesRequest.ProtoReflect.Descriptor instead.
+func (*GetGamesRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetGamesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetGamesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetGamesRequest) GetFilter() *GamesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GamesFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionIds    []int32  `protobuf:"varint,1,rep,packed,name=CompetitionIds,proto3" json:"CompetitionIds"`       // optional filter
+	TeamIds           []int32  `protobuf:"varint,2,rep,packed,name=TeamIds,proto3" json:"TeamIds"`                     // optional filter
+	PlayerIds         []int32  `protobuf:"varint,3,rep,packed,name=PlayerIds,proto3" json:"PlayerIds"`                 // optional filter
+	Date              *Date    `protobuf:"bytes,4,opt,name=Date,proto3" json:"Date"`                                   // optional
+	Statuses          []string `protobuf:"bytes,5,rep,name=Statuses,proto3" json:"Statuses"`                           // optional filter
+	Phase             string   `protobuf:"bytes,6,opt,name=Phase,proto3" json:"Phase"`                                 // optional, regular-season or playoffs
+	OpponentTeamIds   []int32  `protobuf:"varint,7,rep,packed,name=OpponentTeamIds,proto3" json:"OpponentTeamIds"`     // optional, only games between one of TeamIds and one of these
+	OpponentPlayerIds []int32  `protobuf:"varint,8,rep,packed,name=OpponentPlayerIds,proto3" json:"OpponentPlayerIds"` // optional, only games where one of PlayerIds played against one of these
+}
+
+func (x *GamesFilter) Reset() {
+	*x = GamesFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GamesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamesFilter) ProtoMessage() {}
+
+func (x *GamesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamesFilter.ProtoReflect.Descriptor instead.
+func (*GamesFilter) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GamesFilter) GetCompetitionIds() []int32 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *GamesFilter) GetTeamIds() []int32 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *GamesFilter) GetPlayerIds() []int32 {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *GamesFilter) GetDate() *Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GamesFilter) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GamesFilter) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *GamesFilter) GetOpponentTeamIds() []int32 {
+	if x != nil {
+		return x.OpponentTeamIds
+	}
+	return nil
+}
+
+func (x *GamesFilter) GetOpponentPlayerIds() []int32 {
+	if x != nil {
+		return x.OpponentPlayerIds
+	}
+	return nil
+}
+
+type Date struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day   int32 `protobuf:"varint,1,opt,name=Day,proto3" json:"Day"`
+	Month int32 `protobuf:"varint,2,opt,name=Month,proto3" json:"Month"`
+	Year  int32 `protobuf:"varint,3,opt,name=Year,proto3" json:"Year"`
+}
+
+func (x *Date) Reset() {
+	*x = Date{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Date) ProtoMessage() {}
+
+func (x *Date) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Date.ProtoReflect.Descriptor instead.
+func (*Date) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Date) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *Date) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *Date) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GamesCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextOffset int32        `protobuf:"varint,1,opt,name=NextOffset,proto3" json:"NextOffset"` // where we are up to
+	Total      int32        `protobuf:"varint,2,opt,name=Total,proto3" json:"Total"`           // the total available
+	Games      []*Game      `protobuf:"bytes,3,rep,name=Games,proto3" json:"Games"`            // those in this cursor
+	Filter     *GamesFilter `protobuf:"bytes,4,opt,name=Filter,proto3" json:"Filter"`
+}
+
+func (x *GamesCursor) Reset() {
+	*x = GamesCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GamesCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamesCursor) ProtoMessage() {}
+
+func (x *GamesCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamesCursor.ProtoReflect.Descriptor instead.
+func (*GamesCursor) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GamesCursor) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+func (x *GamesCursor) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GamesCursor) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GamesCursor) GetFilter() *GamesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetPlayersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int32          `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset"`
+	Count  int32          `protobuf:"varint,2,opt,name=Count,proto3" json:"Count"`
+	Filter *PlayersFilter `protobuf:"bytes,3,opt,name=Filter,proto3" json:"Filter"`
+}
+
+func (x *GetPlayersRequest) Reset() {
+	*x = GetPlayersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayersRequest) ProtoMessage() {}
+
+func (x *GetPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayersRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPlayersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetPlayersRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetPlayersRequest) GetFilter() *PlayersFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type PlayersFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionIds []int32 `protobuf:"varint,1,rep,packed,name=CompetitionIds,proto3" json:"CompetitionIds"` // optional filter
+	TeamIds        []int32 `protobuf:"varint,2,rep,packed,name=TeamIds,proto3" json:"TeamIds"`               // optional filter
+}
+
+func (x *PlayersFilter) Reset() {
+	*x = PlayersFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayersFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayersFilter) ProtoMessage() {}
+
+func (x *PlayersFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayersFilter.ProtoReflect.Descriptor instead.
+func (*PlayersFilter) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PlayersFilter) GetCompetitionIds() []int32 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *PlayersFilter) GetTeamIds() []int32 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+type PlayersCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextOffset int32          `protobuf:"varint,1,opt,name=NextOffset,proto3" json:"NextOffset"`
+	Total      int32          `protobuf:"varint,2,opt,name=Total,proto3" json:"Total"`
+	Players    []*Player      `protobuf:"bytes,3,rep,name=Players,proto3" json:"Players"`
+	Filter     *PlayersFilter `protobuf:"bytes,4,opt,name=Filter,proto3" json:"Filter"`
+}
+
+func (x *PlayersCursor) Reset() {
+	*x = PlayersCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayersCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayersCursor) ProtoMessage() {}
+
+func (x *PlayersCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayersCursor.ProtoReflect.Descriptor instead.
+func (*PlayersCursor) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PlayersCursor) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+func (x *PlayersCursor) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PlayersCursor) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *PlayersCursor) GetFilter() *PlayersFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// bool being true will cause the response to include that information
+type GetHeroBallMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Competitions bool `protobuf:"varint,1,opt,name=Competitions,proto3" json:"Competitions"`
+	Teams        bool `protobuf:"varint,2,opt,name=Teams,proto3" json:"Teams"`
+	Players      bool `protobuf:"varint,3,opt,name=Players,proto3" json:"Players"`
+}
+
+func (x *GetHeroBallMetadataRequest) Reset() {
+	*x = GetHeroBallMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeroBallMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeroBallMetadataRequest) ProtoMessage() {}
+
+func (x *GetHeroBallMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeroBallMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetHeroBallMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetHeroBallMetadataRequest) GetCompetitions() bool {
+	if x != nil {
+		return x.Competitions
+	}
+	return false
+}
+
+func (x *GetHeroBallMetadataRequest) GetTeams() bool {
+	if x != nil {
+		return x.Teams
+	}
+	return false
+}
+
+func (x *GetHeroBallMetadataRequest) GetPlayers() bool {
+	if x != nil {
+		return x.Players
+	}
+	return false
+}
+
+type HeroBallMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Competitions []*Competition `protobuf:"bytes,1,rep,name=Competitions,proto3" json:"Competitions"`
+	Teams        []*Team        `protobuf:"bytes,2,rep,name=Teams,proto3" json:"Teams"`
+	Players      []*Player      `protobuf:"bytes,3,rep,name=Players,proto3" json:"Players"`
+}
+
+func (x *HeroBallMetadata) Reset() {
+	*x = HeroBallMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeroBallMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeroBallMetadata) ProtoMessage() {}
+
+func (x *HeroBallMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeroBallMetadata.ProtoReflect.Descriptor instead.
+func (*HeroBallMetadata) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *HeroBallMetadata) GetCompetitions() []*Competition {
+	if x != nil {
+		return x.Competitions
+	}
+	return nil
+}
+
+func (x *HeroBallMetadata) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *HeroBallMetadata) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type ForStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionIds []int32 `protobuf:"varint,1,rep,packed,name=CompetitionIds,proto3" json:"CompetitionIds"`
+	TeamIds        []int32 `protobuf:"varint,2,rep,packed,name=TeamIds,proto3" json:"TeamIds"`
+	PlayerIds      []int32 `protobuf:"varint,3,rep,packed,name=PlayerIds,proto3" json:"PlayerIds"`
+}
+
+func (x *ForStatsRequest) Reset() {
+	*x = ForStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForStatsRequest) ProtoMessage() {}
+
+func (x *ForStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForStatsRequest.ProtoReflect.Descriptor instead.
+func (*ForStatsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ForStatsRequest) GetCompetitionIds() []int32 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *ForStatsRequest) GetTeamIds() []int32 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *ForStatsRequest) GetPlayerIds() []int32 {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+type AgainstStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionIds []int32 `protobuf:"varint,1,rep,packed,name=CompetitionIds,proto3" json:"CompetitionIds"`
+	TeamIds        []int32 `protobuf:"varint,2,rep,packed,name=TeamIds,proto3" json:"TeamIds"`
+}
+
+func (x *AgainstStatsRequest) Reset() {
+	*x = AgainstStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgainstStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgainstStatsRequest) ProtoMessage() {}
+
+func (x *AgainstStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgainstStatsRequest.ProtoReflect.Descriptor instead.
+func (*AgainstStatsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AgainstStatsRequest) GetCompetitionIds() []int32 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *AgainstStatsRequest) GetTeamIds() []int32 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+type GetPlayerAverageStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset       int32                `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset"`
+	Count        int32                `protobuf:"varint,2,opt,name=Count,proto3" json:"Count"`
+	MinimumGames int32                `protobuf:"varint,3,opt,name=MinimumGames,proto3" json:"MinimumGames"`
+	For          *ForStatsRequest     `protobuf:"bytes,4,opt,name=For,proto3" json:"For"`
+	Against      *AgainstStatsRequest `protobuf:"bytes,5,opt,name=Against,proto3" json:"Against"`
+	// one of PPG, RPG, ORPG, DRPG, APG, BPG, SPG, 2PFG, 3PFG, MPG, TPG, FT,
+	//EFG, TS, ASTTO, PPS, FTR, PTS36, REB36, AST36, STL36, BLK36, TOV36
+	Ordering string `protobuf:"bytes,6,opt,name=Ordering,proto3" json:"Ordering"`
+	Phase    string `protobuf:"bytes,7,opt,name=Phase,proto3" json:"Phase"` // optional, regular-season or playoffs
+}
+
+func (x *GetPlayerAverageStatsRequest) Reset() {
+	*x = GetPlayerAverageStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerAverageStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerAverageStatsRequest) ProtoMessage() {}
+
+func (x *GetPlayerAverageStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerAverageStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerAverageStatsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPlayerAverageStatsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetPlayerAverageStatsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetPlayerAverageStatsRequest) GetMinimumGames() int32 {
+	if x != nil {
+		return x.MinimumGames
+	}
+	return 0
+}
+
+func (x *GetPlayerAverageStatsRequest) GetFor() *ForStatsRequest {
+	if x != nil {
+		return x.For
+	}
+	return nil
+}
+
+func (x *GetPlayerAverageStatsRequest) GetAgainst() *AgainstStatsRequest {
+	if x != nil {
+		return x.Against
+	}
+	return nil
+}
+
+func (x *GetPlayerAverageStatsRequest) GetOrdering() string {
+	if x != nil {
+		return x.Ordering
+	}
+	return ""
+}
+
+func (x *GetPlayerAverageStatsRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+type GetPlayerAverageStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AggregateStats []*PlayerAggregateStats `protobuf:"bytes,1,rep,name=AggregateStats,proto3" json:"AggregateStats"`
+}
+
+func (x *GetPlayerAverageStatsResponse) Reset() {
+	*x = GetPlayerAverageStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerAverageStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerAverageStatsResponse) ProtoMessage() {}
+
+func (x *GetPlayerAverageStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerAverageStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerAverageStatsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetPlayerAverageStatsResponse) GetAggregateStats() []*PlayerAggregateStats {
+	if x != nil {
+		return x.AggregateStats
+	}
+	return nil
+}
+
+type TeamAggregateStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team          *Team  `protobuf:"bytes,1,opt,name=Team,proto3" json:"Team"`
+	Stats         *Stats `protobuf:"bytes,2,opt,name=Stats,proto3" json:"Stats"`
+	OpponentStats *Stats `protobuf:"bytes,3,opt,name=OpponentStats,proto3" json:"OpponentStats"` // what the team allowed over the same games
+}
+
+func (x *TeamAggregateStats) Reset() {
+	*x = TeamAggregateStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamAggregateStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamAggregateStats) ProtoMessage() {}
+
+func (x *TeamAggregateStats) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamAggregateStats.ProtoReflect.Descriptor instead.
+func (*TeamAggregateStats) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TeamAggregateStats) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *TeamAggregateStats) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *TeamAggregateStats) GetOpponentStats() *Stats {
+	if x != nil {
+		return x.OpponentStats
+	}
+	return nil
+}
+
+type GetTeamAverageStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset       int32                `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset"`
+	Count        int32                `protobuf:"varint,2,opt,name=Count,proto3" json:"Count"`
+	MinimumGames int32                `protobuf:"varint,3,opt,name=MinimumGames,proto3" json:"MinimumGames"`
+	For          *ForStatsRequest     `protobuf:"bytes,4,opt,name=For,proto3" json:"For"` // PlayerIds limits to games any of them played in
+	Against      *AgainstStatsRequest `protobuf:"bytes,5,opt,name=Against,proto3" json:"Against"`
+	// any GetPlayerAverageStatsRequest ordering, or PAPG (fewest points allowed)
+	//and OPPFG (lowest opponent field goal percentage)
+	Ordering string `protobuf:"bytes,6,opt,name=Ordering,proto3" json:"Ordering"`
+	Phase    string `protobuf:"bytes,7,opt,name=Phase,proto3" json:"Phase"` // optional, regular-season or playoffs
+}
+
+func (x *GetTeamAverageStatsRequest) Reset() {
+	*x = GetTeamAverageStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTeamAverageStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamAverageStatsRequest) ProtoMessage() {}
+
+func (x *GetTeamAverageStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamAverageStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamAverageStatsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetTeamAverageStatsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTeamAverageStatsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetTeamAverageStatsRequest) GetMinimumGames() int32 {
+	if x != nil {
+		return x.MinimumGames
+	}
+	return 0
+}
+
+func (x *GetTeamAverageStatsRequest) GetFor() *ForStatsRequest {
+	if x != nil {
+		return x.For
+	}
+	return nil
+}
+
+func (x *GetTeamAverageStatsRequest) GetAgainst() *AgainstStatsRequest {
+	if x != nil {
+		return x.Against
+	}
+	return nil
+}
+
+func (x *GetTeamAverageStatsRequest) GetOrdering() string {
+	if x != nil {
+		return x.Ordering
+	}
+	return ""
+}
+
+func (x *GetTeamAverageStatsRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+type GetTeamAverageStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AggregateStats []*TeamAggregateStats `protobuf:"bytes,1,rep,name=AggregateStats,proto3" json:"AggregateStats"`
+}
+
+func (x *GetTeamAverageStatsResponse) Reset() {
+	*x = GetTeamAverageStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTeamAverageStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamAverageStatsResponse) ProtoMessage() {}
+
+func (x *GetTeamAverageStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamAverageStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamAverageStatsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTeamAverageStatsResponse) GetAggregateStats() []*TeamAggregateStats {
+	if x != nil {
+		return x.AggregateStats
+	}
+	return nil
+}
+
+// one player's stats split by home and away, result, month, location and opponent, over the games the filters leave
+type GetPlayerSplitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId       int32   `protobuf:"varint,1,opt,name=PlayerId,proto3" json:"PlayerId"`
+	CompetitionIds []int32 `protobuf:"varint,2,rep,packed,name=CompetitionIds,proto3" json:"CompetitionIds"` // optional filter
+	Phase          string  `protobuf:"bytes,3,opt,name=Phase,proto3" json:"Phase"`                           // optional, regular-season or playoffs
+}
+
+func (x *GetPlayerSplitsRequest) Reset() {
+	*x = GetPlayerSplitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerSplitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerSplitsRequest) ProtoMessage() {}
+
+func (x *GetPlayerSplitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerSplitsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerSplitsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPlayerSplitsRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GetPlayerSplitsRequest) GetCompetitionIds() []int32 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *GetPlayerSplitsRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+type MonthSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Month string `protobuf:"bytes,1,opt,name=Month,proto3" json:"Month"` // "2006-01"
+	Stats *Stats `protobuf:"bytes,2,opt,name=Stats,proto3" json:"Stats"`
+}
+
+func (x *MonthSplit) Reset() {
+	*x = MonthSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonthSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthSplit) ProtoMessage() {}
+
+func (x *MonthSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthSplit.ProtoReflect.Descriptor instead.
+func (*MonthSplit) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MonthSplit) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthSplit) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type LocationSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *Location `protobuf:"bytes,1,opt,name=Location,proto3" json:"Location"`
+	Stats    *Stats    `protobuf:"bytes,2,opt,name=Stats,proto3" json:"Stats"`
+}
+
+func (x *LocationSplit) Reset() {
+	*x = LocationSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationSplit) ProtoMessage() {}
+
+func (x *LocationSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationSplit.ProtoReflect.Descriptor instead.
+func (*LocationSplit) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LocationSplit) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *LocationSplit) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type OpponentSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Opponent *Team  `protobuf:"bytes,1,opt,name=Opponent,proto3" json:"Opponent"`
+	Stats    *Stats `protobuf:"bytes,2,opt,name=Stats,proto3" json:"Stats"`
+}
+
+func (x *OpponentSplit) Reset() {
+	*x = OpponentSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpponentSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpponentSplit) ProtoMessage() {}
+
+func (x *OpponentSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpponentSplit.ProtoReflect.Descriptor instead.
+func (*OpponentSplit) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *OpponentSplit) GetOpponent() *Team {
+	if x != nil {
+		return x.Opponent
+	}
+	return nil
+}
+
+func (x *OpponentSplit) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// the player's totals over final and forfeited games, a split the player has no games in is unset
+type PlayerSplits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player    *Player          `protobuf:"bytes,1,opt,name=Player,proto3" json:"Player"`
+	Home      *Stats           `protobuf:"bytes,2,opt,name=Home,proto3" json:"Home"`
+	Away      *Stats           `protobuf:"bytes,3,opt,name=Away,proto3" json:"Away"`
+	Wins      *Stats           `protobuf:"bytes,4,opt,name=Wins,proto3" json:"Wins"` // by the team the player played for
+	Losses    *Stats           `protobuf:"bytes,5,opt,name=Losses,proto3" json:"Losses"`
+	Draws     *Stats           `protobuf:"bytes,6,opt,name=Draws,proto3" json:"Draws"`
+	Months    []*MonthSplit    `protobuf:"bytes,7,rep,name=Months,proto3" json:"Months"`       // oldest first
+	Locations []*LocationSplit `protobuf:"bytes,8,rep,name=Locations,proto3" json:"Locations"` // most games first
+	Opponents []*OpponentSplit `protobuf:"bytes,9,rep,name=Opponents,proto3" json:"Opponents"` // most games first
+}
+
+func (x *PlayerSplits) Reset() {
+	*x = PlayerSplits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerSplits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSplits) ProtoMessage() {}
+
+func (x *PlayerSplits) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSplits.ProtoReflect.Descriptor instead.
+func (*PlayerSplits) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PlayerSplits) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerSplits) GetHome() *Stats {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *PlayerSplits) GetAway() *Stats {
+	if x != nil {
+		return x.Away
+	}
+	return nil
+}
+
+func (x *PlayerSplits) GetWins() *Stats {
+	if x != nil {
+		return x.Wins
+	}
+	return nil
+}
+
+func (x *PlayerSplits) GetLosses() *Stats {
+	if x != nil {
+		return x.Losses
+	}
+	return nil
+}
+
+func (x *PlayerSplits) GetDraws() *Stats {
+	if x != nil {
+		return x.Draws
+	}
+	return nil
+}
+
+func (x *PlayerSplits) GetMonths() []*MonthSplit {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *PlayerSplits) GetLocations() []*LocationSplit {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *PlayerSplits) GetOpponents() []*OpponentSplit {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+// exactly one of CompetitionId or LeagueId
+type GetRecordsBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32 `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	LeagueId      int32 `protobuf:"varint,2,opt,name=LeagueId,proto3" json:"LeagueId"`
+	MinimumGames  int32 `protobuf:"varint,3,opt,name=MinimumGames,proto3" json:"MinimumGames"` // to qualify for a season record, defaults to 5
+	Count         int32 `protobuf:"varint,4,opt,name=Count,proto3" json:"Count"`               // entries per category, defaults to 5
+}
+
+func (x *GetRecordsBookRequest) Reset() {
+	*x = GetRecordsBookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecordsBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecordsBookRequest) ProtoMessage() {}
+
+func (x *GetRecordsBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecordsBookRequest.ProtoReflect.Descriptor instead.
+func (*GetRecordsBookRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetRecordsBookRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *GetRecordsBookRequest) GetLeagueId() int32 {
+	if x != nil {
+		return x.LeagueId
+	}
+	return 0
+}
+
+func (x *GetRecordsBookRequest) GetMinimumGames() int32 {
+	if x != nil {
+		return x.MinimumGames
+	}
+	return 0
+}
+
+func (x *GetRecordsBookRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GameRecords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category string        `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category"`
+	Records  []*GameRecord `protobuf:"bytes,2,rep,name=Records,proto3" json:"Records"`
+}
+
+func (x *GameRecords) Reset() {
+	*x = GameRecords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRecords) ProtoMessage() {}
+
+func (x *GameRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRecords.ProtoReflect.Descriptor instead.
+func (*GameRecords) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GameRecords) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GameRecords) GetRecords() []*GameRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// a per game average over one competition
+type SeasonRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player      *Player      `protobuf:"bytes,1,opt,name=Player,proto3" json:"Player"`
+	Competition *Competition `protobuf:"bytes,2,opt,name=Competition,proto3" json:"Competition"`
+	Value       float64      `protobuf:"fixed64,3,opt,name=Value,proto3" json:"Value"`
+	GameCount   int32        `protobuf:"varint,4,opt,name=GameCount,proto3" json:"GameCount"`
+}
+
+func (x *SeasonRecord) Reset() {
+	*x = SeasonRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeasonRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonRecord) ProtoMessage() {}
+
+func (x *SeasonRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonRecord.ProtoReflect.Descriptor instead.
+func (*SeasonRecord) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SeasonRecord) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *SeasonRecord) GetCompetition() *Competition {
+	if x != nil {
+		return x.Competition
+	}
+	return nil
+}
+
+func (x *SeasonRecord) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SeasonRecord) GetGameCount() int32 {
+	if x != nil {
+		return x.GameCount
+	}
+	return 0
+}
+
+type SeasonRecords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category string          `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category"` // PPG, RPG, APG, SPG or BPG
+	Records  []*SeasonRecord `protobuf:"bytes,2,rep,name=Records,proto3" json:"Records"`
+}
+
+func (x *SeasonRecords) Reset() {
+	*x = SeasonRecords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeasonRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonRecords) ProtoMessage() {}
+
+func (x *SeasonRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonRecords.ProtoReflect.Descriptor instead.
+func (*SeasonRecords) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SeasonRecords) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SeasonRecords) GetRecords() []*SeasonRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// only games that are final or forfeited, playoffs included
+type RecordsBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameRecords   []*GameRecords   `protobuf:"bytes,1,rep,name=GameRecords,proto3" json:"GameRecords"`
+	SeasonRecords []*SeasonRecords `protobuf:"bytes,2,rep,name=SeasonRecords,proto3" json:"SeasonRecords"`
+}
+
+func (x *RecordsBook) Reset() {
+	*x = RecordsBook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordsBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordsBook) ProtoMessage() {}
+
+func (x *RecordsBook) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordsBook.ProtoReflect.Descriptor instead.
+func (*RecordsBook) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RecordsBook) GetGameRecords() []*GameRecords {
+	if x != nil {
+		return x.GameRecords
+	}
+	return nil
+}
+
+func (x *RecordsBook) GetSeasonRecords() []*SeasonRecords {
+	if x != nil {
+		return x.SeasonRecords
+	}
+	return nil
+}
+
+// either two teams or two players
+type GetHeadToHeadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId           int32   `protobuf:"varint,1,opt,name=TeamId,proto3" json:"TeamId"`
+	OpponentTeamId   int32   `protobuf:"varint,2,opt,name=OpponentTeamId,proto3" json:"OpponentTeamId"`
+	PlayerId         int32   `protobuf:"varint,3,opt,name=PlayerId,proto3" json:"PlayerId"`
+	OpponentPlayerId int32   `protobuf:"varint,4,opt,name=OpponentPlayerId,proto3" json:"OpponentPlayerId"`
+	CompetitionIds   []int32 `protobuf:"varint,5,rep,packed,name=CompetitionIds,proto3" json:"CompetitionIds"` // optional filter
+	Phase            string  `protobuf:"bytes,6,opt,name=Phase,proto3" json:"Phase"`                           // optional, regular-season or playoffs
+	Offset           int32   `protobuf:"varint,7,opt,name=Offset,proto3" json:"Offset"`                        // into Meetings
+	Count            int32   `protobuf:"varint,8,opt,name=Count,proto3" json:"Count"`                          // of Meetings, defaults to 3
+	Ordering         string  `protobuf:"bytes,9,opt,name=Ordering,proto3" json:"Ordering"`                     // of TopPerformers, any GetPlayerAverageStatsRequest ordering, defaults to PPG
+}
+
+func (x *GetHeadToHeadRequest) Reset() {
+	*x = GetHeadToHeadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHeadToHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeadToHeadRequest) ProtoMessage() {}
+
+func (x *GetHeadToHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeadToHeadRequest.ProtoReflect.Descriptor instead.
+func (*GetHeadToHeadRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetHeadToHeadRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GetHeadToHeadRequest) GetOpponentTeamId() int32 {
+	if x != nil {
+		return x.OpponentTeamId
+	}
+	return 0
+}
+
+func (x *GetHeadToHeadRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GetHeadToHeadRequest) GetOpponentPlayerId() int32 {
+	if x != nil {
+		return x.OpponentPlayerId
+	}
+	return 0
+}
+
+func (x *GetHeadToHeadRequest) GetCompetitionIds() []int32 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *GetHeadToHeadRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *GetHeadToHeadRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetHeadToHeadRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetHeadToHeadRequest) GetOrdering() string {
+	if x != nil {
+		return x.Ordering
+	}
+	return ""
+}
+
+// the record and stats are from the first side's view, over meetings that are final or forfeited
+type HeadToHead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meetings            *GamesCursor            `protobuf:"bytes,1,opt,name=Meetings,proto3" json:"Meetings"` // every meeting whatever its status, page on with GetGames
+	Won                 int32                   `protobuf:"varint,2,opt,name=Won,proto3" json:"Won"`
+	Drawn               int32                   `protobuf:"varint,3,opt,name=Drawn,proto3" json:"Drawn"`
+	Lost                int32                   `protobuf:"varint,4,opt,name=Lost,proto3" json:"Lost"`
+	AverageMargin       float64                 `protobuf:"fixed64,5,opt,name=AverageMargin,proto3" json:"AverageMargin"` // points scored less points conceded, per game
+	TeamStats           *TeamAggregateStats     `protobuf:"bytes,6,opt,name=TeamStats,proto3" json:"TeamStats"`           // for teams, the opponent's are its OpponentStats
+	PlayerStats         *PlayerAggregateStats   `protobuf:"bytes,7,opt,name=PlayerStats,proto3" json:"PlayerStats"`       // for players
+	OpponentPlayerStats *PlayerAggregateStats   `protobuf:"bytes,8,opt,name=OpponentPlayerStats,proto3" json:"OpponentPlayerStats"`
+	TopPerformers       []*PlayerAggregateStats `protobuf:"bytes,9,rep,name=TopPerformers,proto3" json:"TopPerformers"` // from both sides
+}
+
+func (x *HeadToHead) Reset() {
+	*x = HeadToHead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadToHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadToHead) ProtoMessage() {}
+
+func (x *HeadToHead) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadToHead.ProtoReflect.Descriptor instead.
+func (*HeadToHead) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *HeadToHead) GetMeetings() *GamesCursor {
+	if x != nil {
+		return x.Meetings
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetWon() int32 {
+	if x != nil {
+		return x.Won
+	}
+	return 0
+}
+
+func (x *HeadToHead) GetDrawn() int32 {
+	if x != nil {
+		return x.Drawn
+	}
+	return 0
+}
+
+func (x *HeadToHead) GetLost() int32 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *HeadToHead) GetAverageMargin() float64 {
+	if x != nil {
+		return x.AverageMargin
+	}
+	return 0
+}
+
+func (x *HeadToHead) GetTeamStats() *TeamAggregateStats {
+	if x != nil {
+		return x.TeamStats
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetPlayerStats() *PlayerAggregateStats {
+	if x != nil {
+		return x.PlayerStats
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetOpponentPlayerStats() *PlayerAggregateStats {
+	if x != nil {
+		return x.OpponentPlayerStats
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetTopPerformers() []*PlayerAggregateStats {
+	if x != nil {
+		return x.TopPerformers
+	}
+	return nil
+}
+
+type GetPlayerGamesStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset   int32                `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset"`
+	Count    int32                `protobuf:"varint,2,opt,name=Count,proto3" json:"Count"`
+	PlayerId int32                `protobuf:"varint,3,opt,name=PlayerId,proto3" json:"PlayerId"`
+	Against  *AgainstStatsRequest `protobuf:"bytes,5,opt,name=Against,proto3" json:"Against"`
+	Phase    string               `protobuf:"bytes,6,opt,name=Phase,proto3" json:"Phase"` // optional, regular-season or playoffs
+}
+
+func (x *GetPlayerGamesStatsRequest) Reset() {
+	*x = GetPlayerGamesStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerGamesStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerGamesStatsRequest) ProtoMessage() {}
+
+func (x *GetPlayerGamesStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerGamesStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerGamesStatsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetPlayerGamesStatsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetPlayerGamesStatsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetPlayerGamesStatsRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GetPlayerGamesStatsRequest) GetAgainst() *AgainstStatsRequest {
+	if x != nil {
+		return x.Against
+	}
+	return nil
+}
+
+func (x *GetPlayerGamesStatsRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+type GetPlayerGamesStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*Game            `protobuf:"bytes,1,rep,name=Games,proto3" json:"Games"`
+	Stats []*PlayerGameStats `protobuf:"bytes,2,rep,name=Stats,proto3" json:"Stats"`
+}
+
+func (x *GetPlayerGamesStatsResponse) Reset() {
+	*x = GetPlayerGamesStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerGamesStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerGamesStatsResponse) ProtoMessage() {}
+
+func (x *GetPlayerGamesStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerGamesStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerGamesStatsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetPlayerGamesStatsResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GetPlayerGamesStatsResponse) GetStats() []*PlayerGameStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// a single players stat line to be written against a game
+type PlayerGameStatsEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId     int32  `protobuf:"varint,1,opt,name=PlayerId,proto3" json:"PlayerId"`
+	TeamId       int32  `protobuf:"varint,2,opt,name=TeamId,proto3" json:"TeamId"`
+	JerseyNumber int32  `protobuf:"varint,3,opt,name=JerseyNumber,proto3" json:"JerseyNumber"`
+	Stats        *Stats `protobuf:"bytes,4,opt,name=Stats,proto3" json:"Stats"`
+}
+
+func (x *PlayerGameStatsEntry) Reset() {
+	*x = PlayerGameStatsEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerGameStatsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerGameStatsEntry) ProtoMessage() {}
+
+func (x *PlayerGameStatsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerGameStatsEntry.ProtoReflect.Descriptor instead.
+func (*PlayerGameStatsEntry) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PlayerGameStatsEntry) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerGameStatsEntry) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *PlayerGameStatsEntry) GetJerseyNumber() int32 {
+	if x != nil {
+		return x.JerseyNumber
+	}
+	return 0
+}
+
+func (x *PlayerGameStatsEntry) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type CreateGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32                   `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	LocationId    int32                   `protobuf:"varint,2,opt,name=LocationId,proto3" json:"LocationId"`
+	HomeTeamId    int32                   `protobuf:"varint,3,opt,name=HomeTeamId,proto3" json:"HomeTeamId"`
+	AwayTeamId    int32                   `protobuf:"varint,4,opt,name=AwayTeamId,proto3" json:"AwayTeamId"`
+	GameTime      string                  `protobuf:"bytes,5,opt,name=GameTime,proto3" json:"GameTime"`            // RFC3339 or "2006-01-02 15:04"
+	Stats         []*PlayerGameStatsEntry `protobuf:"bytes,6,rep,name=Stats,proto3" json:"Stats"`                  // optional
+	Status        string                  `protobuf:"bytes,7,opt,name=Status,proto3" json:"Status"`                // defaults to final with stats, scheduled without
+	ForfeitTeamId int32                   `protobuf:"varint,8,opt,name=ForfeitTeamId,proto3" json:"ForfeitTeamId"` // required for a forfeit
+	SeriesId      int32                   `protobuf:"varint,9,opt,name=SeriesId,proto3" json:"SeriesId"`           // optional, makes it a playoff game between the series' teams
+}
+
+func (x *CreateGameRequest) Reset() {
+	*x = CreateGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameRequest) ProtoMessage() {}
+
+func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateGameRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetLocationId() int32 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetAwayTeamId() int32 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetGameTime() string {
+	if x != nil {
+		return x.GameTime
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetStats() []*PlayerGameStatsEntry {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetForfeitTeamId() int32 {
+	if x != nil {
+		return x.ForfeitTeamId
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+type CreateGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+}
+
+func (x *CreateGameResponse) Reset() {
+	*x = CreateGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameResponse) ProtoMessage() {}
+
+func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateGameResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+// all game fields are replaced, stats lines supplied are upserted
+type UpdateGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId        int32                   `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+	CompetitionId int32                   `protobuf:"varint,2,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	LocationId    int32                   `protobuf:"varint,3,opt,name=LocationId,proto3" json:"LocationId"`
+	HomeTeamId    int32                   `protobuf:"varint,4,opt,name=HomeTeamId,proto3" json:"HomeTeamId"`
+	AwayTeamId    int32                   `protobuf:"varint,5,opt,name=AwayTeamId,proto3" json:"AwayTeamId"`
+	GameTime      string                  `protobuf:"bytes,6,opt,name=GameTime,proto3" json:"GameTime"`
+	Stats         []*PlayerGameStatsEntry `protobuf:"bytes,7,rep,name=Stats,proto3" json:"Stats"`                  // optional
+	Status        string                  `protobuf:"bytes,8,opt,name=Status,proto3" json:"Status"`                // empty keeps the current status and forfeit team
+	ForfeitTeamId int32                   `protobuf:"varint,9,opt,name=ForfeitTeamId,proto3" json:"ForfeitTeamId"` // required for a forfeit
+	SeriesId      int32                   `protobuf:"varint,10,opt,name=SeriesId,proto3" json:"SeriesId"`          // 0 for a regular season game
+}
+
+func (x *UpdateGameRequest) Reset() {
+	*x = UpdateGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameRequest) ProtoMessage() {}
+
+func (x *UpdateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateGameRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetLocationId() int32 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetAwayTeamId() int32 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetGameTime() string {
+	if x != nil {
+		return x.GameTime
+	}
+	return ""
+}
+
+func (x *UpdateGameRequest) GetStats() []*PlayerGameStatsEntry {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *UpdateGameRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateGameRequest) GetForfeitTeamId() int32 {
+	if x != nil {
+		return x.ForfeitTeamId
+	}
+	return 0
+}
+
+func (x *UpdateGameRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+type UpdateGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+}
+
+func (x *UpdateGameResponse) Reset() {
+	*x = UpdateGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameResponse) ProtoMessage() {}
+
+func (x *UpdateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateGameResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type DeleteGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+}
+
+func (x *DeleteGameRequest) Reset() {
+	*x = DeleteGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameRequest) ProtoMessage() {}
+
+func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteGameRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type DeleteGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+}
+
+func (x *DeleteGameResponse) Reset() {
+	*x = DeleteGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameResponse) ProtoMessage() {}
+
+func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteGameResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type UpsertPlayerGameStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32                   `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+	Stats  []*PlayerGameStatsEntry `protobuf:"bytes,2,rep,name=Stats,proto3" json:"Stats"`
+}
+
+func (x *UpsertPlayerGameStatsRequest) Reset() {
+	*x = UpsertPlayerGameStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertPlayerGameStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPlayerGameStatsRequest) ProtoMessage() {}
+
+func (x *UpsertPlayerGameStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPlayerGameStatsRequest.ProtoReflect.Descriptor instead.
+func (*UpsertPlayerGameStatsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpsertPlayerGameStatsRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *UpsertPlayerGameStatsRequest) GetStats() []*PlayerGameStatsEntry {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type UpsertPlayerGameStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatsIds []int32 `protobuf:"varint,1,rep,packed,name=StatsIds,proto3" json:"StatsIds"` // in the order of the request
+}
+
+func (x *UpsertPlayerGameStatsResponse) Reset() {
+	*x = UpsertPlayerGameStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertPlayerGameStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPlayerGameStatsResponse) ProtoMessage() {}
+
+func (x *UpsertPlayerGameStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPlayerGameStatsResponse.ProtoReflect.Descriptor instead.
+func (*UpsertPlayerGameStatsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpsertPlayerGameStatsResponse) GetStatsIds() []int32 {
+	if x != nil {
+		return x.StatsIds
+	}
+	return nil
+}
+
+type RosterEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId     int32 `protobuf:"varint,1,opt,name=PlayerId,proto3" json:"PlayerId"`
+	JerseyNumber int32 `protobuf:"varint,2,opt,name=JerseyNumber,proto3" json:"JerseyNumber"`
+}
+
+func (x *RosterEntry) Reset() {
+	*x = RosterEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RosterEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterEntry) ProtoMessage() {}
+
+func (x *RosterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterEntry.ProtoReflect.Descriptor instead.
+func (*RosterEntry) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RosterEntry) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *RosterEntry) GetJerseyNumber() int32 {
+	if x != nil {
+		return x.JerseyNumber
+	}
+	return 0
+}
+
+// enters the team in the competition if needed and replaces its roster
+type UpdateCompetitionRosterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32          `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	TeamId        int32          `protobuf:"varint,2,opt,name=TeamId,proto3" json:"TeamId"`
+	Players       []*RosterEntry `protobuf:"bytes,3,rep,name=Players,proto3" json:"Players"`
+}
+
+func (x *UpdateCompetitionRosterRequest) Reset() {
+	*x = UpdateCompetitionRosterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCompetitionRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompetitionRosterRequest) ProtoMessage() {}
+
+func (x *UpdateCompetitionRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompetitionRosterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompetitionRosterRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateCompetitionRosterRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *UpdateCompetitionRosterRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *UpdateCompetitionRosterRequest) GetPlayers() []*RosterEntry {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type UpdateCompetitionRosterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32 `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	TeamId        int32 `protobuf:"varint,2,opt,name=TeamId,proto3" json:"TeamId"`
+}
+
+func (x *UpdateCompetitionRosterResponse) Reset() {
+	*x = UpdateCompetitionRosterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCompetitionRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompetitionRosterResponse) ProtoMessage() {}
+
+func (x *UpdateCompetitionRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompetitionRosterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCompetitionRosterResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateCompetitionRosterResponse) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *UpdateCompetitionRosterResponse) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+// replaces the game's period scores, for games without play-by-play events
+type UpdateGamePeriodScoresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId       int32          `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+	PeriodScores []*PeriodScore `protobuf:"bytes,2,rep,name=PeriodScores,proto3" json:"PeriodScores"` // periods 1 onwards, Overtime is ignored
+}
+
+func (x *UpdateGamePeriodScoresRequest) Reset() {
+	*x = UpdateGamePeriodScoresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGamePeriodScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGamePeriodScoresRequest) ProtoMessage() {}
+
+func (x *UpdateGamePeriodScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGamePeriodScoresRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGamePeriodScoresRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateGamePeriodScoresRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *UpdateGamePeriodScoresRequest) GetPeriodScores() []*PeriodScore {
+	if x != nil {
+		return x.PeriodScores
+	}
+	return nil
+}
+
+type UpdateGamePeriodScoresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+}
+
+func (x *UpdateGamePeriodScoresResponse) Reset() {
+	*x = UpdateGamePeriodScoresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGamePeriodScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGamePeriodScoresResponse) ProtoMessage() {}
+
+func (x *UpdateGamePeriodScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGamePeriodScoresResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGamePeriodScoresResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateGamePeriodScoresResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type UpdateCompetitionPeriodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32 `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	PeriodCount   int32 `protobuf:"varint,2,opt,name=PeriodCount,proto3" json:"PeriodCount"` // 4 or 2
+}
+
+func (x *UpdateCompetitionPeriodsRequest) Reset() {
+	*x = UpdateCompetitionPeriodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCompetitionPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompetitionPeriodsRequest) ProtoMessage() {}
+
+func (x *UpdateCompetitionPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompetitionPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompetitionPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateCompetitionPeriodsRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *UpdateCompetitionPeriodsRequest) GetPeriodCount() int32 {
+	if x != nil {
+		return x.PeriodCount
+	}
+	return 0
+}
+
+type UpdateCompetitionPeriodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32 `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+}
+
+func (x *UpdateCompetitionPeriodsResponse) Reset() {
+	*x = UpdateCompetitionPeriodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCompetitionPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompetitionPeriodsResponse) ProtoMessage() {}
+
+func (x *UpdateCompetitionPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompetitionPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCompetitionPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateCompetitionPeriodsResponse) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+type UpdateStandingsRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32           `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	Rules         *StandingsRules `protobuf:"bytes,2,opt,name=Rules,proto3" json:"Rules"`
+}
+
+func (x *UpdateStandingsRulesRequest) Reset() {
+	*x = UpdateStandingsRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStandingsRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStandingsRulesRequest) ProtoMessage() {}
+
+func (x *UpdateStandingsRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStandingsRulesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStandingsRulesRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateStandingsRulesRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *UpdateStandingsRulesRequest) GetRules() *StandingsRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type UpdateStandingsRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32 `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+}
+
+func (x *UpdateStandingsRulesResponse) Reset() {
+	*x = UpdateStandingsRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStandingsRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStandingsRulesResponse) ProtoMessage() {}
+
+func (x *UpdateStandingsRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStandingsRulesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStandingsRulesResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateStandingsRulesResponse) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+// a weekly time games can be played at, each location hosts one game per slot
+type ScheduleSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weekday int32  `protobuf:"varint,1,opt,name=Weekday,proto3" json:"Weekday"` // 0 for Sunday to 6 for Saturday
+	Time    string `protobuf:"bytes,2,opt,name=Time,proto3" json:"Time"`        // "15:04", UTC
+}
+
+func (x *ScheduleSlot) Reset() {
+	*x = ScheduleSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSlot) ProtoMessage() {}
+
+func (x *ScheduleSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSlot.ProtoReflect.Descriptor instead.
+func (*ScheduleSlot) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ScheduleSlot) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *ScheduleSlot) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type GenerateScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId    int32           `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	TeamIds          []int32         `protobuf:"varint,2,rep,packed,name=TeamIds,proto3" json:"TeamIds"` // optional, defaults to the teams entered in the competition
+	LocationIds      []int32         `protobuf:"varint,3,rep,packed,name=LocationIds,proto3" json:"LocationIds"`
+	StartDate        string          `protobuf:"bytes,4,opt,name=StartDate,proto3" json:"StartDate"` // "2006-01-02", the first day games can be played
+	Slots            []*ScheduleSlot `protobuf:"bytes,5,rep,name=Slots,proto3" json:"Slots"`
+	BlackoutDates    []string        `protobuf:"bytes,6,rep,name=BlackoutDates,proto3" json:"BlackoutDates"`        // "2006-01-02", days no games are played
+	DoubleRoundRobin bool            `protobuf:"varint,7,opt,name=DoubleRoundRobin,proto3" json:"DoubleRoundRobin"` // every pairing twice, home and away swapped
+	Preview          bool            `protobuf:"varint,8,opt,name=Preview,proto3" json:"Preview"`                   // return the fixtures without creating any games
+}
+
+func (x *GenerateScheduleRequest) Reset() {
+	*x = GenerateScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateScheduleRequest) ProtoMessage() {}
+
+func (x *GenerateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GenerateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GenerateScheduleRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *GenerateScheduleRequest) GetTeamIds() []int32 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *GenerateScheduleRequest) GetLocationIds() []int32 {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+func (x *GenerateScheduleRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GenerateScheduleRequest) GetSlots() []*ScheduleSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *GenerateScheduleRequest) GetBlackoutDates() []string {
+	if x != nil {
+		return x.BlackoutDates
+	}
+	return nil
+}
+
+func (x *GenerateScheduleRequest) GetDoubleRoundRobin() bool {
+	if x != nil {
+		return x.DoubleRoundRobin
+	}
+	return false
+}
+
+func (x *GenerateScheduleRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+type ScheduledGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round      int32  `protobuf:"varint,1,opt,name=Round,proto3" json:"Round"` // from 1
+	HomeTeamId int32  `protobuf:"varint,2,opt,name=HomeTeamId,proto3" json:"HomeTeamId"`
+	AwayTeamId int32  `protobuf:"varint,3,opt,name=AwayTeamId,proto3" json:"AwayTeamId"`
+	LocationId int32  `protobuf:"varint,4,opt,name=LocationId,proto3" json:"LocationId"`
+	GameTime   string `protobuf:"bytes,5,opt,name=GameTime,proto3" json:"GameTime"` // RFC3339
+	GameId     int32  `protobuf:"varint,6,opt,name=GameId,proto3" json:"GameId"`    // 0 in a preview
+}
+
+func (x *ScheduledGame) Reset() {
+	*x = ScheduledGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledGame) ProtoMessage() {}
+
+func (x *ScheduledGame) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledGame.ProtoReflect.Descriptor instead.
+func (*ScheduledGame) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ScheduledGame) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ScheduledGame) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *ScheduledGame) GetAwayTeamId() int32 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *ScheduledGame) GetLocationId() int32 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *ScheduledGame) GetGameTime() string {
+	if x != nil {
+		return x.GameTime
+	}
+	return ""
+}
+
+func (x *ScheduledGame) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type ScheduleBye struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round  int32 `protobuf:"varint,1,opt,name=Round,proto3" json:"Round"`
+	TeamId int32 `protobuf:"varint,2,opt,name=TeamId,proto3" json:"TeamId"`
+}
+
+func (x *ScheduleBye) Reset() {
+	*x = ScheduleBye{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleBye) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleBye) ProtoMessage() {}
+
+func (x *ScheduleBye) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleBye.ProtoReflect.Descriptor instead.
+func (*ScheduleBye) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ScheduleBye) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ScheduleBye) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type GenerateScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*ScheduledGame `protobuf:"bytes,1,rep,name=Games,proto3" json:"Games"` // in the order they are played
+	Byes  []*ScheduleBye   `protobuf:"bytes,2,rep,name=Byes,proto3" json:"Byes"`   // only with an odd number of teams
+}
+
+func (x *GenerateScheduleResponse) Reset() {
+	*x = GenerateScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateScheduleResponse) ProtoMessage() {}
+
+func (x *GenerateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GenerateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GenerateScheduleResponse) GetGames() []*ScheduledGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GenerateScheduleResponse) GetByes() []*ScheduleBye {
+	if x != nil {
+		return x.Byes
+	}
+	return nil
+}
+
+// seeds the finals from the competition's standings
+type CreateBracketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32   `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	TeamCount     int32   `protobuf:"varint,2,opt,name=TeamCount,proto3" json:"TeamCount"`  // 2, 4, 8 or 16
+	BestOf        []int32 `protobuf:"varint,3,rep,packed,name=BestOf,proto3" json:"BestOf"` // games per series for each round from the first, odd, defaults to 1 (knockout)
+}
+
+func (x *CreateBracketRequest) Reset() {
+	*x = CreateBracketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBracketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBracketRequest) ProtoMessage() {}
+
+func (x *CreateBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBracketRequest.ProtoReflect.Descriptor instead.
+func (*CreateBracketRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateBracketRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *CreateBracketRequest) GetTeamCount() int32 {
+	if x != nil {
+		return x.TeamCount
+	}
+	return 0
+}
+
+func (x *CreateBracketRequest) GetBestOf() []int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return nil
+}
+
+type GetBracketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId int32 `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+}
+
+func (x *GetBracketRequest) Reset() {
+	*x = GetBracketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBracketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBracketRequest) ProtoMessage() {}
+
+func (x *GetBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBracketRequest.ProtoReflect.Descriptor instead.
+func (*GetBracketRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetBracketRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+type BracketSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeriesId         int32   `protobuf:"varint,1,opt,name=SeriesId,proto3" json:"SeriesId"`
+	Round            int32   `protobuf:"varint,2,opt,name=Round,proto3" json:"Round"`
+	Position         int32   `protobuf:"varint,3,opt,name=Position,proto3" json:"Position"` // from 0, the winners of 2n and 2n+1 meet in the next round
+	BestOf           int32   `protobuf:"varint,4,opt,name=BestOf,proto3" json:"BestOf"`
+	HigherSeed       *Team   `protobuf:"bytes,5,opt,name=HigherSeed,proto3" json:"HigherSeed"` // unset until the series before it is decided
+	HigherSeedNumber int32   `protobuf:"varint,6,opt,name=HigherSeedNumber,proto3" json:"HigherSeedNumber"`
+	HigherSeedWins   int32   `protobuf:"varint,7,opt,name=HigherSeedWins,proto3" json:"HigherSeedWins"`
+	LowerSeed        *Team   `protobuf:"bytes,8,opt,name=LowerSeed,proto3" json:"LowerSeed"`
+	LowerSeedNumber  int32   `protobuf:"varint,9,opt,name=LowerSeedNumber,proto3" json:"LowerSeedNumber"`
+	LowerSeedWins    int32   `protobuf:"varint,10,opt,name=LowerSeedWins,proto3" json:"LowerSeedWins"`
+	WinnerTeamId     int32   `protobuf:"varint,11,opt,name=WinnerTeamId,proto3" json:"WinnerTeamId"` // 0 until one team has won a majority of BestOf
+	GameIds          []int32 `protobuf:"varint,12,rep,packed,name=GameIds,proto3" json:"GameIds"`
+}
+
+func (x *BracketSeries) Reset() {
+	*x = BracketSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BracketSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketSeries) ProtoMessage() {}
+
+func (x *BracketSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketSeries.ProtoReflect.Descriptor instead.
+func (*BracketSeries) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BracketSeries) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetBestOf() int32 {
+	if x != nil {
+		return x.BestOf
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetHigherSeed() *Team {
+	if x != nil {
+		return x.HigherSeed
+	}
+	return nil
+}
+
+func (x *BracketSeries) GetHigherSeedNumber() int32 {
+	if x != nil {
+		return x.HigherSeedNumber
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetHigherSeedWins() int32 {
+	if x != nil {
+		return x.HigherSeedWins
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetLowerSeed() *Team {
+	if x != nil {
+		return x.LowerSeed
+	}
+	return nil
+}
+
+func (x *BracketSeries) GetLowerSeedNumber() int32 {
+	if x != nil {
+		return x.LowerSeedNumber
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetLowerSeedWins() int32 {
+	if x != nil {
+		return x.LowerSeedWins
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetWinnerTeamId() int32 {
+	if x != nil {
+		return x.WinnerTeamId
+	}
+	return 0
+}
+
+func (x *BracketSeries) GetGameIds() []int32 {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+type BracketRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round  int32            `protobuf:"varint,1,opt,name=Round,proto3" json:"Round"`
+	Series []*BracketSeries `protobuf:"bytes,2,rep,name=Series,proto3" json:"Series"`
+}
+
+func (x *BracketRound) Reset() {
+	*x = BracketRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BracketRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketRound) ProtoMessage() {}
+
+func (x *BracketRound) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketRound.ProtoReflect.Descriptor instead.
+func (*BracketRound) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *BracketRound) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *BracketRound) GetSeries() []*BracketSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type Bracket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId  int32           `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	Rounds         []*BracketRound `protobuf:"bytes,2,rep,name=Rounds,proto3" json:"Rounds"`
+	ChampionTeamId int32           `protobuf:"varint,3,opt,name=ChampionTeamId,proto3" json:"ChampionTeamId"` // 0 until the last series is decided
+}
+
+func (x *Bracket) Reset() {
+	*x = Bracket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bracket) ProtoMessage() {}
+
+func (x *Bracket) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bracket.ProtoReflect.Descriptor instead.
+func (*Bracket) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Bracket) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *Bracket) GetRounds() []*BracketRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *Bracket) GetChampionTeamId() int32 {
+	if x != nil {
+		return x.ChampionTeamId
+	}
+	return 0
+}
+
+type StreamGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+}
+
+func (x *StreamGameRequest) Reset() {
+	*x = StreamGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGameRequest) ProtoMessage() {}
+
+func (x *StreamGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGameRequest.ProtoReflect.Descriptor instead.
+func (*StreamGameRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StreamGameRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+// the first update carries every stat line, later ones only what changed
+type GameUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game            *Game              `protobuf:"bytes,1,opt,name=Game,proto3" json:"Game"`               // with the current score
+	PlayerStats     []*PlayerGameStats `protobuf:"bytes,2,rep,name=PlayerStats,proto3" json:"PlayerStats"` // added or changed
+	RemovedStatsIds []int32            `protobuf:"varint,3,rep,packed,name=RemovedStatsIds,proto3" json:"RemovedStatsIds"`
+	Deleted         bool               `protobuf:"varint,4,opt,name=Deleted,proto3" json:"Deleted"` // the game was deleted, no more updates follow
+}
+
+func (x *GameUpdate) Reset() {
+	*x = GameUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameUpdate) ProtoMessage() {}
+
+func (x *GameUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameUpdate.ProtoReflect.Descriptor instead.
+func (*GameUpdate) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GameUpdate) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetPlayerStats() []*PlayerGameStats {
+	if x != nil {
+		return x.PlayerStats
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetRemovedStatsIds() []int32 {
+	if x != nil {
+		return x.RemovedStatsIds
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// a play-by-play event, players with events have their stat line derived from them
+type GameEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId      int32  `protobuf:"varint,1,opt,name=EventId,proto3" json:"EventId"`
+	GameId       int32  `protobuf:"varint,2,opt,name=GameId,proto3" json:"GameId"`
+	PlayerId     int32  `protobuf:"varint,3,opt,name=PlayerId,proto3" json:"PlayerId"`
+	TeamId       int32  `protobuf:"varint,4,opt,name=TeamId,proto3" json:"TeamId"`
+	EventType    string `protobuf:"bytes,5,opt,name=EventType,proto3" json:"EventType"`        // e.g. "two-point-made", see the README for the full list
+	Period       int32  `protobuf:"varint,6,opt,name=Period,proto3" json:"Period"`             // from 1, periods after regulation are overtime
+	ClockSeconds int32  `protobuf:"varint,7,opt,name=ClockSeconds,proto3" json:"ClockSeconds"` // left on the game clock in the period
+	RecordedAt   string `protobuf:"bytes,8,opt,name=RecordedAt,proto3" json:"RecordedAt"`      // RFC3339, set by the server
+	// where a field goal attempt was taken from, in feet. X runs from -25 to 25 across
+	//the court and Y from 0 at the baseline, the basket is at (0, 5.25). (0, 0) is no location
+	ShotX    float64 `protobuf:"fixed64,9,opt,name=ShotX,proto3" json:"ShotX"`
+	ShotY    float64 `protobuf:"fixed64,10,opt,name=ShotY,proto3" json:"ShotY"`
+	ShotZone string  `protobuf:"bytes,11,opt,name=ShotZone,proto3" json:"ShotZone"` // see the README, worked out from ShotX and ShotY when empty
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GameEvent) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *GameEvent) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GameEvent) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GameEvent) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GameEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *GameEvent) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *GameEvent) GetClockSeconds() int32 {
+	if x != nil {
+		return x.ClockSeconds
+	}
+	return 0
+}
+
+func (x *GameEvent) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+func (x *GameEvent) GetShotX() float64 {
+	if x != nil {
+		return x.ShotX
+	}
+	return 0
+}
+
+func (x *GameEvent) GetShotY() float64 {
+	if x != nil {
+		return x.ShotY
+	}
+	return 0
+}
+
+func (x *GameEvent) GetShotZone() string {
+	if x != nil {
+		return x.ShotZone
+	}
+	return ""
+}
+
+type RecordEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *GameEvent `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event"` // EventId and RecordedAt are ignored
+}
+
+func (x *RecordEventRequest) Reset() {
+	*x = RecordEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEventRequest) ProtoMessage() {}
+
+func (x *RecordEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordEventRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RecordEventRequest) GetEvent() *GameEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type RecordEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId int32            `protobuf:"varint,1,opt,name=EventId,proto3" json:"EventId"`
+	Stats   *PlayerGameStats `protobuf:"bytes,2,opt,name=Stats,proto3" json:"Stats"` // the player's line after the event
+}
+
+func (x *RecordEventResponse) Reset() {
+	*x = RecordEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEventResponse) ProtoMessage() {}
+
+func (x *RecordEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEventResponse.ProtoReflect.Descriptor instead.
+func (*RecordEventResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RecordEventResponse) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *RecordEventResponse) GetStats() *PlayerGameStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type UndoEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId int32 `protobuf:"varint,1,opt,name=EventId,proto3" json:"EventId"`
+}
+
+func (x *UndoEventRequest) Reset() {
+	*x = UndoEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoEventRequest) ProtoMessage() {}
+
+func (x *UndoEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoEventRequest.ProtoReflect.Descriptor instead.
+func (*UndoEventRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UndoEventRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type UndoEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId int32            `protobuf:"varint,1,opt,name=EventId,proto3" json:"EventId"`
+	Stats   *PlayerGameStats `protobuf:"bytes,2,opt,name=Stats,proto3" json:"Stats"` // unset when the player has no events left in the game
+}
+
+func (x *UndoEventResponse) Reset() {
+	*x = UndoEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoEventResponse) ProtoMessage() {}
+
+func (x *UndoEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoEventResponse.ProtoReflect.Descriptor instead.
+func (*UndoEventResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UndoEventResponse) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *UndoEventResponse) GetStats() *PlayerGameStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// oldest first, by period and then game clock
+type ListEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+	Offset int32 `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset"`
+	Count  int32 `protobuf:"varint,3,opt,name=Count,proto3" json:"Count"` // 0 for all
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListEventsRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events     []*GameEvent `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events"`
+	NextOffset int32        `protobuf:"varint,2,opt,name=NextOffset,proto3" json:"NextOffset"`
+	Total      int32        `protobuf:"varint,3,opt,name=Total,proto3" json:"Total"`
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListEventsResponse) GetEvents() []*GameEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+func (x *ListEventsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// a player coming on or going off, starters come on at the start of the game without a PlayerOutId
+type Substitution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubstitutionId int32  `protobuf:"varint,1,opt,name=SubstitutionId,proto3" json:"SubstitutionId"`
+	GameId         int32  `protobuf:"varint,2,opt,name=GameId,proto3" json:"GameId"`
+	TeamId         int32  `protobuf:"varint,3,opt,name=TeamId,proto3" json:"TeamId"`
+	PlayerInId     int32  `protobuf:"varint,4,opt,name=PlayerInId,proto3" json:"PlayerInId"`   // 0 when a player only goes off
+	PlayerOutId    int32  `protobuf:"varint,5,opt,name=PlayerOutId,proto3" json:"PlayerOutId"` // 0 when a player only comes on
+	Period         int32  `protobuf:"varint,6,opt,name=Period,proto3" json:"Period"`
+	ClockSeconds   int32  `protobuf:"varint,7,opt,name=ClockSeconds,proto3" json:"ClockSeconds"` // left on the game clock in the period
+	RecordedAt     string `protobuf:"bytes,8,opt,name=RecordedAt,proto3" json:"RecordedAt"`      // RFC3339, set by the server
+}
+
+func (x *Substitution) Reset() {
+	*x = Substitution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Substitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Substitution) ProtoMessage() {}
+
+func (x *Substitution) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Substitution.ProtoReflect.Descriptor instead.
+func (*Substitution) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Substitution) GetSubstitutionId() int32 {
+	if x != nil {
+		return x.SubstitutionId
+	}
+	return 0
+}
+
+func (x *Substitution) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *Substitution) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Substitution) GetPlayerInId() int32 {
+	if x != nil {
+		return x.PlayerInId
+	}
+	return 0
+}
+
+func (x *Substitution) GetPlayerOutId() int32 {
+	if x != nil {
+		return x.PlayerOutId
+	}
+	return 0
+}
+
+func (x *Substitution) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *Substitution) GetClockSeconds() int32 {
+	if x != nil {
+		return x.ClockSeconds
+	}
+	return 0
+}
+
+func (x *Substitution) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+type RecordSubstitutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Substitution *Substitution `protobuf:"bytes,1,opt,name=Substitution,proto3" json:"Substitution"` // SubstitutionId and RecordedAt are ignored
+}
+
+func (x *RecordSubstitutionRequest) Reset() {
+	*x = RecordSubstitutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordSubstitutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSubstitutionRequest) ProtoMessage() {}
+
+func (x *RecordSubstitutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSubstitutionRequest.ProtoReflect.Descriptor instead.
+func (*RecordSubstitutionRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RecordSubstitutionRequest) GetSubstitution() *Substitution {
+	if x != nil {
+		return x.Substitution
+	}
+	return nil
+}
+
+type RecordSubstitutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubstitutionId int32   `protobuf:"varint,1,opt,name=SubstitutionId,proto3" json:"SubstitutionId"`
+	OnCourt        []int32 `protobuf:"varint,2,rep,packed,name=OnCourt,proto3" json:"OnCourt"` // the team's players on the court after all its substitutions
+}
+
+func (x *RecordSubstitutionResponse) Reset() {
+	*x = RecordSubstitutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordSubstitutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSubstitutionResponse) ProtoMessage() {}
+
+func (x *RecordSubstitutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSubstitutionResponse.ProtoReflect.Descriptor instead.
+func (*RecordSubstitutionResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RecordSubstitutionResponse) GetSubstitutionId() int32 {
+	if x != nil {
+		return x.SubstitutionId
+	}
+	return 0
+}
+
+func (x *RecordSubstitutionResponse) GetOnCourt() []int32 {
+	if x != nil {
+		return x.OnCourt
+	}
+	return nil
+}
+
+type UndoSubstitutionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubstitutionId int32 `protobuf:"varint,1,opt,name=SubstitutionId,proto3" json:"SubstitutionId"`
+}
+
+func (x *UndoSubstitutionRequest) Reset() {
+	*x = UndoSubstitutionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoSubstitutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoSubstitutionRequest) ProtoMessage() {}
+
+func (x *UndoSubstitutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoSubstitutionRequest.ProtoReflect.Descriptor instead.
+func (*UndoSubstitutionRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UndoSubstitutionRequest) GetSubstitutionId() int32 {
+	if x != nil {
+		return x.SubstitutionId
+	}
+	return 0
+}
+
+type UndoSubstitutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubstitutionId int32 `protobuf:"varint,1,opt,name=SubstitutionId,proto3" json:"SubstitutionId"`
+}
+
+func (x *UndoSubstitutionResponse) Reset() {
+	*x = UndoSubstitutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoSubstitutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoSubstitutionResponse) ProtoMessage() {}
+
+func (x *UndoSubstitutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoSubstitutionResponse.ProtoReflect.Descriptor instead.
+func (*UndoSubstitutionResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UndoSubstitutionResponse) GetSubstitutionId() int32 {
+	if x != nil {
+		return x.SubstitutionId
+	}
+	return 0
+}
+
+type GetLineupStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId             int32   `protobuf:"varint,1,opt,name=TeamId,proto3" json:"TeamId"`
+	CompetitionId      int32   `protobuf:"varint,2,opt,name=CompetitionId,proto3" json:"CompetitionId"`            // optional filter
+	GameId             int32   `protobuf:"varint,3,opt,name=GameId,proto3" json:"GameId"`                          // optional, a single game whatever its status
+	MinimumPossessions float64 `protobuf:"fixed64,4,opt,name=MinimumPossessions,proto3" json:"MinimumPossessions"` // for a lineup to be ranked
+	Count              int32   `protobuf:"varint,5,opt,name=Count,proto3" json:"Count"`                            // lineups returned, defaults to 10
+}
+
+func (x *GetLineupStatsRequest) Reset() {
+	*x = GetLineupStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLineupStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLineupStatsRequest) ProtoMessage() {}
+
+func (x *GetLineupStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLineupStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLineupStatsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetLineupStatsRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GetLineupStatsRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *GetLineupStatsRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GetLineupStatsRequest) GetMinimumPossessions() float64 {
+	if x != nil {
+		return x.MinimumPossessions
+	}
+	return 0
+}
+
+func (x *GetLineupStatsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// a five player lineup's scoring while it was on the court together.
+// Possessions are estimated from the play-by-play as field goals attempted
+// plus 0.44 of free throws attempted, less offensive rebounds, plus turnovers
+type LineupStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players             []*Player `protobuf:"bytes,1,rep,name=Players,proto3" json:"Players"`
+	GameCount           int32     `protobuf:"varint,2,opt,name=GameCount,proto3" json:"GameCount"`
+	PointsFor           int32     `protobuf:"varint,3,opt,name=PointsFor,proto3" json:"PointsFor"`
+	PointsAgainst       int32     `protobuf:"varint,4,opt,name=PointsAgainst,proto3" json:"PointsAgainst"`
+	Possessions         float64   `protobuf:"fixed64,5,opt,name=Possessions,proto3" json:"Possessions"`
+	OpponentPossessions float64   `protobuf:"fixed64,6,opt,name=OpponentPossessions,proto3" json:"OpponentPossessions"`
+	OffensiveRating     float64   `protobuf:"fixed64,7,opt,name=OffensiveRating,proto3" json:"OffensiveRating"` // points per 100 possessions
+	DefensiveRating     float64   `protobuf:"fixed64,8,opt,name=DefensiveRating,proto3" json:"DefensiveRating"` // points allowed per 100 opponent possessions
+	NetRating           float64   `protobuf:"fixed64,9,opt,name=NetRating,proto3" json:"NetRating"`
+}
+
+func (x *LineupStats) Reset() {
+	*x = LineupStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineupStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineupStats) ProtoMessage() {}
+
+func (x *LineupStats) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineupStats.ProtoReflect.Descriptor instead.
+func (*LineupStats) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *LineupStats) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *LineupStats) GetGameCount() int32 {
+	if x != nil {
+		return x.GameCount
+	}
+	return 0
+}
+
+func (x *LineupStats) GetPointsFor() int32 {
+	if x != nil {
+		return x.PointsFor
+	}
+	return 0
+}
+
+func (x *LineupStats) GetPointsAgainst() int32 {
+	if x != nil {
+		return x.PointsAgainst
+	}
+	return 0
+}
+
+func (x *LineupStats) GetPossessions() float64 {
+	if x != nil {
+		return x.Possessions
+	}
+	return 0
+}
+
+func (x *LineupStats) GetOpponentPossessions() float64 {
+	if x != nil {
+		return x.OpponentPossessions
+	}
+	return 0
+}
+
+func (x *LineupStats) GetOffensiveRating() float64 {
+	if x != nil {
+		return x.OffensiveRating
+	}
+	return 0
+}
+
+func (x *LineupStats) GetDefensiveRating() float64 {
+	if x != nil {
+		return x.DefensiveRating
+	}
+	return 0
+}
+
+func (x *LineupStats) GetNetRating() float64 {
+	if x != nil {
+		return x.NetRating
+	}
+	return 0
+}
+
+type PlayerPlusMinus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player    *Player `protobuf:"bytes,1,opt,name=Player,proto3" json:"Player"`
+	PlusMinus int32   `protobuf:"varint,2,opt,name=PlusMinus,proto3" json:"PlusMinus"` // points scored less points allowed while on the court
+	GameCount int32   `protobuf:"varint,3,opt,name=GameCount,proto3" json:"GameCount"`
+}
+
+func (x *PlayerPlusMinus) Reset() {
+	*x = PlayerPlusMinus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerPlusMinus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPlusMinus) ProtoMessage() {}
+
+func (x *PlayerPlusMinus) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPlusMinus.ProtoReflect.Descriptor instead.
+func (*PlayerPlusMinus) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PlayerPlusMinus) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerPlusMinus) GetPlusMinus() int32 {
+	if x != nil {
+		return x.PlusMinus
+	}
+	return 0
+}
+
+func (x *PlayerPlusMinus) GetGameCount() int32 {
+	if x != nil {
+		return x.GameCount
+	}
+	return 0
+}
+
+// from games with substitutions and play-by-play events
+type GetLineupStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lineups   []*LineupStats     `protobuf:"bytes,1,rep,name=Lineups,proto3" json:"Lineups"`     // best NetRating first
+	PlusMinus []*PlayerPlusMinus `protobuf:"bytes,2,rep,name=PlusMinus,proto3" json:"PlusMinus"` // best first
+}
+
+func (x *GetLineupStatsResponse) Reset() {
+	*x = GetLineupStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLineupStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLineupStatsResponse) ProtoMessage() {}
+
+func (x *GetLineupStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLineupStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLineupStatsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetLineupStatsResponse) GetLineups() []*LineupStats {
+	if x != nil {
+		return x.Lineups
+	}
+	return nil
+}
+
+func (x *GetLineupStatsResponse) GetPlusMinus() []*PlayerPlusMinus {
+	if x != nil {
+		return x.PlusMinus
+	}
+	return nil
+}
+
+// exactly one of PlayerId or TeamId
+type GetShotChartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId        int32   `protobuf:"varint,1,opt,name=PlayerId,proto3" json:"PlayerId"`
+	TeamId          int32   `protobuf:"varint,2,opt,name=TeamId,proto3" json:"TeamId"`
+	CompetitionIds  []int32 `protobuf:"varint,3,rep,packed,name=CompetitionIds,proto3" json:"CompetitionIds"`   // optional filter
+	OpponentTeamIds []int32 `protobuf:"varint,4,rep,packed,name=OpponentTeamIds,proto3" json:"OpponentTeamIds"` // optional filter
+	Phase           string  `protobuf:"bytes,5,opt,name=Phase,proto3" json:"Phase"`                             // optional, regular-season or playoffs
+}
+
+func (x *GetShotChartRequest) Reset() {
+	*x = GetShotChartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShotChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShotChartRequest) ProtoMessage() {}
+
+func (x *GetShotChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShotChartRequest.ProtoReflect.Descriptor instead.
+func (*GetShotChartRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetShotChartRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GetShotChartRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *GetShotChartRequest) GetCompetitionIds() []int32 {
+	if x != nil {
+		return x.CompetitionIds
+	}
+	return nil
+}
+
+func (x *GetShotChartRequest) GetOpponentTeamIds() []int32 {
+	if x != nil {
+		return x.OpponentTeamIds
+	}
+	return nil
+}
+
+func (x *GetShotChartRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+type ShotZoneStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Zone       string  `protobuf:"bytes,1,opt,name=Zone,proto3" json:"Zone"`
+	Points     int32   `protobuf:"varint,2,opt,name=Points,proto3" json:"Points"` // 2 or 3
+	Made       int32   `protobuf:"varint,3,opt,name=Made,proto3" json:"Made"`
+	Attempted  int32   `protobuf:"varint,4,opt,name=Attempted,proto3" json:"Attempted"`
+	Percentage float64 `protobuf:"fixed64,5,opt,name=Percentage,proto3" json:"Percentage"`
+}
+
+func (x *ShotZoneStats) Reset() {
+	*x = ShotZoneStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShotZoneStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShotZoneStats) ProtoMessage() {}
+
+func (x *ShotZoneStats) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShotZoneStats.ProtoReflect.Descriptor instead.
+func (*ShotZoneStats) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ShotZoneStats) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *ShotZoneStats) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *ShotZoneStats) GetMade() int32 {
+	if x != nil {
+		return x.Made
+	}
+	return 0
+}
+
+func (x *ShotZoneStats) GetAttempted() int32 {
+	if x != nil {
+		return x.Attempted
+	}
+	return 0
+}
+
+func (x *ShotZoneStats) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type Shot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   int32   `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+	ShotX    float64 `protobuf:"fixed64,2,opt,name=ShotX,proto3" json:"ShotX"` // (0, 0) when only the zone was recorded
+	ShotY    float64 `protobuf:"fixed64,3,opt,name=ShotY,proto3" json:"ShotY"`
+	ShotZone string  `protobuf:"bytes,4,opt,name=ShotZone,proto3" json:"ShotZone"`
+	Made     bool    `protobuf:"varint,5,opt,name=Made,proto3" json:"Made"`
+}
+
+func (x *Shot) Reset() {
+	*x = Shot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Shot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shot) ProtoMessage() {}
+
+func (x *Shot) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shot.ProtoReflect.Descriptor instead.
+func (*Shot) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Shot) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *Shot) GetShotX() float64 {
+	if x != nil {
+		return x.ShotX
+	}
+	return 0
+}
+
+func (x *Shot) GetShotY() float64 {
+	if x != nil {
+		return x.ShotY
+	}
+	return 0
+}
+
+func (x *Shot) GetShotZone() string {
+	if x != nil {
+		return x.ShotZone
+	}
+	return ""
+}
+
+func (x *Shot) GetMade() bool {
+	if x != nil {
+		return x.Made
+	}
+	return false
+}
+
+// field goals over final and forfeited games by zone. Attempts without a location
+// fall in two-point-unlocated and three-point-unlocated, so the zones add up to the
+// TwoPointFGA, TwoPointFGM, ThreePointFGA and ThreePointFGM of the same games
+type ShotChart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player    *Player          `protobuf:"bytes,1,opt,name=Player,proto3" json:"Player"` // for a player's chart
+	Team      *Team            `protobuf:"bytes,2,opt,name=Team,proto3" json:"Team"`     // for a team's chart
+	GameCount int32            `protobuf:"varint,3,opt,name=GameCount,proto3" json:"GameCount"`
+	Zones     []*ShotZoneStats `protobuf:"bytes,4,rep,name=Zones,proto3" json:"Zones"` // every zone, closest to the basket first
+	Shots     []*Shot          `protobuf:"bytes,5,rep,name=Shots,proto3" json:"Shots"` // the located attempts
+}
+
+func (x *ShotChart) Reset() {
+	*x = ShotChart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShotChart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShotChart) ProtoMessage() {}
+
+func (x *ShotChart) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShotChart.ProtoReflect.Descriptor instead.
+func (*ShotChart) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ShotChart) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *ShotChart) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *ShotChart) GetGameCount() int32 {
+	if x != nil {
+		return x.GameCount
+	}
+	return 0
+}
+
+func (x *ShotChart) GetZones() []*ShotZoneStats {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *ShotChart) GetShots() []*Shot {
+	if x != nil {
+		return x.Shots
+	}
+	return nil
+}
+
+// teams that have never played a final game are rated 1500
+type GetTeamRatingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompetitionId  int32   `protobuf:"varint,1,opt,name=CompetitionId,proto3" json:"CompetitionId"` // optional, the teams entered in it
+	TeamIds        []int32 `protobuf:"varint,2,rep,packed,name=TeamIds,proto3" json:"TeamIds"`      // optional
+	IncludeHistory bool    `protobuf:"varint,3,opt,name=IncludeHistory,proto3" json:"IncludeHistory"`
+}
+
+func (x *GetTeamRatingsRequest) Reset() {
+	*x = GetTeamRatingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTeamRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRatingsRequest) ProtoMessage() {}
+
+func (x *GetTeamRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetTeamRatingsRequest) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *GetTeamRatingsRequest) GetTeamIds() []int32 {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+func (x *GetTeamRatingsRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+type RatingChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId        int32   `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+	GameTime      string  `protobuf:"bytes,2,opt,name=GameTime,proto3" json:"GameTime"`
+	Opponent      *Team   `protobuf:"bytes,3,opt,name=Opponent,proto3" json:"Opponent"`
+	PointsFor     int32   `protobuf:"varint,4,opt,name=PointsFor,proto3" json:"PointsFor"`
+	PointsAgainst int32   `protobuf:"varint,5,opt,name=PointsAgainst,proto3" json:"PointsAgainst"`
+	RatingBefore  float64 `protobuf:"fixed64,6,opt,name=RatingBefore,proto3" json:"RatingBefore"`
+	RatingAfter   float64 `protobuf:"fixed64,7,opt,name=RatingAfter,proto3" json:"RatingAfter"`
+}
+
+func (x *RatingChange) Reset() {
+	*x = RatingChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingChange) ProtoMessage() {}
+
+func (x *RatingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingChange.ProtoReflect.Descriptor instead.
+func (*RatingChange) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RatingChange) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *RatingChange) GetGameTime() string {
+	if x != nil {
+		return x.GameTime
+	}
+	return ""
+}
+
+func (x *RatingChange) GetOpponent() *Team {
+	if x != nil {
+		return x.Opponent
+	}
+	return nil
+}
+
+func (x *RatingChange) GetPointsFor() int32 {
+	if x != nil {
+		return x.PointsFor
+	}
+	return 0
+}
+
+func (x *RatingChange) GetPointsAgainst() int32 {
+	if x != nil {
+		return x.PointsAgainst
+	}
+	return 0
+}
+
+func (x *RatingChange) GetRatingBefore() float64 {
+	if x != nil {
+		return x.RatingBefore
+	}
+	return 0
+}
+
+func (x *RatingChange) GetRatingAfter() float64 {
+	if x != nil {
+		return x.RatingAfter
+	}
+	return 0
+}
+
+type TeamRating struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team      *Team           `protobuf:"bytes,1,opt,name=Team,proto3" json:"Team"`
+	Rating    float64         `protobuf:"fixed64,2,opt,name=Rating,proto3" json:"Rating"`
+	GameCount int32           `protobuf:"varint,3,opt,name=GameCount,proto3" json:"GameCount"` // final games rated
+	History   []*RatingChange `protobuf:"bytes,4,rep,name=History,proto3" json:"History"`      // oldest first, with IncludeHistory
+}
+
+func (x *TeamRating) Reset() {
+	*x = TeamRating{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamRating) ProtoMessage() {}
+
+func (x *TeamRating) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamRating.ProtoReflect.Descriptor instead.
+func (*TeamRating) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *TeamRating) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *TeamRating) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *TeamRating) GetGameCount() int32 {
+	if x != nil {
+		return x.GameCount
+	}
+	return 0
+}
+
+func (x *TeamRating) GetHistory() []*RatingChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type GetTeamRatingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ratings []*TeamRating `protobuf:"bytes,1,rep,name=Ratings,proto3" json:"Ratings"` // highest first
+}
+
+func (x *GetTeamRatingsResponse) Reset() {
+	*x = GetTeamRatingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTeamRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRatingsResponse) ProtoMessage() {}
+
+func (x *GetTeamRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRatingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetTeamRatingsResponse) GetRatings() []*TeamRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+type PredictGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId int32 `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+}
+
+func (x *PredictGameRequest) Reset() {
+	*x = PredictGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PredictGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredictGameRequest) ProtoMessage() {}
+
+func (x *PredictGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredictGameRequest.ProtoReflect.Descriptor instead.
+func (*PredictGameRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *PredictGameRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+// from the teams' ratings going into the game, so a game already played is predicted as it stood
+type GamePrediction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId             int32   `protobuf:"varint,1,opt,name=GameId,proto3" json:"GameId"`
+	HomeTeamRating     float64 `protobuf:"fixed64,2,opt,name=HomeTeamRating,proto3" json:"HomeTeamRating"`
+	AwayTeamRating     float64 `protobuf:"fixed64,3,opt,name=AwayTeamRating,proto3" json:"AwayTeamRating"`
+	HomeWinProbability float64 `protobuf:"fixed64,4,opt,name=HomeWinProbability,proto3" json:"HomeWinProbability"` // with home court taken into account
+	AwayWinProbability float64 `protobuf:"fixed64,5,opt,name=AwayWinProbability,proto3" json:"AwayWinProbability"`
+}
+
+func (x *GamePrediction) Reset() {
+	*x = GamePrediction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GamePrediction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePrediction) ProtoMessage() {}
+
+func (x *GamePrediction) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePrediction.ProtoReflect.Descriptor instead.
+func (*GamePrediction) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GamePrediction) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *GamePrediction) GetHomeTeamRating() float64 {
+	if x != nil {
+		return x.HomeTeamRating
+	}
+	return 0
+}
+
+func (x *GamePrediction) GetAwayTeamRating() float64 {
+	if x != nil {
+		return x.AwayTeamRating
+	}
+	return 0
+}
+
+func (x *GamePrediction) GetHomeWinProbability() float64 {
+	if x != nil {
+		return x.HomeWinProbability
+	}
+	return 0
+}
+
+func (x *GamePrediction) GetAwayWinProbability() float64 {
+	if x != nil {
+		return x.AwayWinProbability
+	}
+	return 0
+}
+
+// voted on after every game of a competition, with the votes added up over the season
+type Award struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AwardId       int32   `protobuf:"varint,1,opt,name=AwardId,proto3" json:"AwardId"`
+	CompetitionId int32   `protobuf:"varint,2,opt,name=CompetitionId,proto3" json:"CompetitionId"`
+	Name          string  `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name"`                     // e.g. "Best on Court", unique in the competition
+	VotePoints    []int32 `protobuf:"varint,4,rep,packed,name=VotePoints,proto3" json:"VotePoints"` // for each place on a ballot, defaults to 3, 2, 1
+}
+
+func (x *Award) Reset() {
+	*x = Award{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Award) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Award) ProtoMessage() {}
+
+func (x *Award) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Award.ProtoReflect.Descriptor instead.
+func (*Award) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *Award) GetAwardId() int32 {
+	if x != nil {
+		return x.AwardId
+	}
+	return 0
+}
+
+func (x *Award) GetCompetitionId() int32 {
+	if x != nil {
+		return x.CompetitionId
+	}
+	return 0
+}
+
+func (x *Award) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Award) GetVotePoints() []int32 {
+	if x != nil {
+		return x.VotePoints
+	}
+	return nil
+}
+
+type CreateAwardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Award *Award `protobuf:"bytes,1,opt,name=Award,proto3" json:"Award"` // AwardId is ignored
+}
+
+func (x *CreateAwardRequest) Reset() {
+	*x = CreateAwardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAwardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAwardRequest) ProtoMessage() {}
+
+func (x *CreateAwardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAwardRequest.ProtoReflect.Descriptor instead.
+func (*CreateAwardRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateAwardRequest) GetAward() *Award {
+	if x != nil {
+		return x.Award
+	}
+	return nil
+}
+
+type CreateAwardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AwardId int32 `protobuf:"varint,1,opt,name=AwardId,proto3" json:"AwardId"`
+}
+
+func (x *CreateAwardResponse) Reset() {
+	*x = CreateAwardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAwardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAwardResponse) ProtoMessage() {}
+
+func (x *CreateAwardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAwardResponse.ProtoReflect.Descriptor instead.
+func (*CreateAwardResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateAwardResponse) GetAwardId() int32 {
+	if x != nil {
+		return x.AwardId
+	}
+	return 0
+}
+
+// replaces the voter's ballot for the game
+type CastAwardVotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AwardId   int32   `protobuf:"varint,1,opt,name=AwardId,proto3" json:"AwardId"`
+	GameId    int32   `protobuf:"varint,2,opt,name=GameId,proto3" json:"GameId"`              // must be final
+	Voter     string  `protobuf:"bytes,3,opt,name=Voter,proto3" json:"Voter"`                 // who voted, one ballot each per game. With tokens on it is the token's subject, and may be left empty
+	VoterRole string  `protobuf:"bytes,4,opt,name=VoterRole,proto3" json:"VoterRole"`         // coach or referee
+	PlayerIds []int32 `protobuf:"varint,5,rep,packed,name=PlayerIds,proto3" json:"PlayerIds"` // best first, up to one for each VotePoints, none withdraws the ballot
+}
+
+func (x *CastAwardVotesRequest) Reset() {
+	*x = CastAwardVotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastAwardVotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastAwardVotesRequest) ProtoMessage() {}
+
+func (x *CastAwardVotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastAwardVotesRequest.ProtoReflect.Descriptor instead.
+func (*CastAwardVotesRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CastAwardVotesRequest) GetAwardId() int32 {
+	if x != nil {
+		return x.AwardId
+	}
+	return 0
+}
+
+func (x *CastAwardVotesRequest) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *CastAwardVotesRequest) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *CastAwardVotesRequest) GetVoterRole() string {
+	if x != nil {
+		return x.VoterRole
+	}
+	return ""
+}
+
+func (x *CastAwardVotesRequest) GetPlayerIds() []int32 {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+type CastAwardVotesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *AwardGameResult `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result"`
+}
+
+func (x *CastAwardVotesResponse) Reset() {
+	*x = CastAwardVotesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastAwardVotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastAwardVotesResponse) ProtoMessage() {}
+
+func (x *CastAwardVotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastAwardVotesResponse.ProtoReflect.Descriptor instead.
+func (*CastAwardVotesResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CastAwardVotesResponse) GetResult() *AwardGameResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type AwardVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=Player,proto3" json:"Player"`
+	Points int32   `protobuf:"varint,2,opt,name=Points,proto3" json:"Points"`
+}
+
+func (x *AwardVote) Reset() {
+	*x = AwardVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AwardVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwardVote) ProtoMessage() {}
+
+func (x *AwardVote) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwardVote.ProtoReflect.Descriptor instead.
+func (*AwardVote) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *AwardVote) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *AwardVote) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// with no ballots, the players with the best game score take the VotePoints of a single ballot
+type AwardGameResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Award         *Award       `protobuf:"bytes,1,opt,name=Award,proto3" json:"Award"`
+	GameId        int32        `protobuf:"varint,2,opt,name=GameId,proto3" json:"GameId"`
+	BallotCount   int32        `protobuf:"varint,3,opt,name=BallotCount,proto3" json:"BallotCount"`
+	FromGameScore bool         `protobuf:"varint,4,opt,name=FromGameScore,proto3" json:"FromGameScore"`
+	Votes         []*AwardVote `protobuf:"bytes,5,rep,name=Votes,proto3" json:"Votes"`     // most points first
+	Winners       []*Player    `protobuf:"bytes,6,rep,name=Winners,proto3" json:"Winners"` // more than one on a tie
+}
+
+func (x *AwardGameResult) Reset() {
+	*x = AwardGameResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AwardGameResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwardGameResult) ProtoMessage() {}
+
+func (x *AwardGameResult) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwardGameResult.ProtoReflect.Descriptor instead.
+func (*AwardGameResult) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AwardGameResult) GetAward() *Award {
+	if x != nil {
+		return x.Award
+	}
+	return nil
+}
+
+func (x *AwardGameResult) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *AwardGameResult) GetBallotCount() int32 {
+	if x != nil {
+		return x.BallotCount
+	}
+	return 0
+}
+
+func (x *AwardGameResult) GetFromGameScore() bool {
+	if x != nil {
+		return x.FromGameScore
+	}
+	return false
+}
+
+func (x *AwardGameResult) GetVotes() []*AwardVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *AwardGameResult) GetWinners() []*Player {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+type GetAwardLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AwardId int32 `protobuf:"varint,1,opt,name=AwardId,proto3" json:"AwardId"`
+	Count   int32 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count"` // defaults to 10
+}
+
+func (x *GetAwardLeaderboardRequest) Reset() {
+	*x = GetAwardLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAwardLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAwardLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetAwardLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAwardLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetAwardLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetAwardLeaderboardRequest) GetAwardId() int32 {
+	if x != nil {
+		return x.AwardId
+	}
+	return 0
+}
+
+func (x *GetAwardLeaderboardRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AwardLeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank            int32   `protobuf:"varint,1,opt,name=Rank,proto3" json:"Rank"` // players level on points share a rank
+	Player          *Player `protobuf:"bytes,2,opt,name=Player,proto3" json:"Player"`
+	Points          int32   `protobuf:"varint,3,opt,name=Points,proto3" json:"Points"`
+	VotedPoints     int32   `protobuf:"varint,4,opt,name=VotedPoints,proto3" json:"VotedPoints"`         // of Points, from ballots
+	GameScorePoints int32   `protobuf:"varint,5,opt,name=GameScorePoints,proto3" json:"GameScorePoints"` // of Points, from games without ballots
+	GamesWon        int32   `protobuf:"varint,6,opt,name=GamesWon,proto3" json:"GamesWon"`
+}
+
+func (x *AwardLeaderboardEntry) Reset() {
+	*x = AwardLeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AwardLeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwardLeaderboardEntry) ProtoMessage() {}
+
+func (x *AwardLeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwardLeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*AwardLeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *AwardLeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *AwardLeaderboardEntry) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *AwardLeaderboardEntry) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *AwardLeaderboardEntry) GetVotedPoints() int32 {
+	if x != nil {
+		return x.VotedPoints
+	}
+	return 0
+}
+
+func (x *AwardLeaderboardEntry) GetGameScorePoints() int32 {
+	if x != nil {
+		return x.GameScorePoints
+	}
+	return 0
+}
+
+func (x *AwardLeaderboardEntry) GetGamesWon() int32 {
+	if x != nil {
+		return x.GamesWon
+	}
+	return 0
+}
+
+// over the competition's final games so far
+type AwardLeaderboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Award     *Award                   `protobuf:"bytes,1,opt,name=Award,proto3" json:"Award"`
+	GameCount int32                    `protobuf:"varint,2,opt,name=GameCount,proto3" json:"GameCount"`
+	Entries   []*AwardLeaderboardEntry `protobuf:"bytes,3,rep,name=Entries,proto3" json:"Entries"`
+}
+
+func (x *AwardLeaderboard) Reset() {
+	*x = AwardLeaderboard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AwardLeaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwardLeaderboard) ProtoMessage() {}
+
+func (x *AwardLeaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwardLeaderboard.ProtoReflect.Descriptor instead.
+func (*AwardLeaderboard) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AwardLeaderboard) GetAward() *Award {
+	if x != nil {
+		return x.Award
+	}
+	return nil
+}
+
+func (x *AwardLeaderboard) GetGameCount() int32 {
+	if x != nil {
+		return x.GameCount
+	}
+	return 0
+}
+
+func (x *AwardLeaderboard) GetEntries() []*AwardLeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PlayerAward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Award    *Award                 `protobuf:"bytes,1,opt,name=Award,proto3" json:"Award"`
+	Standing *AwardLeaderboardEntry `protobuf:"bytes,2,opt,name=Standing,proto3" json:"Standing"`
+}
+
+func (x *PlayerAward) Reset() {
+	*x = PlayerAward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerAward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAward) ProtoMessage() {}
+
+func (x *PlayerAward) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAward.ProtoReflect.Descriptor instead.
+func (*PlayerAward) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PlayerAward) GetAward() *Award {
+	if x != nil {
+		return x.Award
+	}
+	return nil
+}
+
+func (x *PlayerAward) GetStanding() *AwardLeaderboardEntry {
+	if x != nil {
+		return x.Standing
+	}
+	return nil
+}
+
+type RefreshViewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshViewsRequest) Reset() {
+	*x = RefreshViewsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshViewsRequest) ProtoMessage() {}
+
+func (x *RefreshViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshViewsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshViewsRequest) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{125}
+}
+
+type RefreshViewsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastRefreshTime     string `protobuf:"bytes,1,opt,name=LastRefreshTime,proto3" json:"LastRefreshTime"`         // RFC3339, the refresh just performed
+	PreviousRefreshTime string `protobuf:"bytes,2,opt,name=PreviousRefreshTime,proto3" json:"PreviousRefreshTime"` // RFC3339, empty if none since startup
+	DurationMs          int32  `protobuf:"varint,3,opt,name=DurationMs,proto3" json:"DurationMs"`
+}
+
+func (x *RefreshViewsResponse) Reset() {
+	*x = RefreshViewsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_heroball_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshViewsResponse) ProtoMessage() {}
+
+func (x *RefreshViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_heroball_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshViewsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshViewsResponse) Descriptor() ([]byte, []int) {
+	return file_heroball_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *RefreshViewsResponse) GetLastRefreshTime() string {
+	if x != nil {
+		return x.LastRefreshTime
+	}
+	return ""
+}
+
+func (x *RefreshViewsResponse) GetPreviousRefreshTime() string {
+	if x != nil {
+		return x.PreviousRefreshTime
+	}
+	return ""
+}
+
+func (x *RefreshViewsResponse) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+var File_heroball_proto protoreflect.FileDescriptor
+
+var file_heroball_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x68, 0x65, 0x72, 0x6f, 0x62, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x54, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x67,
+	0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8d,
+	0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x06, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x67, 0x75, 0x65, 0x52, 0x06, 0x4c, 0x65, 0x61, 0x67,
+	0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x32,
+	0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xb7, 0x03, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04,
+	0x54, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x57, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x57, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x77, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x44, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x4c, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4c, 0x6f, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x4c, 0x61, 0x64, 0x64, 0x65, 0x72,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x4c, 0x61,
+	0x64, 0x64, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x12, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2a,
+	0x0a, 0x10, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x57, 0x69,
+	0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x57, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x6f, 0x72, 0x6d,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x6f, 0x72, 0x6d, 0x22, 0xb6, 0x01, 0x0a,
+	0x0e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x57, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x57, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x44, 0x72, 0x61, 0x77, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x44, 0x72, 0x61, 0x77, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x69, 0x65, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x69, 0x65, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc8, 0x05, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x54, 0x77, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x47, 0x4d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x54, 0x77, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x47,
+	0x4d, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x77, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x47, 0x41,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x54, 0x77, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x46, 0x47, 0x41, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x68, 0x72, 0x65, 0x65, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x46, 0x47, 0x4d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x54, 0x68, 0x72, 0x65,
+	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x47, 0x4d, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x68, 0x72,
+	0x65, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x47, 0x41, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x54, 0x68, 0x72, 0x65, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x47, 0x41, 0x12,
+	0x26, 0x0a, 0x0e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x4d, 0x61, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x68, 0x72,
+	0x6f, 0x77, 0x73, 0x4d, 0x61, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x46, 0x72, 0x65, 0x65, 0x54,
+	0x68, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x46, 0x72, 0x65, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x4f, 0x66, 0x66,
+	0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x66, 0x65, 0x6e,
+	0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53,
+	0x74, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2e, 0x0a,
+	0x12, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x75, 0x6c, 0x73, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x52, 0x65, 0x67, 0x75, 0x6c,
+	0x61, 0x72, 0x46, 0x6f, 0x75, 0x6c, 0x73, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x15, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x75, 0x6c, 0x73, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x52, 0x65,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x46, 0x6f, 0x75, 0x6c, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x46, 0x6f, 0x75, 0x6c, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x46,
+	0x6f, 0x75, 0x6c, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2d, 0x0a, 0x08, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x64,
+	0x22, 0xe9, 0x04, 0x0a, 0x0d, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x4d, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x4d, 0x61,
+	0x64, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x73,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x13, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x1c, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1c, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x54, 0x72, 0x75, 0x65,
+	0x53, 0x68, 0x6f, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x54, 0x72, 0x75, 0x65, 0x53, 0x68,
+	0x6f, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x34, 0x0a, 0x15, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x72, 0x6e,
+	0x6f, 0x76, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x15, 0x41, 0x73, 0x73, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65,
+	0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x15,
+	0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x4f, 0x66, 0x66,
+	0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x15, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x68, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x68, 0x6f, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x46, 0x72, 0x65, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x46, 0x72, 0x65, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x77,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x50, 0x65, 0x72, 0x33, 0x36, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x33, 0x36, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x50, 0x65, 0x72, 0x33, 0x36, 0x12, 0x30, 0x0a, 0x13, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x6f,
+	0x61, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xe8, 0x02, 0x0a,
+	0x0a, 0x50, 0x65, 0x72, 0x33, 0x36, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x4f, 0x66, 0x66, 0x65,
+	0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41,
+	0x73, 0x73, 0x69, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x41, 0x73,
+	0x73, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x76,
+	0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c,
+	0x73, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x13, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x6f, 0x61, 0x6c, 0x73, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x46, 0x72, 0x65, 0x65, 0x54, 0x68, 0x72,
+	0x6f, 0x77, 0x73, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x13, 0x46, 0x72, 0x65, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x59, 0x65, 0x61, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x59, 0x65, 0x61, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x01,
+	0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x54, 0x65, 0x61,
+	0x6d, 0x12, 0x22, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x5b, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x22,
+	0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x65,
+	0x61, 0x6d, 0x12, 0x31, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x54,
+	0x65, 0x61, 0x6d, 0x12, 0x40, 0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4a, 0x65, 0x72, 0x73, 0x65, 0x79, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x4a, 0x65,
+	0x72, 0x73, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xe5, 0x02, 0x0a, 0x04,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x08,
+	0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x08, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65,
+	0x61, 0x6d, 0x12, 0x24, 0x0a, 0x08, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x08,
+	0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x28, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x49, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x48, 0x6f, 0x6d, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x77,
+	0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x77,
+	0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0xce, 0x03, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a,
+	0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x54, 0x65,
+	0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x54, 0x65, 0x61, 0x6d, 0x73,
+	0x12, 0x40, 0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x0b, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b,
+	0x43, 0x61, 0x72, 0x65, 0x65, 0x72, 0x48, 0x69, 0x67, 0x68, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x0b, 0x43, 0x61, 0x72, 0x65, 0x65, 0x72, 0x48, 0x69, 0x67, 0x68, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x54, 0x72, 0x69,
+	0x70, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x41, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x04,
+	0x54, 0x65, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x22, 0xe5, 0x01, 0x0a, 0x08, 0x54,
+	0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x31, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x07, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0b, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x22, 0x56, 0x0a, 0x0c, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x4a, 0x65, 0x72, 0x73, 0x65, 0x79,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x4a, 0x65,
+	0x72, 0x73, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xe3, 0x01, 0x0a, 0x08, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0c,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x0c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x04, 0x46, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x04, 0x46,
+	0x6c, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x06, 0x41, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x77, 0x61, 0x72, 0x64, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x41, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x22, 0x91, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x48, 0x6f,
+	0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x46, 0x6c, 0x6f,
+	0x77, 0x12, 0x30, 0x0a, 0x13, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x61, 0x72,
+	0x67, 0x65, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13,
+	0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x4c,
+	0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x13, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x73,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x4c, 0x65, 0x61, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x46, 0x72, 0x6f,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf7, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70,
+	0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x52, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x2a, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a,
+	0x05, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x61,
+	0x6d, 0x52, 0x05, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x69, 0x72, 0x73,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x4c, 0x61, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4c, 0x61, 0x73, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x0e,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x06, 0x41, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x41, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x95, 0x02, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x54,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x4f,
+	0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x2c,
+	0x0a, 0x11, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x11, 0x4f, 0x70, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x44, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x59, 0x65, 0x61, 0x72,
+	0x22, 0x8c, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x05, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x05, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x51, 0x0a,
+	0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73,
+	0x22, 0x96, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x29,
+	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x72, 0x6f, 0x42, 0x61, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x10,
+	0x48, 0x65, 0x72, 0x6f, 0x42, 0x61, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x33, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x71, 0x0a, 0x0f, 0x46,
+	0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x57,
+	0x0a, 0x13, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07,
+	0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x4d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x03, 0x46, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x46, 0x6f,
+	0x72, 0x12, 0x31, 0x0a, 0x07, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x41, 0x67, 0x61,
+	0x69, 0x6e, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x54, 0x65,
+	0x61, 0x6d, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1c, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1f,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x2f, 0x0a, 0x0d, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x0d, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0xfa, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x03, 0x46, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x03, 0x46, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x41, 0x67, 0x61, 0x69,
+	0x6e, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x22, 0x5d, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x43, 0x6f, 0x6d, 0x70,
+	0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x22, 0x43, 0x0a, 0x0a, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x22, 0x56, 0x0a, 0x0d, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x08,
+	0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0xdd, 0x02, 0x0a, 0x0c, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x04, 0x48, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x04, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x04, 0x41, 0x77, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x04, 0x41, 0x77, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x04,
+	0x57, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x04, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x4c,
+	0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x4c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x05, 0x44, 0x72, 0x61, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x44, 0x72, 0x61, 0x77, 0x73, 0x12,
+	0x26, 0x0a, 0x06, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52,
+	0x06, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x09, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x4f, 0x70, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x09,
+	0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70,
+	0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x65, 0x61,
+	0x67, 0x75, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4c, 0x65, 0x61,
+	0x67, 0x75, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x47, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x4d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x53, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x07, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0b, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x57, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x0a,
+	0x07, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x07, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x79, 0x0a, 0x0b, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x31, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x0b,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x0d, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x22, 0xa6, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x4f,
+	0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x70, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x99, 0x03,
+	0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x08,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52,
+	0x08, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x57, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x57, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x44,
+	0x72, 0x61, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x44, 0x72, 0x61, 0x77,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x09, 0x54,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4a, 0x0a,
+	0x13, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x13, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x54, 0x6f, 0x70,
+	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0d, 0x54, 0x6f, 0x70, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x41, 0x67,
+	0x61, 0x69, 0x6e, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x47, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x05, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x4a, 0x65, 0x72, 0x73, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x4a, 0x65, 0x72, 0x73, 0x65, 0x79,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0xbf, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x66, 0x65,
+	0x69, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xd7, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x48,
+	0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x41,
+	0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x47,
+	0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x47,
+	0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x46, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49,
+	0x64, 0x22, 0x2c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22,
+	0x2b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x1c, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x22, 0x3b, 0x0a, 0x1d, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x64, 0x73, 0x22,
+	0x4d, 0x0a, 0x0b, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x4a, 0x65,
+	0x72, 0x73, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x4a, 0x65, 0x72, 0x73, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x89,
+	0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x1f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x1d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x1e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48,
+	0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a,
+	0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3c, 0x0a,
+	0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x17,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07,
+	0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0xb9, 0x01, 0x0a, 0x0d,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x0b, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x42, 0x79, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x05, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x42, 0x79, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x65, 0x52, 0x04, 0x42, 0x79, 0x65, 0x73, 0x22, 0x72,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x65,
+	0x73, 0x74, 0x4f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x42, 0x65, 0x73, 0x74,
+	0x4f, 0x66, 0x22, 0x39, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa9, 0x03,
+	0x0a, 0x0d, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x42, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x42,
+	0x65, 0x73, 0x74, 0x4f, 0x66, 0x12, 0x28, 0x0a, 0x0a, 0x48, 0x69, 0x67, 0x68, 0x65, 0x72, 0x53,
+	0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54,
+	0x65, 0x61, 0x6d, 0x52, 0x0a, 0x48, 0x69, 0x67, 0x68, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x48, 0x69, 0x67, 0x68, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x48, 0x69, 0x67, 0x68, 0x65,
+	0x72, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x48,
+	0x69, 0x67, 0x68, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x48, 0x69, 0x67, 0x68, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x57,
+	0x69, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x09, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x4c,
+	0x6f, 0x77, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x65,
+	0x65, 0x64, 0x57, 0x69, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x4c, 0x6f,
+	0x77, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x57,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x07, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x0c, 0x42, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x29, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x06, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x07, 0x42,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x62, 0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6d, 0x70, 0x69,
+	0x6f, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x43, 0x68, 0x61, 0x6d, 0x70, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x2b,
+	0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x0a,
+	0x47, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x47, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x22, 0xb3, 0x02, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x68, 0x6f, 0x74, 0x58, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x53, 0x68, 0x6f, 0x74, 0x58, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x68, 0x6f, 0x74, 0x59,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x53, 0x68, 0x6f, 0x74, 0x59, 0x12, 0x1a, 0x0a,
+	0x08, 0x53, 0x68, 0x6f, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x53, 0x68, 0x6f, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x39, 0x0a, 0x12, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0x2c, 0x0a, 0x10, 0x55, 0x6e, 0x64, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x58,
+	0x0a, 0x11, 0x55, 0x6e, 0x64, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x71, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x84, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x49, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0x51, 0x0a,
+	0x19, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x53, 0x75,
+	0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x5e, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x6e, 0x43, 0x6f, 0x75, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x4f, 0x6e, 0x43, 0x6f, 0x75, 0x72, 0x74,
+	0x22, 0x41, 0x0a, 0x17, 0x55, 0x6e, 0x64, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x53,
+	0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x18, 0x55, 0x6e, 0x64, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x50, 0x6f, 0x73, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdb, 0x02,
+	0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x24, 0x0a,
+	0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x12,
+	0x24, 0x0a, 0x0d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x41, 0x67,
+	0x61, 0x69, 0x6e, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x50, 0x6f, 0x73, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x4f, 0x70, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x4f, 0x66, 0x66,
+	0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x4f, 0x66, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x44, 0x65,
+	0x66, 0x65, 0x6e, 0x73, 0x69, 0x76, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x4e, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x4e, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x71, 0x0a, 0x0f, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6c, 0x75, 0x73, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x12, 0x22,
+	0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6c, 0x75, 0x73, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x50, 0x6c, 0x75, 0x73, 0x4d, 0x69, 0x6e, 0x75, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x76,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x65,
+	0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x6e, 0x65, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x4c, 0x69, 0x6e, 0x65,
+	0x75, 0x70, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x50, 0x6c, 0x75, 0x73, 0x4d, 0x69, 0x6e, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x50, 0x6c, 0x75, 0x73, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x52, 0x09, 0x50, 0x6c, 0x75,
+	0x73, 0x4d, 0x69, 0x6e, 0x75, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x68,
+	0x6f, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x43, 0x6f, 0x6d, 0x70,
+	0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x4f, 0x70,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0f, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x53,
+	0x68, 0x6f, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x5a, 0x6f, 0x6e, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x61, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4d, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x7a, 0x0a, 0x04, 0x53, 0x68,
+	0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x68,
+	0x6f, 0x74, 0x58, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x53, 0x68, 0x6f, 0x74, 0x58,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x68, 0x6f, 0x74, 0x59, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x53, 0x68, 0x6f, 0x74, 0x59, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x74, 0x5a, 0x6f,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x68, 0x6f, 0x74, 0x5a, 0x6f,
+	0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x4d, 0x61, 0x64, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x74, 0x43,
+	0x68, 0x61, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x74, 0x5a, 0x6f, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x05, 0x53, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x53, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x7f, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x54, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x54,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xf2,
+	0x01, 0x0a, 0x0c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52,
+	0x08, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x0a, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x54, 0x65, 0x61, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x47, 0x61, 0x6d,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x22, 0x42, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x48, 0x6f, 0x6d, 0x65, 0x54, 0x65, 0x61,
+	0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x77, 0x61, 0x79, 0x54,
+	0x65, 0x61, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x41, 0x77, 0x61, 0x79, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x2e, 0x0a, 0x12, 0x48, 0x6f, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x48, 0x6f, 0x6d,
+	0x65, 0x57, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x2e, 0x0a, 0x12, 0x41, 0x77, 0x61, 0x79, 0x57, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x41, 0x77, 0x61,
+	0x79, 0x57, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22,
+	0x7b, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x77, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x41, 0x77, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x41, 0x77,
+	0x61, 0x72, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x77,
+	0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x43, 0x61, 0x73, 0x74, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x41, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x22, 0x45, 0x0a, 0x16, 0x43, 0x61, 0x73, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x77, 0x61, 0x72, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x47, 0x0a, 0x09, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x0f, 0x41, 0x77, 0x61, 0x72, 0x64, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x77, 0x61, 0x72,
+	0x64, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x07,
+	0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x57, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x22, 0x4c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x41, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x41, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xcf, 0x01, 0x0a, 0x15, 0x41, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x61,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x22,
+	0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x6f,
+	0x74, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x57,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x57,
+	0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x41, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x47, 0x61, 0x6d,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x0b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x41, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x56, 0x69, 0x65,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x14, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x56, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4c, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x13,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x32, 0xce,
+	0x1f, 0x0a, 0x0f, 0x48, 0x65, 0x72, 0x6f, 0x42, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x57, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x61, 0x6d,
+	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x85, 0x01,
+	0x0a, 0x15, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f, 0x67, 0x61,
+	0x6d, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x79, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x61, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22,
+	0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x83, 0x01, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x90, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12,
+	0x23, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x23, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x63,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76,
+	0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x60,
+	0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0x5a, 0x0a, 0x09, 0x55, 0x6e, 0x64, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2f, 0x67,
+	0x61, 0x6d, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x5b, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x12, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a, 0x10, 0x55, 0x6e, 0x64, 0x6f, 0x53,
+	0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e,
+	0x64, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c,
+	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f,
+	0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12,
+	0x69, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
+	0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f,
+	0x6c, 0x69, 0x6e, 0x65, 0x75, 0x70, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x5a, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x53, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x73, 0x68, 0x6f, 0x74, 0x63, 0x68,
+	0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61,
+	0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x5d, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x67, 0x61,
+	0x6d, 0x65, 0x2f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a,
+	0x12, 0x5b, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a,
+	0x0e, 0x43, 0x61, 0x73, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x56, 0x6f,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x61, 0x73, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x77, 0x61, 0x72, 0x64,
+	0x2f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x41, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x2f, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22,
+	0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x7d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x2f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0x67, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x73, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x5e, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x5e, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x68, 0x65,
+	0x61, 0x64, 0x74, 0x6f, 0x68, 0x65, 0x61, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x48, 0x65, 0x72, 0x6f, 0x42, 0x61, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x72, 0x6f, 0x42,
+	0x61, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x42, 0x61, 0x6c, 0x6c,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x3a, 0x01, 0x2a, 0x12, 0x4a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22,
+	0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x52, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x59, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65,
+	0x74, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x3a, 0x01, 0x2a,
+	0x12, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6f,
+	0x3a, 0x01, 0x2a, 0x12, 0x51, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
+	0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x69,
+	0x6e, 0x66, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x6d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65,
+	0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x6e,
+	0x66, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x51, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47,
+	0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x67,
+	0x61, 0x6d, 0x65, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x65, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x56, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x56, 0x69,
+	0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x73, 0x3a, 0x01, 0x2a, 0x42,
+	0x1d, 0x5a, 0x1b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6c,
+	0x76, 0x39, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x3b, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_heroball_proto_rawDescOnce sync.Once
+	file_heroball_proto_rawDescData = file_heroball_proto_rawDesc
+)
+
+func file_heroball_proto_rawDescGZIP() []byte {
+	file_heroball_proto_rawDescOnce.Do(func() {
+		file_heroball_proto_rawDescData = protoimpl.X.CompressGZIP(file_heroball_proto_rawDescData)
+	})
+	return file_heroball_proto_rawDescData
+}
+
+var file_heroball_proto_msgTypes = make([]protoimpl.MessageInfo, 127)
+var file_heroball_proto_goTypes = []interface{}{
+	(*Player)(nil),                           // 0: pb.Player
+	(*League)(nil),                           // 1: pb.League
+	(*Competition)(nil),                      // 2: pb.Competition
+	(*Team)(nil),                             // 3: pb.Team
+	(*CompetitionTeam)(nil),                  // 4: pb.CompetitionTeam
+	(*StandingsRules)(nil),                   // 5: pb.StandingsRules
+	(*Location)(nil),                         // 6: pb.Location
+	(*Stats)(nil),                            // 7: pb.Stats
+	(*AdvancedStats)(nil),                    // 8: pb.AdvancedStats
+	(*Per36Stats)(nil),                       // 9: pb.Per36Stats
+	(*PlayerProfile)(nil),                    // 10: pb.PlayerProfile
+	(*PlayerGameStats)(nil),                  // 11: pb.PlayerGameStats
+	(*PlayerAggregateStats)(nil),             // 12: pb.PlayerAggregateStats
+	(*PlayerTeam)(nil),                       // 13: pb.PlayerTeam
+	(*Game)(nil),                             // 14: pb.Game
+	(*GameResult)(nil),                       // 15: pb.GameResult
+	(*PlayerInfo)(nil),                       // 16: pb.PlayerInfo
+	(*GameRecord)(nil),                       // 17: pb.GameRecord
+	(*TeamInfo)(nil),                         // 18: pb.TeamInfo
+	(*RosterPlayer)(nil),                     // 19: pb.RosterPlayer
+	(*GameInfo)(nil),                         // 20: pb.GameInfo
+	(*PeriodScore)(nil),                      // 21: pb.PeriodScore
+	(*GameFlow)(nil),                         // 22: pb.GameFlow
+	(*CompetitionInfo)(nil),                  // 23: pb.CompetitionInfo
+	(*GetPlayerInfoRequest)(nil),             // 24: pb.GetPlayerInfoRequest
+	(*GetGameInfoRequest)(nil),               // 25: pb.GetGameInfoRequest
+	(*GetTeamInfoRequest)(nil),               // 26: pb.GetTeamInfoRequest
+	(*GetCompetitionInfoRequest)(nil),        // 27: pb.GetCompetitionInfoRequest
+	(*GetGamesRequest)(nil),                  // 28: pb.GetGamesRequest
+	(*GamesFilter)(nil),                      // 29: pb.GamesFilter
+	(*Date)(nil),                             // 30: pb.Date
+	(*GamesCursor)(nil),                      // 31: pb.GamesCursor
+	(*GetPlayersRequest)(nil),                // 32: pb.GetPlayersRequest
+	(*PlayersFilter)(nil),                    // 33: pb.PlayersFilter
+	(*PlayersCursor)(nil),                    // 34: pb.PlayersCursor
+	(*GetHeroBallMetadataRequest)(nil),       // 35: pb.GetHeroBallMetadataRequest
+	(*HeroBallMetadata)(nil),                 // 36: pb.HeroBallMetadata
+	(*ForStatsRequest)(nil),                  // 37: pb.ForStatsRequest
+	(*AgainstStatsRequest)(nil),              // 38: pb.AgainstStatsRequest
+	(*GetPlayerAverageStatsRequest)(nil),     // 39: pb.GetPlayerAverageStatsRequest
+	(*GetPlayerAverageStatsResponse)(nil),    // 40: pb.GetPlayerAverageStatsResponse
+	(*TeamAggregateStats)(nil),               // 41: pb.TeamAggregateStats
+	(*GetTeamAverageStatsRequest)(nil),       // 42: pb.GetTeamAverageStatsRequest
+	(*GetTeamAverageStatsResponse)(nil),      // 43: pb.GetTeamAverageStatsResponse
+	(*GetPlayerSplitsRequest)(nil),           // 44: pb.GetPlayerSplitsRequest
+	(*MonthSplit)(nil),                       // 45: pb.MonthSplit
+	(*LocationSplit)(nil),                    // 46: pb.LocationSplit
+	(*OpponentSplit)(nil),                    // 47: pb.OpponentSplit
+	(*PlayerSplits)(nil),                     // 48: pb.PlayerSplits
+	(*GetRecordsBookRequest)(nil),            // 49: pb.GetRecordsBookRequest
+	(*GameRecords)(nil),                      // 50: pb.GameRecords
+	(*SeasonRecord)(nil),                     // 51: pb.SeasonRecord
+	(*SeasonRecords)(nil),                    // 52: pb.SeasonRecords
+	(*RecordsBook)(nil),                      // 53: pb.RecordsBook
+	(*GetHeadToHeadRequest)(nil),             // 54: pb.GetHeadToHeadRequest
+	(*HeadToHead)(nil),                       // 55: pb.HeadToHead
+	(*GetPlayerGamesStatsRequest)(nil),       // 56: pb.GetPlayerGamesStatsRequest
+	(*GetPlayerGamesStatsResponse)(nil),      // 57: pb.GetPlayerGamesStatsResponse
+	(*PlayerGameStatsEntry)(nil),             // 58: pb.PlayerGameStatsEntry
+	(*CreateGameRequest)(nil),                // 59: pb.CreateGameRequest
+	(*CreateGameResponse)(nil),               // 60: pb.CreateGameResponse
+	(*UpdateGameRequest)(nil),                // 61: pb.UpdateGameRequest
+	(*UpdateGameResponse)(nil),               // 62: pb.UpdateGameResponse
+	(*DeleteGameRequest)(nil),                // 63: pb.DeleteGameRequest
+	(*DeleteGameResponse)(nil),               // 64: pb.DeleteGameResponse
+	(*UpsertPlayerGameStatsRequest)(nil),     // 65: pb.UpsertPlayerGameStatsRequest
+	(*UpsertPlayerGameStatsResponse)(nil),    // 66: pb.UpsertPlayerGameStatsResponse
+	(*RosterEntry)(nil),                      // 67: pb.RosterEntry
+	(*UpdateCompetitionRosterRequest)(nil),   // 68: pb.UpdateCompetitionRosterRequest
+	(*UpdateCompetitionRosterResponse)(nil),  // 69: pb.UpdateCompetitionRosterResponse
+	(*UpdateGamePeriodScoresRequest)(nil),    // 70: pb.UpdateGamePeriodScoresRequest
+	(*UpdateGamePeriodScoresResponse)(nil),   // 71: pb.UpdateGamePeriodScoresResponse
+	(*UpdateCompetitionPeriodsRequest)(nil),  // 72: pb.UpdateCompetitionPeriodsRequest
+	(*UpdateCompetitionPeriodsResponse)(nil), // 73: pb.UpdateCompetitionPeriodsResponse
+	(*UpdateStandingsRulesRequest)(nil),      // 74: pb.UpdateStandingsRulesRequest
+	(*UpdateStandingsRulesResponse)(nil),     // 75: pb.UpdateStandingsRulesResponse
+	(*ScheduleSlot)(nil),                     // 76: pb.ScheduleSlot
+	(*GenerateScheduleRequest)(nil),          // 77: pb.GenerateScheduleRequest
+	(*ScheduledGame)(nil),                    // 78: pb.ScheduledGame
+	(*ScheduleBye)(nil),                      // 79: pb.ScheduleBye
+	(*GenerateScheduleResponse)(nil),         // 80: pb.GenerateScheduleResponse
+	(*CreateBracketRequest)(nil),             // 81: pb.CreateBracketRequest
+	(*GetBracketRequest)(nil),                // 82: pb.GetBracketRequest
+	(*BracketSeries)(nil),                    // 83: pb.BracketSeries
+	(*BracketRound)(nil),                     // 84: pb.BracketRound
+	(*Bracket)(nil),                          // 85: pb.Bracket
+	(*StreamGameRequest)(nil),                // 86: pb.StreamGameRequest
+	(*GameUpdate)(nil),                       // 87: pb.GameUpdate
+	(*GameEvent)(nil),                        // 88: pb.GameEvent
+	(*RecordEventRequest)(nil),               // 89: pb.RecordEventRequest
+	(*RecordEventResponse)(nil),              // 90: pb.RecordEventResponse
+	(*UndoEventRequest)(nil),                 // 91: pb.UndoEventRequest
+	(*UndoEventResponse)(nil),                // 92: pb.UndoEventResponse
+	(*ListEventsRequest)(nil),                // 93: pb.ListEventsRequest
+	(*ListEventsResponse)(nil),               // 94: pb.ListEventsResponse
+	(*Substitution)(nil),                     // 95: pb.Substitution
+	(*RecordSubstitutionRequest)(nil),        // 96: pb.RecordSubstitutionRequest
+	(*RecordSubstitutionResponse)(nil),       // 97: pb.RecordSubstitutionResponse
+	(*UndoSubstitutionRequest)(nil),          // 98: pb.UndoSubstitutionRequest
+	(*UndoSubstitutionResponse)(nil),         // 99: pb.UndoSubstitutionResponse
+	(*GetLineupStatsRequest)(nil),            // 100: pb.GetLineupStatsRequest
+	(*LineupStats)(nil),                      // 101: pb.LineupStats
+	(*PlayerPlusMinus)(nil),                  // 102: pb.PlayerPlusMinus
+	(*GetLineupStatsResponse)(nil),           // 103: pb.GetLineupStatsResponse
+	(*GetShotChartRequest)(nil),              // 104: pb.GetShotChartRequest
+	(*ShotZoneStats)(nil),                    // 105: pb.ShotZoneStats
+	(*Shot)(nil),                             // 106: pb.Shot
+	(*ShotChart)(nil),                        // 107: pb.ShotChart
+	(*GetTeamRatingsRequest)(nil),            // 108: pb.GetTeamRatingsRequest
+	(*RatingChange)(nil),                     // 109: pb.RatingChange
+	(*TeamRating)(nil),                       // 110: pb.TeamRating
+	(*GetTeamRatingsResponse)(nil),           // 111: pb.GetTeamRatingsResponse
+	(*PredictGameRequest)(nil),               // 112: pb.PredictGameRequest
+	(*GamePrediction)(nil),                   // 113: pb.GamePrediction
+	(*Award)(nil),                            // 114: pb.Award
+	(*CreateAwardRequest)(nil),               // 115: pb.CreateAwardRequest
+	(*CreateAwardResponse)(nil),              // 116: pb.CreateAwardResponse
+	(*CastAwardVotesRequest)(nil),            // 117: pb.CastAwardVotesRequest
+	(*CastAwardVotesResponse)(nil),           // 118: pb.CastAwardVotesResponse
+	(*AwardVote)(nil),                        // 119: pb.AwardVote
+	(*AwardGameResult)(nil),                  // 120: pb.AwardGameResult
+	(*GetAwardLeaderboardRequest)(nil),       // 121: pb.GetAwardLeaderboardRequest
+	(*AwardLeaderboardEntry)(nil),            // 122: pb.AwardLeaderboardEntry
+	(*AwardLeaderboard)(nil),                 // 123: pb.AwardLeaderboard
+	(*PlayerAward)(nil),                      // 124: pb.PlayerAward
+	(*RefreshViewsRequest)(nil),              // 125: pb.RefreshViewsRequest
+	(*RefreshViewsResponse)(nil),             // 126: pb.RefreshViewsResponse
+}
+var file_heroball_proto_depIdxs = []int32{
+	1,   // 0: pb.Competition.League:type_name -> pb.League
+	3,   // 1: pb.CompetitionTeam.Team:type_name -> pb.Team
+	8,   // 2: pb.Stats.Advanced:type_name -> pb.AdvancedStats
+	9,   // 3: pb.AdvancedStats.Per36:type_name -> pb.Per36Stats
+	3,   // 4: pb.PlayerGameStats.Team:type_name -> pb.Team
+	0,   // 5: pb.PlayerGameStats.Player:type_name -> pb.Player
+	7,   // 6: pb.PlayerGameStats.Stats:type_name -> pb.Stats
+	0,   // 7: pb.PlayerAggregateStats.Player:type_name -> pb.Player
+	7,   // 8: pb.PlayerAggregateStats.Stats:type_name -> pb.Stats
+	2,   // 9: pb.PlayerTeam.Competition:type_name -> pb.Competition
+	3,   // 10: pb.PlayerTeam.Team:type_name -> pb.Team
+	12,  // 11: pb.PlayerTeam.AggregateStats:type_name -> pb.PlayerAggregateStats
+	3,   // 12: pb.Game.HomeTeam:type_name -> pb.Team
+	3,   // 13: pb.Game.AwayTeam:type_name -> pb.Team
+	6,   // 14: pb.Game.Location:type_name -> pb.Location
+	2,   // 15: pb.Game.Competition:type_name -> pb.Competition
+	15,  // 16: pb.Game.Result:type_name -> pb.GameResult
+	10,  // 17: pb.PlayerInfo.Profile:type_name -> pb.PlayerProfile
+	13,  // 18: pb.PlayerInfo.Teams:type_name -> pb.PlayerTeam
+	12,  // 19: pb.PlayerInfo.AggregateStats:type_name -> pb.PlayerAggregateStats
+	31,  // 20: pb.PlayerInfo.RecentGames:type_name -> pb.GamesCursor
+	11,  // 21: pb.PlayerInfo.RecentStats:type_name -> pb.PlayerGameStats
+	17,  // 22: pb.PlayerInfo.CareerHighs:type_name -> pb.GameRecord
+	124, // 23: pb.PlayerInfo.Awards:type_name -> pb.PlayerAward
+	0,   // 24: pb.GameRecord.Player:type_name -> pb.Player
+	3,   // 25: pb.GameRecord.Team:type_name -> pb.Team
+	3,   // 26: pb.TeamInfo.Team:type_name -> pb.Team
+	2,   // 27: pb.TeamInfo.Competition:type_name -> pb.Competition
+	34,  // 28: pb.TeamInfo.Players:type_name -> pb.PlayersCursor
+	31,  // 29: pb.TeamInfo.RecentGames:type_name -> pb.GamesCursor
+	19,  // 30: pb.TeamInfo.Roster:type_name -> pb.RosterPlayer
+	0,   // 31: pb.RosterPlayer.Player:type_name -> pb.Player
+	14,  // 32: pb.GameInfo.Game:type_name -> pb.Game
+	11,  // 33: pb.GameInfo.PlayerStats:type_name -> pb.PlayerGameStats
+	21,  // 34: pb.GameInfo.PeriodScores:type_name -> pb.PeriodScore
+	22,  // 35: pb.GameInfo.Flow:type_name -> pb.GameFlow
+	120, // 36: pb.GameInfo.Awards:type_name -> pb.AwardGameResult
+	2,   // 37: pb.CompetitionInfo.Competition:type_name -> pb.Competition
+	31,  // 38: pb.CompetitionInfo.RecentGames:type_name -> pb.GamesCursor
+	6,   // 39: pb.CompetitionInfo.Locations:type_name -> pb.Location
+	4,   // 40: pb.CompetitionInfo.Teams:type_name -> pb.CompetitionTeam
+	5,   // 41: pb.CompetitionInfo.StandingsRules:type_name -> pb.StandingsRules
+	114, // 42: pb.CompetitionInfo.Awards:type_name -> pb.Award
+	29,  // 43: pb.GetGamesRequest.Filter:type_name -> pb.GamesFilter
+	30,  // 44: pb.GamesFilter.Date:type_name -> pb.Date
+	14,  // 45: pb.GamesCursor.Games:type_name -> pb.Game
+	29,  // 46: pb.GamesCursor.Filter:type_name -> pb.GamesFilter
+	33,  // 47: pb.GetPlayersRequest.Filter:type_name -> pb.PlayersFilter
+	0,   // 48: pb.PlayersCursor.Players:type_name -> pb.Player
+	33,  // 49: pb.PlayersCursor.Filter:type_name -> pb.PlayersFilter
+	2,   // 50: pb.HeroBallMetadata.Competitions:type_name -> pb.Competition
+	3,   // 51: pb.HeroBallMetadata.Teams:type_name -> pb.Team
+	0,   // 52: pb.HeroBallMetadata.Players:type_name -> pb.Player
+	37,  // 53: pb.GetPlayerAverageStatsRequest.For:type_name -> pb.ForStatsRequest
+	38,  // 54: pb.GetPlayerAverageStatsRequest.Against:type_name -> pb.AgainstStatsRequest
+	12,  // 55: pb.GetPlayerAverageStatsResponse.AggregateStats:type_name -> pb.PlayerAggregateStats
+	3,   // 56: pb.TeamAggregateStats.Team:type_name -> pb.Team
+	7,   // 57: pb.TeamAggregateStats.Stats:type_name -> pb.Stats
+	7,   // 58: pb.TeamAggregateStats.OpponentStats:type_name -> pb.Stats
+	37,  // 59: pb.GetTeamAverageStatsRequest.For:type_name -> pb.ForStatsRequest
+	38,  // 60: pb.GetTeamAverageStatsRequest.Against:type_name -> pb.AgainstStatsRequest
+	41,  // 61: pb.GetTeamAverageStatsResponse.AggregateStats:type_name -> pb.TeamAggregateStats
+	7,   // 62: pb.MonthSplit.Stats:type_name -> pb.Stats
+	6,   // 63: pb.LocationSplit.Location:type_name -> pb.Location
+	7,   // 64: pb.LocationSplit.Stats:type_name -> pb.Stats
+	3,   // 65: pb.OpponentSplit.Opponent:type_name -> pb.Team
+	7,   // 66: pb.OpponentSplit.Stats:type_name -> pb.Stats
+	0,   // 67: pb.PlayerSplits.Player:type_name -> pb.Player
+	7,   // 68: pb.PlayerSplits.Home:type_name -> pb.Stats
+	7,   // 69: pb.PlayerSplits.Away:type_name -> pb.Stats
+	7,   // 70: pb.PlayerSplits.Wins:type_name -> pb.Stats
+	7,   // 71: pb.PlayerSplits.Losses:type_name -> pb.Stats
+	7,   // 72: pb.PlayerSplits.Draws:type_name -> pb.Stats
+	45,  // 73: pb.PlayerSplits.Months:type_name -> pb.MonthSplit
+	46,  // 74: pb.PlayerSplits.Locations:type_name -> pb.LocationSplit
+	47,  // 75: pb.PlayerSplits.Opponents:type_name -> pb.OpponentSplit
+	17,  // 76: pb.GameRecords.Records:type_name -> pb.GameRecord
+	0,   // 77: pb.SeasonRecord.Player:type_name -> pb.Player
+	2,   // 78: pb.SeasonRecord.Competition:type_name -> pb.Competition
+	51,  // 79: pb.SeasonRecords.Records:type_name -> pb.SeasonRecord
+	50,  // 80: pb.RecordsBook.GameRecords:type_name -> pb.GameRecords
+	52,  // 81: pb.RecordsBook.SeasonRecords:type_name -> pb.SeasonRecords
+	31,  // 82: pb.HeadToHead.Meetings:type_name -> pb.GamesCursor
+	41,  // 83: pb.HeadToHead.TeamStats:type_name -> pb.TeamAggregateStats
+	12,  // 84: pb.HeadToHead.PlayerStats:type_name -> pb.PlayerAggregateStats
+	12,  // 85: pb.HeadToHead.OpponentPlayerStats:type_name -> pb.PlayerAggregateStats
+	12,  // 86: pb.HeadToHead.TopPerformers:type_name -> pb.PlayerAggregateStats
+	38,  // 87: pb.GetPlayerGamesStatsRequest.Against:type_name -> pb.AgainstStatsRequest
+	14,  // 88: pb.GetPlayerGamesStatsResponse.Games:type_name -> pb.Game
+	11,  // 89: pb.GetPlayerGamesStatsResponse.Stats:type_name -> pb.PlayerGameStats
+	7,   // 90: pb.PlayerGameStatsEntry.Stats:type_name -> pb.Stats
+	58,  // 91: pb.CreateGameRequest.Stats:type_name -> pb.PlayerGameStatsEntry
+	58,  // 92: pb.UpdateGameRequest.Stats:type_name -> pb.PlayerGameStatsEntry
+	58,  // 93: pb.UpsertPlayerGameStatsRequest.Stats:type_name -> pb.PlayerGameStatsEntry
+	67,  // 94: pb.UpdateCompetitionRosterRequest.Players:type_name -> pb.RosterEntry
+	21,  // 95: pb.UpdateGamePeriodScoresRequest.PeriodScores:type_name -> pb.PeriodScore
+	5,   // 96: pb.UpdateStandingsRulesRequest.Rules:type_name -> pb.StandingsRules
+	76,  // 97: pb.GenerateScheduleRequest.Slots:type_name -> pb.ScheduleSlot
+	78,  // 98: pb.GenerateScheduleResponse.Games:type_name -> pb.ScheduledGame
+	79,  // 99: pb.GenerateScheduleResponse.Byes:type_name -> pb.ScheduleBye
+	3,   // 100: pb.BracketSeries.HigherSeed:type_name -> pb.Team
+	3,   // 101: pb.BracketSeries.LowerSeed:type_name -> pb.Team
+	83,  // 102: pb.BracketRound.Series:type_name -> pb.BracketSeries
+	84,  // 103: pb.Bracket.Rounds:type_name -> pb.BracketRound
+	14,  // 104: pb.GameUpdate.Game:type_name -> pb.Game
+	11,  // 105: pb.GameUpdate.PlayerStats:type_name -> pb.PlayerGameStats
+	88,  // 106: pb.RecordEventRequest.Event:type_name -> pb.GameEvent
+	11,  // 107: pb.RecordEventResponse.Stats:type_name -> pb.PlayerGameStats
+	11,  // 108: pb.UndoEventResponse.Stats:type_name -> pb.PlayerGameStats
+	88,  // 109: pb.ListEventsResponse.Events:type_name -> pb.GameEvent
+	95,  // 110: pb.RecordSubstitutionRequest.Substitution:type_name -> pb.Substitution
+	0,   // 111: pb.LineupStats.Players:type_name -> pb.Player
+	0,   // 112: pb.PlayerPlusMinus.Player:type_name -> pb.Player
+	101, // 113: pb.GetLineupStatsResponse.Lineups:type_name -> pb.LineupStats
+	102, // 114: pb.GetLineupStatsResponse.PlusMinus:type_name -> pb.PlayerPlusMinus
+	0,   // 115: pb.ShotChart.Player:type_name -> pb.Player
+	3,   // 116: pb.ShotChart.Team:type_name -> pb.Team
+	105, // 117: pb.ShotChart.Zones:type_name -> pb.ShotZoneStats
+	106, // 118: pb.ShotChart.Shots:type_name -> pb.Shot
+	3,   // 119: pb.RatingChange.Opponent:type_name -> pb.Team
+	3,   // 120: pb.TeamRating.Team:type_name -> pb.Team
+	109, // 121: pb.TeamRating.History:type_name -> pb.RatingChange
+	110, // 122: pb.GetTeamRatingsResponse.Ratings:type_name -> pb.TeamRating
+	114, // 123: pb.CreateAwardRequest.Award:type_name -> pb.Award
+	120, // 124: pb.CastAwardVotesResponse.Result:type_name -> pb.AwardGameResult
+	0,   // 125: pb.AwardVote.Player:type_name -> pb.Player
+	114, // 126: pb.AwardGameResult.Award:type_name -> pb.Award
+	119, // 127: pb.AwardGameResult.Votes:type_name -> pb.AwardVote
+	0,   // 128: pb.AwardGameResult.Winners:type_name -> pb.Player
+	0,   // 129: pb.AwardLeaderboardEntry.Player:type_name -> pb.Player
+	114, // 130: pb.AwardLeaderboard.Award:type_name -> pb.Award
+	122, // 131: pb.AwardLeaderboard.Entries:type_name -> pb.AwardLeaderboardEntry
+	114, // 132: pb.PlayerAward.Award:type_name -> pb.Award
+	122, // 133: pb.PlayerAward.Standing:type_name -> pb.AwardLeaderboardEntry
+	59,  // 134: pb.HeroBallService.CreateGame:input_type -> pb.CreateGameRequest
+	61,  // 135: pb.HeroBallService.UpdateGame:input_type -> pb.UpdateGameRequest
+	63,  // 136: pb.HeroBallService.DeleteGame:input_type -> pb.DeleteGameRequest
+	65,  // 137: pb.HeroBallService.UpsertPlayerGameStats:input_type -> pb.UpsertPlayerGameStatsRequest
+	68,  // 138: pb.HeroBallService.UpdateCompetitionRoster:input_type -> pb.UpdateCompetitionRosterRequest
+	77,  // 139: pb.HeroBallService.GenerateSchedule:input_type -> pb.GenerateScheduleRequest
+	81,  // 140: pb.HeroBallService.CreateBracket:input_type -> pb.CreateBracketRequest
+	82,  // 141: pb.HeroBallService.GetBracket:input_type -> pb.GetBracketRequest
+	70,  // 142: pb.HeroBallService.UpdateGamePeriodScores:input_type -> pb.UpdateGamePeriodScoresRequest
+	72,  // 143: pb.HeroBallService.UpdateCompetitionPeriods:input_type -> pb.UpdateCompetitionPeriodsRequest
+	74,  // 144: pb.HeroBallService.UpdateStandingsRules:input_type -> pb.UpdateStandingsRulesRequest
+	89,  // 145: pb.HeroBallService.RecordEvent:input_type -> pb.RecordEventRequest
+	91,  // 146: pb.HeroBallService.UndoEvent:input_type -> pb.UndoEventRequest
+	93,  // 147: pb.HeroBallService.ListEvents:input_type -> pb.ListEventsRequest
+	96,  // 148: pb.HeroBallService.RecordSubstitution:input_type -> pb.RecordSubstitutionRequest
+	98,  // 149: pb.HeroBallService.UndoSubstitution:input_type -> pb.UndoSubstitutionRequest
+	100, // 150: pb.HeroBallService.GetLineupStats:input_type -> pb.GetLineupStatsRequest
+	104, // 151: pb.HeroBallService.GetShotChart:input_type -> pb.GetShotChartRequest
+	108, // 152: pb.HeroBallService.GetTeamRatings:input_type -> pb.GetTeamRatingsRequest
+	112, // 153: pb.HeroBallService.PredictGame:input_type -> pb.PredictGameRequest
+	115, // 154: pb.HeroBallService.CreateAward:input_type -> pb.CreateAwardRequest
+	117, // 155: pb.HeroBallService.CastAwardVotes:input_type -> pb.CastAwardVotesRequest
+	121, // 156: pb.HeroBallService.GetAwardLeaderboard:input_type -> pb.GetAwardLeaderboardRequest
+	56,  // 157: pb.HeroBallService.GetPlayerGamesStats:input_type -> pb.GetPlayerGamesStatsRequest
+	39,  // 158: pb.HeroBallService.GetPlayerAverageStats:input_type -> pb.GetPlayerAverageStatsRequest
+	42,  // 159: pb.HeroBallService.GetTeamAverageStats:input_type -> pb.GetTeamAverageStatsRequest
+	44,  // 160: pb.HeroBallService.GetPlayerSplits:input_type -> pb.GetPlayerSplitsRequest
+	49,  // 161: pb.HeroBallService.GetRecordsBook:input_type -> pb.GetRecordsBookRequest
+	54,  // 162: pb.HeroBallService.GetHeadToHead:input_type -> pb.GetHeadToHeadRequest
+	35,  // 163: pb.HeroBallService.GetHeroBallMetadata:input_type -> pb.GetHeroBallMetadataRequest
+	28,  // 164: pb.HeroBallService.GetGames:input_type -> pb.GetGamesRequest
+	32,  // 165: pb.HeroBallService.GetPlayers:input_type -> pb.GetPlayersRequest
+	24,  // 166: pb.HeroBallService.GetPlayerInfo:input_type -> pb.GetPlayerInfoRequest
+	26,  // 167: pb.HeroBallService.GetTeamInfo:input_type -> pb.GetTeamInfoRequest
+	25,  // 168: pb.HeroBallService.GetGameInfo:input_type -> pb.GetGameInfoRequest
+	27,  // 169: pb.HeroBallService.GetCompetitionInfo:input_type -> pb.GetCompetitionInfoRequest
+	86,  // 170: pb.HeroBallService.StreamGame:input_type -> pb.StreamGameRequest
+	125, // 171: pb.HeroBallService.RefreshViews:input_type -> pb.RefreshViewsRequest
+	60,  // 172: pb.HeroBallService.CreateGame:output_type -> pb.CreateGameResponse
+	62,  // 173: pb.HeroBallService.UpdateGame:output_type -> pb.UpdateGameResponse
+	64,  // 174: pb.HeroBallService.DeleteGame:output_type -> pb.DeleteGameResponse
+	66,  // 175: pb.HeroBallService.UpsertPlayerGameStats:output_type -> pb.UpsertPlayerGameStatsResponse
+	69,  // 176: pb.HeroBallService.UpdateCompetitionRoster:output_type -> pb.UpdateCompetitionRosterResponse
+	80,  // 177: pb.HeroBallService.GenerateSchedule:output_type -> pb.GenerateScheduleResponse
+	85,  // 178: pb.HeroBallService.CreateBracket:output_type -> pb.Bracket
+	85,  // 179: pb.HeroBallService.GetBracket:output_type -> pb.Bracket
+	71,  // 180: pb.HeroBallService.UpdateGamePeriodScores:output_type -> pb.UpdateGamePeriodScoresResponse
+	73,  // 181: pb.HeroBallService.UpdateCompetitionPeriods:output_type -> pb.UpdateCompetitionPeriodsResponse
+	75,  // 182: pb.HeroBallService.UpdateStandingsRules:output_type -> pb.UpdateStandingsRulesResponse
+	90,  // 183: pb.HeroBallService.RecordEvent:output_type -> pb.RecordEventResponse
+	92,  // 184: pb.HeroBallService.UndoEvent:output_type -> pb.UndoEventResponse
+	94,  // 185: pb.HeroBallService.ListEvents:output_type -> pb.ListEventsResponse
+	97,  // 186: pb.HeroBallService.RecordSubstitution:output_type -> pb.RecordSubstitutionResponse
+	99,  // 187: pb.HeroBallService.UndoSubstitution:output_type -> pb.UndoSubstitutionResponse
+	103, // 188: pb.HeroBallService.GetLineupStats:output_type -> pb.GetLineupStatsResponse
+	107, // 189: pb.HeroBallService.GetShotChart:output_type -> pb.ShotChart
+	111, // 190: pb.HeroBallService.GetTeamRatings:output_type -> pb.GetTeamRatingsResponse
+	113, // 191: pb.HeroBallService.PredictGame:output_type -> pb.GamePrediction
+	116, // 192: pb.HeroBallService.CreateAward:output_type -> pb.CreateAwardResponse
+	118, // 193: pb.HeroBallService.CastAwardVotes:output_type -> pb.CastAwardVotesResponse
+	123, // 194: pb.HeroBallService.GetAwardLeaderboard:output_type -> pb.AwardLeaderboard
+	57,  // 195: pb.HeroBallService.GetPlayerGamesStats:output_type -> pb.GetPlayerGamesStatsResponse
+	40,  // 196: pb.HeroBallService.GetPlayerAverageStats:output_type -> pb.GetPlayerAverageStatsResponse
+	43,  // 197: pb.HeroBallService.GetTeamAverageStats:output_type -> pb.GetTeamAverageStatsResponse
+	48,  // 198: pb.HeroBallService.GetPlayerSplits:output_type -> pb.PlayerSplits
+	53,  // 199: pb.HeroBallService.GetRecordsBook:output_type -> pb.RecordsBook
+	55,  // 200: pb.HeroBallService.GetHeadToHead:output_type -> pb.HeadToHead
+	36,  // 201: pb.HeroBallService.GetHeroBallMetadata:output_type -> pb.HeroBallMetadata
+	31,  // 202: pb.HeroBallService.GetGames:output_type -> pb.GamesCursor
+	34,  // 203: pb.HeroBallService.GetPlayers:output_type -> pb.PlayersCursor
+	16,  // 204: pb.HeroBallService.GetPlayerInfo:output_type -> pb.PlayerInfo
+	18,  // 205: pb.HeroBallService.GetTeamInfo:output_type -> pb.TeamInfo
+	20,  // 206: pb.HeroBallService.GetGameInfo:output_type -> pb.GameInfo
+	23,  // 207: pb.HeroBallService.GetCompetitionInfo:output_type -> pb.CompetitionInfo
+	87,  // 208: pb.HeroBallService.StreamGame:output_type -> pb.GameUpdate
+	126, // 209: pb.HeroBallService.RefreshViews:output_type -> pb.RefreshViewsResponse
+	172, // [172:210] is the sub-list for method output_type
+	134, // [134:172] is the sub-list for method input_type
+	134, // [134:134] is the sub-list for extension type_name
+	134, // [134:134] is the sub-list for extension extendee
+	0,   // [0:134] is the sub-list for field type_name
+}
+
+func init() { file_heroball_proto_init() }
+func file_heroball_proto_init() {
+	if File_heroball_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_heroball_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*League); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Competition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompetitionTeam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandingsRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdvancedStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Per36Stats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerGameStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerAggregateStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerTeam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Game); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RosterPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeriodScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameFlow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompetitionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTeamInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompetitionInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GamesFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GamesCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayersFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayersCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeroBallMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeroBallMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgainstStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerAverageStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerAverageStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamAggregateStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTeamAverageStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTeamAverageStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerSplitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonthSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpponentSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerSplits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecordsBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeasonRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeasonRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordsBook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeadToHeadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadToHead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerGamesStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerGamesStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerGameStatsEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertPlayerGameStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertPlayerGameStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RosterEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCompetitionRosterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCompetitionRosterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGamePeriodScoresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGamePeriodScoresResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCompetitionPeriodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCompetitionPeriodsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStandingsRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStandingsRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleBye); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBracketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBracketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BracketSeries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BracketRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bracket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Substitution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordSubstitutionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordSubstitutionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoSubstitutionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoSubstitutionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLineupStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LineupStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerPlusMinus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLineupStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShotChartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShotZoneStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShotChart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTeamRatingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatingChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamRating); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTeamRatingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PredictGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GamePrediction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Award); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAwardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAwardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastAwardVotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastAwardVotesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwardVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwardGameResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAwardLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwardLeaderboardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwardLeaderboard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerAward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshViewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_heroball_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshViewsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_heroball_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   127,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_heroball_proto_goTypes,
+		DependencyIndexes: file_heroball_proto_depIdxs,
+		MessageInfos:      file_heroball_proto_msgTypes,
+	}.Build()
+	File_heroball_proto = out.File
+	file_heroball_proto_rawDesc = nil
+	file_heroball_proto_goTypes = nil
+	file_heroball_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// HeroBallServiceClient is the client API for HeroBallService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HeroBallServiceClient interface {
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
+	UpdateGame(ctx context.Context, in *UpdateGameRequest, opts ...grpc.CallOption) (*UpdateGameResponse, error)
+	DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error)
+	UpsertPlayerGameStats(ctx context.Context, in *UpsertPlayerGameStatsRequest, opts ...grpc.CallOption) (*UpsertPlayerGameStatsResponse, error)
+	UpdateCompetitionRoster(ctx context.Context, in *UpdateCompetitionRosterRequest, opts ...grpc.CallOption) (*UpdateCompetitionRosterResponse, error)
+	GenerateSchedule(ctx context.Context, in *GenerateScheduleRequest, opts ...grpc.CallOption) (*GenerateScheduleResponse, error)
+	CreateBracket(ctx context.Context, in *CreateBracketRequest, opts ...grpc.CallOption) (*Bracket, error)
+	GetBracket(ctx context.Context, in *GetBracketRequest, opts ...grpc.CallOption) (*Bracket, error)
+	UpdateGamePeriodScores(ctx context.Context, in *UpdateGamePeriodScoresRequest, opts ...grpc.CallOption) (*UpdateGamePeriodScoresResponse, error)
+	UpdateCompetitionPeriods(ctx context.Context, in *UpdateCompetitionPeriodsRequest, opts ...grpc.CallOption) (*UpdateCompetitionPeriodsResponse, error)
+	UpdateStandingsRules(ctx context.Context, in *UpdateStandingsRulesRequest, opts ...grpc.CallOption) (*UpdateStandingsRulesResponse, error)
+	RecordEvent(ctx context.Context, in *RecordEventRequest, opts ...grpc.CallOption) (*RecordEventResponse, error)
+	UndoEvent(ctx context.Context, in *UndoEventRequest, opts ...grpc.CallOption) (*UndoEventResponse, error)
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	RecordSubstitution(ctx context.Context, in *RecordSubstitutionRequest, opts ...grpc.CallOption) (*RecordSubstitutionResponse, error)
+	UndoSubstitution(ctx context.Context, in *UndoSubstitutionRequest, opts ...grpc.CallOption) (*UndoSubstitutionResponse, error)
+	GetLineupStats(ctx context.Context, in *GetLineupStatsRequest, opts ...grpc.CallOption) (*GetLineupStatsResponse, error)
+	GetShotChart(ctx context.Context, in *GetShotChartRequest, opts ...grpc.CallOption) (*ShotChart, error)
+	GetTeamRatings(ctx context.Context, in *GetTeamRatingsRequest, opts ...grpc.CallOption) (*GetTeamRatingsResponse, error)
+	PredictGame(ctx context.Context, in *PredictGameRequest, opts ...grpc.CallOption) (*GamePrediction, error)
+	CreateAward(ctx context.Context, in *CreateAwardRequest, opts ...grpc.CallOption) (*CreateAwardResponse, error)
+	CastAwardVotes(ctx context.Context, in *CastAwardVotesRequest, opts ...grpc.CallOption) (*CastAwardVotesResponse, error)
+	GetAwardLeaderboard(ctx context.Context, in *GetAwardLeaderboardRequest, opts ...grpc.CallOption) (*AwardLeaderboard, error)
+	GetPlayerGamesStats(ctx context.Context, in *GetPlayerGamesStatsRequest, opts ...grpc.CallOption) (*GetPlayerGamesStatsResponse, error)
+	GetPlayerAverageStats(ctx context.Context, in *GetPlayerAverageStatsRequest, opts ...grpc.CallOption) (*GetPlayerAverageStatsResponse, error)
+	GetTeamAverageStats(ctx context.Context, in *GetTeamAverageStatsRequest, opts ...grpc.CallOption) (*GetTeamAverageStatsResponse, error)
+	GetPlayerSplits(ctx context.Context, in *GetPlayerSplitsRequest, opts ...grpc.CallOption) (*PlayerSplits, error)
+	GetRecordsBook(ctx context.Context, in *GetRecordsBookRequest, opts ...grpc.CallOption) (*RecordsBook, error)
+	GetHeadToHead(ctx context.Context, in *GetHeadToHeadRequest, opts ...grpc.CallOption) (*HeadToHead, error)
+	GetHeroBallMetadata(ctx context.Context, in *GetHeroBallMetadataRequest, opts ...grpc.CallOption) (*HeroBallMetadata, error)
+	GetGames(ctx context.Context, in *GetGamesRequest, opts ...grpc.CallOption) (*GamesCursor, error)
+	GetPlayers(ctx context.Context, in *GetPlayersRequest, opts ...grpc.CallOption) (*PlayersCursor, error)
+	GetPlayerInfo(ctx context.Context, in *GetPlayerInfoRequest, opts ...grpc.CallOption) (*PlayerInfo, error)
+	GetTeamInfo(ctx context.Context, in *GetTeamInfoRequest, opts ...grpc.CallOption) (*TeamInfo, error)
+	GetGameInfo(ctx context.Context, in *GetGameInfoRequest, opts ...grpc.CallOption) (*GameInfo, error)
+	GetCompetitionInfo(ctx context.Context, in *GetCompetitionInfoRequest, opts ...grpc.CallOption) (*CompetitionInfo, error)
+	StreamGame(ctx context.Context, in *StreamGameRequest, opts ...grpc.CallOption) (HeroBallService_StreamGameClient, error)
+	RefreshViews(ctx context.Context, in *RefreshViewsRequest, opts ...grpc.CallOption) (*RefreshViewsResponse, error)
+}
+
+type heroBallServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHeroBallServiceClient(cc grpc.ClientConnInterface) HeroBallServiceClient {
+	return &heroBallServiceClient{cc}
+}
+
+func (c *heroBallServiceClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error) {
+	out := new(CreateGameResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/CreateGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) UpdateGame(ctx context.Context, in *UpdateGameRequest, opts ...grpc.CallOption) (*UpdateGameResponse, error) {
+	out := new(UpdateGameResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/UpdateGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) DeleteGame(ctx context.Context, in *DeleteGameRequest, opts ...grpc.CallOption) (*DeleteGameResponse, error) {
+	out := new(DeleteGameResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/DeleteGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) UpsertPlayerGameStats(ctx context.Context, in *UpsertPlayerGameStatsRequest, opts ...grpc.CallOption) (*UpsertPlayerGameStatsResponse, error) {
+	out := new(UpsertPlayerGameStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/UpsertPlayerGameStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) UpdateCompetitionRoster(ctx context.Context, in *UpdateCompetitionRosterRequest, opts ...grpc.CallOption) (*UpdateCompetitionRosterResponse, error) {
+	out := new(UpdateCompetitionRosterResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/UpdateCompetitionRoster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GenerateSchedule(ctx context.Context, in *GenerateScheduleRequest, opts ...grpc.CallOption) (*GenerateScheduleResponse, error) {
+	out := new(GenerateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GenerateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) CreateBracket(ctx context.Context, in *CreateBracketRequest, opts ...grpc.CallOption) (*Bracket, error) {
+	out := new(Bracket)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/CreateBracket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetBracket(ctx context.Context, in *GetBracketRequest, opts ...grpc.CallOption) (*Bracket, error) {
+	out := new(Bracket)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetBracket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) UpdateGamePeriodScores(ctx context.Context, in *UpdateGamePeriodScoresRequest, opts ...grpc.CallOption) (*UpdateGamePeriodScoresResponse, error) {
+	out := new(UpdateGamePeriodScoresResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/UpdateGamePeriodScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) UpdateCompetitionPeriods(ctx context.Context, in *UpdateCompetitionPeriodsRequest, opts ...grpc.CallOption) (*UpdateCompetitionPeriodsResponse, error) {
+	out := new(UpdateCompetitionPeriodsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/UpdateCompetitionPeriods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) UpdateStandingsRules(ctx context.Context, in *UpdateStandingsRulesRequest, opts ...grpc.CallOption) (*UpdateStandingsRulesResponse, error) {
+	out := new(UpdateStandingsRulesResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/UpdateStandingsRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) RecordEvent(ctx context.Context, in *RecordEventRequest, opts ...grpc.CallOption) (*RecordEventResponse, error) {
+	out := new(RecordEventResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/RecordEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) UndoEvent(ctx context.Context, in *UndoEventRequest, opts ...grpc.CallOption) (*UndoEventResponse, error) {
+	out := new(UndoEventResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/UndoEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) RecordSubstitution(ctx context.Context, in *RecordSubstitutionRequest, opts ...grpc.CallOption) (*RecordSubstitutionResponse, error) {
+	out := new(RecordSubstitutionResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/RecordSubstitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) UndoSubstitution(ctx context.Context, in *UndoSubstitutionRequest, opts ...grpc.CallOption) (*UndoSubstitutionResponse, error) {
+	out := new(UndoSubstitutionResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/UndoSubstitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetLineupStats(ctx context.Context, in *GetLineupStatsRequest, opts ...grpc.CallOption) (*GetLineupStatsResponse, error) {
+	out := new(GetLineupStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetLineupStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetShotChart(ctx context.Context, in *GetShotChartRequest, opts ...grpc.CallOption) (*ShotChart, error) {
+	out := new(ShotChart)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetShotChart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetTeamRatings(ctx context.Context, in *GetTeamRatingsRequest, opts ...grpc.CallOption) (*GetTeamRatingsResponse, error) {
+	out := new(GetTeamRatingsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetTeamRatings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) PredictGame(ctx context.Context, in *PredictGameRequest, opts ...grpc.CallOption) (*GamePrediction, error) {
+	out := new(GamePrediction)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/PredictGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) CreateAward(ctx context.Context, in *CreateAwardRequest, opts ...grpc.CallOption) (*CreateAwardResponse, error) {
+	out := new(CreateAwardResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/CreateAward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) CastAwardVotes(ctx context.Context, in *CastAwardVotesRequest, opts ...grpc.CallOption) (*CastAwardVotesResponse, error) {
+	out := new(CastAwardVotesResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/CastAwardVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetAwardLeaderboard(ctx context.Context, in *GetAwardLeaderboardRequest, opts ...grpc.CallOption) (*AwardLeaderboard, error) {
+	out := new(AwardLeaderboard)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetAwardLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetPlayerGamesStats(ctx context.Context, in *GetPlayerGamesStatsRequest, opts ...grpc.CallOption) (*GetPlayerGamesStatsResponse, error) {
+	out := new(GetPlayerGamesStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetPlayerGamesStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetPlayerAverageStats(ctx context.Context, in *GetPlayerAverageStatsRequest, opts ...grpc.CallOption) (*GetPlayerAverageStatsResponse, error) {
+	out := new(GetPlayerAverageStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetPlayerAverageStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetTeamAverageStats(ctx context.Context, in *GetTeamAverageStatsRequest, opts ...grpc.CallOption) (*GetTeamAverageStatsResponse, error) {
+	out := new(GetTeamAverageStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetTeamAverageStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetPlayerSplits(ctx context.Context, in *GetPlayerSplitsRequest, opts ...grpc.CallOption) (*PlayerSplits, error) {
+	out := new(PlayerSplits)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetPlayerSplits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetRecordsBook(ctx context.Context, in *GetRecordsBookRequest, opts ...grpc.CallOption) (*RecordsBook, error) {
+	out := new(RecordsBook)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetRecordsBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetHeadToHead(ctx context.Context, in *GetHeadToHeadRequest, opts ...grpc.CallOption) (*HeadToHead, error) {
+	out := new(HeadToHead)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetHeadToHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetHeroBallMetadata(ctx context.Context, in *GetHeroBallMetadataRequest, opts ...grpc.CallOption) (*HeroBallMetadata, error) {
+	out := new(HeroBallMetadata)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetHeroBallMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetGames(ctx context.Context, in *GetGamesRequest, opts ...grpc.CallOption) (*GamesCursor, error) {
+	out := new(GamesCursor)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetPlayers(ctx context.Context, in *GetPlayersRequest, opts ...grpc.CallOption) (*PlayersCursor, error) {
+	out := new(PlayersCursor)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetPlayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetPlayerInfo(ctx context.Context, in *GetPlayerInfoRequest, opts ...grpc.CallOption) (*PlayerInfo, error) {
+	out := new(PlayerInfo)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetPlayerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetTeamInfo(ctx context.Context, in *GetTeamInfoRequest, opts ...grpc.CallOption) (*TeamInfo, error) {
+	out := new(TeamInfo)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetTeamInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetGameInfo(ctx context.Context, in *GetGameInfoRequest, opts ...grpc.CallOption) (*GameInfo, error) {
+	out := new(GameInfo)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetGameInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) GetCompetitionInfo(ctx context.Context, in *GetCompetitionInfoRequest, opts ...grpc.CallOption) (*CompetitionInfo, error) {
+	out := new(CompetitionInfo)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/GetCompetitionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heroBallServiceClient) StreamGame(ctx context.Context, in *StreamGameRequest, opts ...grpc.CallOption) (HeroBallService_StreamGameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_HeroBallService_serviceDesc.Streams[0], "/pb.HeroBallService/StreamGame", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &heroBallServiceStreamGameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HeroBallService_StreamGameClient interface {
+	Recv() (*GameUpdate, error)
+	grpc.ClientStream
+}
+
+type heroBallServiceStreamGameClient struct {
+	grpc.ClientStream
+}
+
+func (x *heroBallServiceStreamGameClient) Recv() (*GameUpdate, error) {
+	m := new(GameUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *heroBallServiceClient) RefreshViews(ctx context.Context, in *RefreshViewsRequest, opts ...grpc.CallOption) (*RefreshViewsResponse, error) {
+	out := new(RefreshViewsResponse)
+	err := c.cc.Invoke(ctx, "/pb.HeroBallService/RefreshViews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HeroBallServiceServer is the server API for HeroBallService service.
+type HeroBallServiceServer interface {
+	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
+	UpdateGame(context.Context, *UpdateGameRequest) (*UpdateGameResponse, error)
+	DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error)
+	UpsertPlayerGameStats(context.Context, *UpsertPlayerGameStatsRequest) (*UpsertPlayerGameStatsResponse, error)
+	UpdateCompetitionRoster(context.Context, *UpdateCompetitionRosterRequest) (*UpdateCompetitionRosterResponse, error)
+	GenerateSchedule(context.Context, *GenerateScheduleRequest) (*GenerateScheduleResponse, error)
+	CreateBracket(context.Context, *CreateBracketRequest) (*Bracket, error)
+	GetBracket(context.Context, *GetBracketRequest) (*Bracket, error)
+	UpdateGamePeriodScores(context.Context, *UpdateGamePeriodScoresRequest) (*UpdateGamePeriodScoresResponse, error)
+	UpdateCompetitionPeriods(context.Context, *UpdateCompetitionPeriodsRequest) (*UpdateCompetitionPeriodsResponse, error)
+	UpdateStandingsRules(context.Context, *UpdateStandingsRulesRequest) (*UpdateStandingsRulesResponse, error)
+	RecordEvent(context.Context, *RecordEventRequest) (*RecordEventResponse, error)
+	UndoEvent(context.Context, *UndoEventRequest) (*UndoEventResponse, error)
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	RecordSubstitution(context.Context, *RecordSubstitutionRequest) (*RecordSubstitutionResponse, error)
+	UndoSubstitution(context.Context, *UndoSubstitutionRequest) (*UndoSubstitutionResponse, error)
+	GetLineupStats(context.Context, *GetLineupStatsRequest) (*GetLineupStatsResponse, error)
+	GetShotChart(context.Context, *GetShotChartRequest) (*ShotChart, error)
+	GetTeamRatings(context.Context, *GetTeamRatingsRequest) (*GetTeamRatingsResponse, error)
+	PredictGame(context.Context, *PredictGameRequest) (*GamePrediction, error)
+	CreateAward(context.Context, *CreateAwardRequest) (*CreateAwardResponse, error)
+	CastAwardVotes(context.Context, *CastAwardVotesRequest) (*CastAwardVotesResponse, error)
+	GetAwardLeaderboard(context.Context, *GetAwardLeaderboardRequest) (*AwardLeaderboard, error)
+	GetPlayerGamesStats(context.Context, *GetPlayerGamesStatsRequest) (*GetPlayerGamesStatsResponse, error)
+	GetPlayerAverageStats(context.Context, *GetPlayerAverageStatsRequest) (*GetPlayerAverageStatsResponse, error)
+	GetTeamAverageStats(context.Context, *GetTeamAverageStatsRequest) (*GetTeamAverageStatsResponse, error)
+	GetPlayerSplits(context.Context, *GetPlayerSplitsRequest) (*PlayerSplits, error)
+	GetRecordsBook(context.Context, *GetRecordsBookRequest) (*RecordsBook, error)
+	GetHeadToHead(context.Context, *GetHeadToHeadRequest) (*HeadToHead, error)
+	GetHeroBallMetadata(context.Context, *GetHeroBallMetadataRequest) (*HeroBallMetadata, error)
+	GetGames(context.Context, *GetGamesRequest) (*GamesCursor, error)
+	GetPlayers(context.Context, *GetPlayersRequest) (*PlayersCursor, error)
+	GetPlayerInfo(context.Context, *GetPlayerInfoRequest) (*PlayerInfo, error)
+	GetTeamInfo(context.Context, *GetTeamInfoRequest) (*TeamInfo, error)
+	GetGameInfo(context.Context, *GetGameInfoRequest) (*GameInfo, error)
+	GetCompetitionInfo(context.Context, *GetCompetitionInfoRequest) (*CompetitionInfo, error)
+	StreamGame(*StreamGameRequest, HeroBallService_StreamGameServer) error
+	RefreshViews(context.Context, *RefreshViewsRequest) (*RefreshViewsResponse, error)
+}
+
+// UnimplementedHeroBallServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedHeroBallServiceServer struct {
+}
+
+func (*UnimplementedHeroBallServiceServer) CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) UpdateGame(context.Context, *UpdateGameRequest) (*UpdateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGame not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) DeleteGame(context.Context, *DeleteGameRequest) (*DeleteGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGame not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) UpsertPlayerGameStats(context.Context, *UpsertPlayerGameStatsRequest) (*UpsertPlayerGameStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPlayerGameStats not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) UpdateCompetitionRoster(context.Context, *UpdateCompetitionRosterRequest) (*UpdateCompetitionRosterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompetitionRoster not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GenerateSchedule(context.Context, *GenerateScheduleRequest) (*GenerateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSchedule not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) CreateBracket(context.Context, *CreateBracketRequest) (*Bracket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBracket not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetBracket(context.Context, *GetBracketRequest) (*Bracket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBracket not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) UpdateGamePeriodScores(context.Context, *UpdateGamePeriodScoresRequest) (*UpdateGamePeriodScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGamePeriodScores not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) UpdateCompetitionPeriods(context.Context, *UpdateCompetitionPeriodsRequest) (*UpdateCompetitionPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompetitionPeriods not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) UpdateStandingsRules(context.Context, *UpdateStandingsRulesRequest) (*UpdateStandingsRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStandingsRules not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) RecordEvent(context.Context, *RecordEventRequest) (*RecordEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEvent not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) UndoEvent(context.Context, *UndoEventRequest) (*UndoEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoEvent not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) RecordSubstitution(context.Context, *RecordSubstitutionRequest) (*RecordSubstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordSubstitution not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) UndoSubstitution(context.Context, *UndoSubstitutionRequest) (*UndoSubstitutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoSubstitution not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetLineupStats(context.Context, *GetLineupStatsRequest) (*GetLineupStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineupStats not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetShotChart(context.Context, *GetShotChartRequest) (*ShotChart, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShotChart not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetTeamRatings(context.Context, *GetTeamRatingsRequest) (*GetTeamRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamRatings not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) PredictGame(context.Context, *PredictGameRequest) (*GamePrediction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PredictGame not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) CreateAward(context.Context, *CreateAwardRequest) (*CreateAwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAward not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) CastAwardVotes(context.Context, *CastAwardVotesRequest) (*CastAwardVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CastAwardVotes not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetAwardLeaderboard(context.Context, *GetAwardLeaderboardRequest) (*AwardLeaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAwardLeaderboard not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetPlayerGamesStats(context.Context, *GetPlayerGamesStatsRequest) (*GetPlayerGamesStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerGamesStats not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetPlayerAverageStats(context.Context, *GetPlayerAverageStatsRequest) (*GetPlayerAverageStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerAverageStats not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetTeamAverageStats(context.Context, *GetTeamAverageStatsRequest) (*GetTeamAverageStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamAverageStats not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetPlayerSplits(context.Context, *GetPlayerSplitsRequest) (*PlayerSplits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerSplits not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetRecordsBook(context.Context, *GetRecordsBookRequest) (*RecordsBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsBook not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetHeadToHead(context.Context, *GetHeadToHeadRequest) (*HeadToHead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeadToHead not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetHeroBallMetadata(context.Context, *GetHeroBallMetadataRequest) (*HeroBallMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeroBallMetadata not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetGames(context.Context, *GetGamesRequest) (*GamesCursor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGames not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetPlayers(context.Context, *GetPlayersRequest) (*PlayersCursor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayers not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetPlayerInfo(context.Context, *GetPlayerInfoRequest) (*PlayerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerInfo not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetTeamInfo(context.Context, *GetTeamInfoRequest) (*TeamInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamInfo not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetGameInfo(context.Context, *GetGameInfoRequest) (*GameInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameInfo not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) GetCompetitionInfo(context.Context, *GetCompetitionInfoRequest) (*CompetitionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompetitionInfo not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) StreamGame(*StreamGameRequest, HeroBallService_StreamGameServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGame not implemented")
+}
+func (*UnimplementedHeroBallServiceServer) RefreshViews(context.Context, *RefreshViewsRequest) (*RefreshViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshViews not implemented")
+}
+
+func RegisterHeroBallServiceServer(s *grpc.Server, srv HeroBallServiceServer) {
+	s.RegisterService(&_HeroBallService_serviceDesc, srv)
+}
+
+func _HeroBallService_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/CreateGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_UpdateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).UpdateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/UpdateGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).UpdateGame(ctx, req.(*UpdateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_DeleteGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).DeleteGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/DeleteGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).DeleteGame(ctx, req.(*DeleteGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_UpsertPlayerGameStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertPlayerGameStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).UpsertPlayerGameStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/UpsertPlayerGameStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).UpsertPlayerGameStats(ctx, req.(*UpsertPlayerGameStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_UpdateCompetitionRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompetitionRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).UpdateCompetitionRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/UpdateCompetitionRoster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).UpdateCompetitionRoster(ctx, req.(*UpdateCompetitionRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GenerateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GenerateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GenerateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GenerateSchedule(ctx, req.(*GenerateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_CreateBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).CreateBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/CreateBracket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).CreateBracket(ctx, req.(*CreateBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetBracket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetBracket(ctx, req.(*GetBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_UpdateGamePeriodScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGamePeriodScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).UpdateGamePeriodScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/UpdateGamePeriodScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).UpdateGamePeriodScores(ctx, req.(*UpdateGamePeriodScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_UpdateCompetitionPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompetitionPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).UpdateCompetitionPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/UpdateCompetitionPeriods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).UpdateCompetitionPeriods(ctx, req.(*UpdateCompetitionPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_UpdateStandingsRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStandingsRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).UpdateStandingsRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/UpdateStandingsRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).UpdateStandingsRules(ctx, req.(*UpdateStandingsRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_RecordEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).RecordEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/RecordEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).RecordEvent(ctx, req.(*RecordEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_UndoEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).UndoEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/UndoEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).UndoEvent(ctx, req.(*UndoEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_RecordSubstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSubstitutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).RecordSubstitution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/RecordSubstitution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).RecordSubstitution(ctx, req.(*RecordSubstitutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_UndoSubstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoSubstitutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).UndoSubstitution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/UndoSubstitution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).UndoSubstitution(ctx, req.(*UndoSubstitutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetLineupStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLineupStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetLineupStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetLineupStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetLineupStats(ctx, req.(*GetLineupStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetShotChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShotChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetShotChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetShotChart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetShotChart(ctx, req.(*GetShotChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetTeamRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetTeamRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetTeamRatings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetTeamRatings(ctx, req.(*GetTeamRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_PredictGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PredictGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).PredictGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/PredictGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).PredictGame(ctx, req.(*PredictGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_CreateAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).CreateAward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/CreateAward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).CreateAward(ctx, req.(*CreateAwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_CastAwardVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CastAwardVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).CastAwardVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/CastAwardVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).CastAwardVotes(ctx, req.(*CastAwardVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetAwardLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAwardLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetAwardLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetAwardLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetAwardLeaderboard(ctx, req.(*GetAwardLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetPlayerGamesStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerGamesStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetPlayerGamesStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetPlayerGamesStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetPlayerGamesStats(ctx, req.(*GetPlayerGamesStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetPlayerAverageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerAverageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetPlayerAverageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetPlayerAverageStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetPlayerAverageStats(ctx, req.(*GetPlayerAverageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetTeamAverageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamAverageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetTeamAverageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetTeamAverageStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetTeamAverageStats(ctx, req.(*GetTeamAverageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetPlayerSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetPlayerSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetPlayerSplits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetPlayerSplits(ctx, req.(*GetPlayerSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetRecordsBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordsBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetRecordsBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetRecordsBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetRecordsBook(ctx, req.(*GetRecordsBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetHeadToHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeadToHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetHeadToHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetHeadToHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetHeadToHead(ctx, req.(*GetHeadToHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetHeroBallMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeroBallMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetHeroBallMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetHeroBallMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetHeroBallMetadata(ctx, req.(*GetHeroBallMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetGames(ctx, req.(*GetGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetPlayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetPlayers(ctx, req.(*GetPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetPlayerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetPlayerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetPlayerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetPlayerInfo(ctx, req.(*GetPlayerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetTeamInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetTeamInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetTeamInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetTeamInfo(ctx, req.(*GetTeamInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetGameInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetGameInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetGameInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetGameInfo(ctx, req.(*GetGameInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_GetCompetitionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompetitionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).GetCompetitionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/GetCompetitionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).GetCompetitionInfo(ctx, req.(*GetCompetitionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeroBallService_StreamGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeroBallServiceServer).StreamGame(m, &heroBallServiceStreamGameServer{stream})
+}
+
+type HeroBallService_StreamGameServer interface {
+	Send(*GameUpdate) error
+	grpc.ServerStream
+}
+
+type heroBallServiceStreamGameServer struct {
+	grpc.ServerStream
+}
+
+func (x *heroBallServiceStreamGameServer) Send(m *GameUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HeroBallService_RefreshViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeroBallServiceServer).RefreshViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.HeroBallService/RefreshViews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeroBallServiceServer).RefreshViews(ctx, req.(*RefreshViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HeroBallService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.HeroBallService",
+	HandlerType: (*HeroBallServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGame",
+			Handler:    _HeroBallService_CreateGame_Handler,
+		},
+		{
+			MethodName: "UpdateGame",
+			Handler:    _HeroBallService_UpdateGame_Handler,
+		},
+		{
+			MethodName: "DeleteGame",
+			Handler:    _HeroBallService_DeleteGame_Handler,
+		},
+		{
+			MethodName: "UpsertPlayerGameStats",
+			Handler:    _HeroBallService_UpsertPlayerGameStats_Handler,
+		},
+		{
+			MethodName: "UpdateCompetitionRoster",
+			Handler:    _HeroBallService_UpdateCompetitionRoster_Handler,
+		},
+		{
+			MethodName: "GenerateSchedule",
+			Handler:    _HeroBallService_GenerateSchedule_Handler,
+		},
+		{
+			MethodName: "CreateBracket",
+			Handler:    _HeroBallService_CreateBracket_Handler,
+		},
+		{
+			MethodName: "GetBracket",
+			Handler:    _HeroBallService_GetBracket_Handler,
+		},
+		{
+			MethodName: "UpdateGamePeriodScores",
+			Handler:    _HeroBallService_UpdateGamePeriodScores_Handler,
+		},
+		{
+			MethodName: "UpdateCompetitionPeriods",
+			Handler:    _HeroBallService_UpdateCompetitionPeriods_Handler,
+		},
+		{
+			MethodName: "UpdateStandingsRules",
+			Handler:    _HeroBallService_UpdateStandingsRules_Handler,
+		},
+		{
+			MethodName: "RecordEvent",
+			Handler:    _HeroBallService_RecordEvent_Handler,
+		},
+		{
+			MethodName: "UndoEvent",
+			Handler:    _HeroBallService_UndoEvent_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _HeroBallService_ListEvents_Handler,
+		},
+		{
+			MethodName: "RecordSubstitution",
+			Handler:    _HeroBallService_RecordSubstitution_Handler,
+		},
+		{
+			MethodName: "UndoSubstitution",
+			Handler:    _HeroBallService_UndoSubstitution_Handler,
+		},
+		{
+			MethodName: "GetLineupStats",
+			Handler:    _HeroBallService_GetLineupStats_Handler,
+		},
+		{
+			MethodName: "GetShotChart",
+			Handler:    _HeroBallService_GetShotChart_Handler,
+		},
+		{
+			MethodName: "GetTeamRatings",
+			Handler:    _HeroBallService_GetTeamRatings_Handler,
+		},
+		{
+			MethodName: "PredictGame",
+			Handler:    _HeroBallService_PredictGame_Handler,
+		},
+		{
+			MethodName: "CreateAward",
+			Handler:    _HeroBallService_CreateAward_Handler,
+		},
+		{
+			MethodName: "CastAwardVotes",
+			Handler:    _HeroBallService_CastAwardVotes_Handler,
+		},
+		{
+			MethodName: "GetAwardLeaderboard",
+			Handler:    _HeroBallService_GetAwardLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetPlayerGamesStats",
+			Handler:    _HeroBallService_GetPlayerGamesStats_Handler,
+		},
+		{
+			MethodName: "GetPlayerAverageStats",
+			Handler:    _HeroBallService_GetPlayerAverageStats_Handler,
+		},
+		{
+			MethodName: "GetTeamAverageStats",
+			Handler:    _HeroBallService_GetTeamAverageStats_Handler,
+		},
+		{
+			MethodName: "GetPlayerSplits",
+			Handler:    _HeroBallService_GetPlayerSplits_Handler,
+		},
+		{
+			MethodName: "GetRecordsBook",
+			Handler:    _HeroBallService_GetRecordsBook_Handler,
+		},
+		{
+			MethodName: "GetHeadToHead",
+			Handler:    _HeroBallService_GetHeadToHead_Handler,
+		},
+		{
+			MethodName: "GetHeroBallMetadata",
+			Handler:    _HeroBallService_GetHeroBallMetadata_Handler,
+		},
+		{
+			MethodName: "GetGames",
+			Handler:    _HeroBallService_GetGames_Handler,
+		},
+		{
+			MethodName: "GetPlayers",
+			Handler:    _HeroBallService_GetPlayers_Handler,
+		},
+		{
+			MethodName: "GetPlayerInfo",
+			Handler:    _HeroBallService_GetPlayerInfo_Handler,
+		},
+		{
+			MethodName: "GetTeamInfo",
+			Handler:    _HeroBallService_GetTeamInfo_Handler,
+		},
+		{
+			MethodName: "GetGameInfo",
+			Handler:    _HeroBallService_GetGameInfo_Handler,
+		},
+		{
+			MethodName: "GetCompetitionInfo",
+			Handler:    _HeroBallService_GetCompetitionInfo_Handler,
+		},
+		{
+			MethodName: "RefreshViews",
+			Handler:    _HeroBallService_RefreshViews_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGame",
+			Handler:       _HeroBallService_StreamGame_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "heroball.proto",
+}